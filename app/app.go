@@ -18,18 +18,23 @@ import (
 	"github.com/shinecloudfoundation/shinecloudnet/version"
 	"github.com/shinecloudfoundation/shinecloudnet/x/asset"
 	"github.com/shinecloudfoundation/shinecloudnet/x/auth"
+	"github.com/shinecloudfoundation/shinecloudnet/x/authz"
 	"github.com/shinecloudfoundation/shinecloudnet/x/bank"
 	"github.com/shinecloudfoundation/shinecloudnet/x/crisis"
 	distr "github.com/shinecloudfoundation/shinecloudnet/x/distribution"
+	"github.com/shinecloudfoundation/shinecloudnet/x/feegrant"
 	"github.com/shinecloudfoundation/shinecloudnet/x/genaccounts"
 	"github.com/shinecloudfoundation/shinecloudnet/x/genutil"
 	"github.com/shinecloudfoundation/shinecloudnet/x/gov"
+	"github.com/shinecloudfoundation/shinecloudnet/x/memo"
 	"github.com/shinecloudfoundation/shinecloudnet/x/mint"
 	"github.com/shinecloudfoundation/shinecloudnet/x/params"
 	paramsclient "github.com/shinecloudfoundation/shinecloudnet/x/params/client"
+	"github.com/shinecloudfoundation/shinecloudnet/x/payment"
 	"github.com/shinecloudfoundation/shinecloudnet/x/slashing"
 	"github.com/shinecloudfoundation/shinecloudnet/x/staking"
 	"github.com/shinecloudfoundation/shinecloudnet/x/supply"
+	"github.com/shinecloudfoundation/shinecloudnet/x/vesting"
 )
 
 const appName = "ScloudApp"
@@ -52,12 +57,18 @@ var (
 		staking.AppModuleBasic{},
 		mint.AppModuleBasic{},
 		distr.AppModuleBasic{},
-		gov.NewAppModuleBasic(paramsclient.ProposalHandler, distr.ProposalHandler),
+		gov.NewAppModuleBasic(paramsclient.ProposalHandler, distr.ProposalHandler,
+			distr.StreamProposalHandler, distr.CancelStreamProposalHandler),
 		params.AppModuleBasic{},
 		crisis.AppModuleBasic{},
 		slashing.AppModuleBasic{},
 		supply.AppModuleBasic{},
 		asset.AppModuleBasic{},
+		feegrant.AppModuleBasic{},
+		vesting.AppModuleBasic{},
+		authz.AppModuleBasic{},
+		memo.AppModuleBasic{},
+		payment.AppModuleBasic{},
 	)
 
 	// module account permissions
@@ -69,6 +80,7 @@ var (
 		staking.NotBondedPoolName: {supply.Burner, supply.Staking},
 		gov.ModuleName:            {supply.Burner},
 		asset.ModuleName:          {supply.Minter},
+		payment.ModuleName:        nil,
 	}
 
 	ShineContext = config.NewDefaultContext()
@@ -109,6 +121,11 @@ type ShineApp struct {
 	crisisKeeper   crisis.Keeper
 	paramsKeeper   params.Keeper
 	assetKeeper    asset.Keeper
+	feeGrantKeeper feegrant.Keeper
+	vestingKeeper  vesting.Keeper
+	authzKeeper    authz.Keeper
+	memoKeeper     memo.Keeper
+	paymentKeeper  payment.Keeper
 
 	// the module manager
 	mm *module.Manager
@@ -127,7 +144,8 @@ func NewShineApp(logger log.Logger, db dbm.DB, traceStore io.Writer, loadLatest
 	keys := sdk.NewKVStoreKeys(
 		bam.MainStoreKey, auth.StoreKey, staking.StoreKey,
 		supply.StoreKey, mint.StoreKey, distr.StoreKey, slashing.StoreKey,
-		gov.StoreKey, params.StoreKey, asset.StoreKey,
+		gov.StoreKey, params.StoreKey, asset.StoreKey, feegrant.StoreKey,
+		authz.StoreKey, memo.StoreKey, payment.StoreKey,
 	)
 	tkeys := sdk.NewTransientStoreKeys(staking.TStoreKey, params.TStoreKey)
 
@@ -150,6 +168,7 @@ func NewShineApp(logger log.Logger, db dbm.DB, traceStore io.Writer, loadLatest
 	govSubspace := app.paramsKeeper.Subspace(gov.DefaultParamspace)
 	crisisSubspace := app.paramsKeeper.Subspace(crisis.DefaultParamspace)
 	assetSubspace := app.paramsKeeper.Subspace(asset.DefaultParamspace)
+	paymentSubspace := app.paramsKeeper.Subspace(payment.DefaultParamspace)
 
 	// add keepers
 	app.accountKeeper = auth.NewAccountKeeper(app.cdc, keys[auth.StoreKey], authSubspace, auth.ProtoBaseAccount)
@@ -157,11 +176,12 @@ func NewShineApp(logger log.Logger, db dbm.DB, traceStore io.Writer, loadLatest
 	app.supplyKeeper = supply.NewKeeper(app.cdc, keys[supply.StoreKey], app.accountKeeper, app.bankKeeper, maccPerms)
 	stakingKeeper := staking.NewKeeper(
 		app.cdc, keys[staking.StoreKey], tkeys[staking.TStoreKey],
-		app.supplyKeeper, stakingSubspace, staking.DefaultCodespace,
+		app.accountKeeper, app.supplyKeeper, stakingSubspace, staking.DefaultCodespace,
 	)
-	app.mintKeeper = mint.NewKeeper(app.cdc, keys[mint.StoreKey], mintSubspace, app.supplyKeeper, auth.FeeCollectorName)
 	app.distrKeeper = distr.NewKeeper(app.cdc, keys[distr.StoreKey], distrSubspace, &stakingKeeper,
 		app.supplyKeeper, distr.DefaultCodespace, auth.FeeCollectorName, app.ModuleAccountAddrs())
+	app.mintKeeper = mint.NewKeeper(app.cdc, keys[mint.StoreKey], mintSubspace, app.supplyKeeper,
+		app.distrKeeper, auth.FeeCollectorName)
 	app.slashingKeeper = slashing.NewKeeper(
 		app.cdc, keys[slashing.StoreKey], &stakingKeeper, slashingSubspace, slashing.DefaultCodespace,
 	)
@@ -184,6 +204,12 @@ func NewShineApp(logger log.Logger, db dbm.DB, traceStore io.Writer, loadLatest
 	)
 
 	app.assetKeeper = asset.NewKeeper(cdc, keys[asset.StoreKey], assetSubspace, app.supplyKeeper, asset.DefaultCodespace)
+	app.feeGrantKeeper = feegrant.NewKeeper(cdc, keys[feegrant.StoreKey], feegrant.DefaultCodespace)
+	app.vestingKeeper = vesting.NewKeeper(app.accountKeeper, app.bankKeeper, vesting.DefaultCodespace)
+	app.authzKeeper = authz.NewKeeper(cdc, keys[authz.StoreKey], app.Router(), authz.DefaultCodespace)
+	app.memoKeeper = memo.NewKeeper(cdc, keys[memo.StoreKey], memo.DefaultCodespace)
+	app.paymentKeeper = payment.NewKeeper(cdc, keys[payment.StoreKey], paymentSubspace, app.bankKeeper,
+		app.supplyKeeper, payment.DefaultCodespace)
 
 	// NOTE: Any module instantiated in the module manager that is later modified
 	// must be passed by reference here.
@@ -200,21 +226,29 @@ func NewShineApp(logger log.Logger, db dbm.DB, traceStore io.Writer, loadLatest
 		slashing.NewAppModule(app.slashingKeeper, app.stakingKeeper),
 		staking.NewAppModule(app.stakingKeeper, app.distrKeeper, app.accountKeeper, app.supplyKeeper),
 		asset.NewAppModule(app.assetKeeper),
+		feegrant.NewAppModule(app.feeGrantKeeper),
+		vesting.NewAppModule(app.vestingKeeper),
+		authz.NewAppModule(app.authzKeeper),
+		memo.NewAppModule(app.memoKeeper),
+		payment.NewAppModule(app.paymentKeeper),
 	)
 
 	// During begin block slashing happens after distr.BeginBlocker so that
 	// there is nothing left over in the validator fee pool, so as to keep the
 	// CanWithdrawInvariant invariant.
-	app.mm.SetOrderBeginBlockers(mint.ModuleName, distr.ModuleName, slashing.ModuleName)
+	app.mm.SetOrderBeginBlockers(mint.ModuleName, distr.ModuleName, slashing.ModuleName, staking.ModuleName)
 
-	app.mm.SetOrderEndBlockers(crisis.ModuleName, gov.ModuleName, staking.ModuleName)
+	app.mm.SetOrderEndBlockers(crisis.ModuleName, gov.ModuleName, distr.ModuleName, staking.ModuleName, payment.ModuleName)
 
 	// NOTE: The genutils module must occur after staking so that pools are
-	// properly initialized with tokens from genesis accounts.
+	// properly initialized with tokens from genesis accounts. The mint module
+	// must occur after supply so that the initial mint pool is added to the
+	// genesis supply.
 	app.mm.SetOrderInitGenesis(
 		genaccounts.ModuleName, distr.ModuleName, staking.ModuleName,
 		auth.ModuleName, bank.ModuleName, slashing.ModuleName, gov.ModuleName,
-		mint.ModuleName, supply.ModuleName, crisis.ModuleName, genutil.ModuleName, asset.ModuleName,
+		supply.ModuleName, mint.ModuleName, crisis.ModuleName, genutil.ModuleName, asset.ModuleName,
+		feegrant.ModuleName, authz.ModuleName, memo.ModuleName, payment.ModuleName,
 	)
 
 	app.mm.RegisterInvariants(&app.crisisKeeper)
@@ -227,7 +261,9 @@ func NewShineApp(logger log.Logger, db dbm.DB, traceStore io.Writer, loadLatest
 	// initialize BaseApp
 	app.SetInitChainer(app.InitChainer)
 	app.SetBeginBlocker(app.BeginBlocker)
-	app.SetAnteHandler(auth.NewAnteHandler(app.accountKeeper, app.supplyKeeper, auth.DefaultSigVerificationGasConsumer))
+	app.SetAnteHandler(memo.NewAnteHandler(auth.NewAnteHandlerWithFeeGrant(
+		app.accountKeeper, app.supplyKeeper, app.feeGrantKeeper, auth.DefaultSigVerificationGasConsumer,
+	), app.memoKeeper))
 	app.SetEndBlocker(app.EndBlocker)
 
 	app.registerUpgrade()
@@ -4,14 +4,24 @@ import (
 	"encoding/hex"
 	"os"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
 	"github.com/tendermint/tendermint/libs/log"
 	db "github.com/tendermint/tm-db"
 
+	bam "github.com/shinecloudfoundation/shinecloudnet/baseapp"
 	"github.com/shinecloudfoundation/shinecloudnet/codec"
 	"github.com/shinecloudfoundation/shinecloudnet/simapp"
+	"github.com/shinecloudfoundation/shinecloudnet/store"
+	sdk "github.com/shinecloudfoundation/shinecloudnet/types"
 	"github.com/shinecloudfoundation/shinecloudnet/x/auth"
+	"github.com/shinecloudfoundation/shinecloudnet/x/authz"
+	"github.com/shinecloudfoundation/shinecloudnet/x/genaccounts"
+	"github.com/shinecloudfoundation/shinecloudnet/x/mint"
+	"github.com/shinecloudfoundation/shinecloudnet/x/mock"
+	"github.com/shinecloudfoundation/shinecloudnet/x/payment"
 	abci "github.com/tendermint/tendermint/abci/types"
 )
 
@@ -54,3 +64,169 @@ func setGenesis(gapp *ShineApp) error {
 	gapp.Commit()
 	return nil
 }
+
+func TestDryRunUpgrade(t *testing.T) {
+	defer func() { sdk.GlobalUpgradeMgr = sdk.NewUpgradeManager() }()
+	sdk.GlobalUpgradeMgr = sdk.NewUpgradeManager()
+
+	gapp := NewShineApp(log.NewTMLogger(log.NewSyncWriter(os.Stdout)), db.NewMemDB(), nil, true, 0,
+		bam.SetPruning(store.PruneNothing))
+	require.NoError(t, setGenesis(gapp))
+
+	var upgradeHeight int64
+	sdk.GlobalUpgradeMgr.RegisterUpgradeHeight("dryRunTest", 100)
+	sdk.GlobalUpgradeMgr.RegisterBeginBlockerFirst("dryRunTest", func(ctx sdk.Context) {
+		upgradeHeight = ctx.BlockHeight()
+	})
+
+	_, err := gapp.DryRunUpgrade("unknown", abci.Header{}, abci.LastCommitInfo{})
+	require.Error(t, err)
+
+	res, err := gapp.DryRunUpgrade("dryRunTest", abci.Header{}, abci.LastCommitInfo{})
+	require.NoError(t, err)
+	require.Equal(t, int64(2), res.Height)
+	require.Equal(t, res.Height, upgradeHeight)
+	require.Equal(t, res.Height, sdk.GlobalUpgradeMgr.GetUpgradeHeight("dryRunTest"))
+	require.Len(t, res.Stores, len(gapp.keys))
+	require.NotEmpty(t, res.Invariants)
+	require.False(t, res.InvariantsBroken(), res.String())
+
+	_, err = gapp.DryRunUpgrade("dryRunTest", abci.Header{}, abci.LastCommitInfo{})
+	require.Error(t, err)
+
+	// changed stores which can't be diffed are reported as such
+	res.Stores = []StoreDryRunDiff{{Store: "transient", HashBefore: "AA", HashAfter: "BB", NotDiffed: true}}
+	require.Contains(t, res.String(), "transient    AA -> BB (changed, keys not diffed)")
+}
+
+func TestInitialMintPool(t *testing.T) {
+	initChain := func(mintGenesis mint.GenesisState) *ShineApp {
+		gapp := NewShineApp(log.NewTMLogger(log.NewSyncWriter(os.Stdout)), db.NewMemDB(), nil, true, 0)
+		genesisState := simapp.NewDefaultGenesisState()
+		genesisState[mint.ModuleName] = gapp.cdc.MustMarshalJSON(mintGenesis)
+		stateBytes, err := codec.MarshalJSONIndent(gapp.cdc, genesisState)
+		require.NoError(t, err)
+
+		gapp.InitChain(abci.RequestInitChain{AppStateBytes: stateBytes})
+		return gapp
+	}
+	remained := func(gapp *ShineApp) sdk.Int {
+		ctx := gapp.NewContext(false, abci.Header{})
+		return gapp.mintKeeper.GetMinter(ctx).RemainedTokens.AmountOf(sdk.DefaultBondDenom)
+	}
+	supplied := func(gapp *ShineApp) sdk.Int {
+		ctx := gapp.NewContext(false, abci.Header{})
+		return gapp.supplyKeeper.GetSupply(ctx).GetTotal().AmountOf(sdk.DefaultBondDenom)
+	}
+
+	// the initial pool is minted by InitGenesis
+	mintGenesis := mint.DefaultGenesisState()
+	mintGenesis.Params.UnfreezeAmountPerBlock = 0
+	mintGenesis.InitialLockedTokens = sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000))
+	gapp := initChain(mintGenesis)
+	require.True(t, remained(gapp).Equal(sdk.NewInt(1000)))
+	require.True(t, supplied(gapp).Equal(sdk.NewInt(1000)))
+
+	gapp.BeginBlock(abci.RequestBeginBlock{Header: abci.Header{Height: 1}})
+	require.True(t, remained(gapp).Equal(sdk.NewInt(1000)))
+
+	// a genesis without the initial pool mints the legacy pool at height 1
+	mintGenesis = mint.DefaultGenesisState()
+	mintGenesis.Params.UnfreezeAmountPerBlock = 0
+	mintGenesis.InitialLockedTokens = nil
+	mintGenesis.MintAtGenesis = false
+	gapp = initChain(mintGenesis)
+	require.True(t, remained(gapp).IsZero())
+
+	gapp.BeginBlock(abci.RequestBeginBlock{Header: abci.Header{Height: 1}})
+	require.True(t, remained(gapp).Equal(mint.LegacyInitialLockedAmount))
+	require.True(t, supplied(gapp).Equal(mint.LegacyInitialLockedAmount))
+}
+
+func TestUnlockRemainderUpgrade(t *testing.T) {
+	defer func() { sdk.GlobalUpgradeMgr = sdk.NewUpgradeManager() }()
+	sdk.GlobalUpgradeMgr = sdk.NewUpgradeManager()
+
+	gapp := NewShineApp(log.NewTMLogger(log.NewSyncWriter(os.Stdout)), db.NewMemDB(), nil, true, 0)
+	mintGenesis := mint.DefaultGenesisState()
+	mintGenesis.Params.UnfreezeAmountPerBlock = 400
+	mintGenesis.InitialLockedTokens = sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000))
+	genesisState := simapp.NewDefaultGenesisState()
+	genesisState[mint.ModuleName] = gapp.cdc.MustMarshalJSON(mintGenesis)
+	stateBytes, err := codec.MarshalJSONIndent(gapp.cdc, genesisState)
+	require.NoError(t, err)
+	gapp.InitChain(abci.RequestInitChain{AppStateBytes: stateBytes})
+
+	remained := func() sdk.Int {
+		ctx := gapp.NewContext(true, abci.Header{})
+		return gapp.mintKeeper.GetMinter(ctx).RemainedTokens.AmountOf(sdk.DefaultBondDenom)
+	}
+	nextBlock := func() {
+		height := gapp.LastBlockHeight() + 1
+		gapp.BeginBlock(abci.RequestBeginBlock{Header: abci.Header{Height: height}})
+		gapp.EndBlock(abci.RequestEndBlock{Height: height})
+		gapp.Commit()
+	}
+	sdk.GlobalUpgradeMgr.RegisterUpgradeHeight(mint.UpgradeUnlockRemainder, 5)
+
+	// the remainder lower than the unlock amount stays locked until the upgrade
+	for i := 0; i < 4; i++ {
+		nextBlock()
+	}
+	require.True(t, remained().Equal(sdk.NewInt(200)), remained().String())
+
+	nextBlock()
+	require.True(t, remained().IsZero(), remained().String())
+}
+
+func TestExecPaymentMsg(t *testing.T) {
+	payerKey, granteeKey := secp256k1.GenPrivKey(), secp256k1.GenPrivKey()
+	payer, grantee := sdk.AccAddress(payerKey.PubKey().Address()), sdk.AccAddress(granteeKey.PubKey().Address())
+	payee := sdk.AccAddress([]byte("payee"))
+	coins := func(amount int64) sdk.Coins {
+		return sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, amount))
+	}
+
+	gapp := NewShineApp(log.NewTMLogger(log.NewSyncWriter(os.Stdout)), db.NewMemDB(), nil, true, 0)
+	genesisState := simapp.NewDefaultGenesisState()
+	genesisState[genaccounts.ModuleName] = gapp.cdc.MustMarshalJSON(genaccounts.GenesisState{
+		genaccounts.NewGenesisAccount(&auth.BaseAccount{Address: payer, Coins: coins(1000)}),
+		genaccounts.NewGenesisAccount(&auth.BaseAccount{Address: grantee, Coins: coins(1000)}),
+	})
+	stateBytes, err := codec.MarshalJSONIndent(gapp.cdc, genesisState)
+	require.NoError(t, err)
+	gapp.InitChain(abci.RequestInitChain{AppStateBytes: stateBytes})
+	gapp.Commit()
+
+	now := time.Unix(1577836800, 0).UTC()
+	deliver := func(key secp256k1.PrivKeySecp256k1, msgs ...sdk.Msg) sdk.Result {
+		height := gapp.LastBlockHeight() + 1
+		header := abci.Header{Height: height, Time: now.Add(time.Duration(height) * time.Second)}
+		gapp.BeginBlock(abci.RequestBeginBlock{Header: header})
+		acc := gapp.accountKeeper.GetAccount(gapp.NewContext(false, header), sdk.AccAddress(key.PubKey().Address()))
+		res := gapp.Deliver(mock.GenTx(msgs, []uint64{acc.GetAccountNumber()}, []uint64{acc.GetSequence()}, key))
+		gapp.EndBlock(abci.RequestEndBlock{Height: height})
+		gapp.Commit()
+		return res
+	}
+
+	// the payer lets the grantee cancel its payment schedules
+	res := deliver(payerKey,
+		payment.NewMsgCreateSchedule(payer, payee, coins(10), time.Time{}, time.Hour, 10),
+		authz.NewMsgGrantAuthorization(payer, grantee,
+			authz.NewGenericAuthorization(authz.MsgTypeOf(payment.MsgCancelSchedule{})), now.Add(time.Hour)),
+	)
+	require.True(t, res.IsOK(), res.Log)
+
+	ctx := gapp.NewContext(true, abci.Header{})
+	schedules := gapp.paymentKeeper.GetSchedulesByPayer(ctx, payer)
+	require.Len(t, schedules, 1)
+
+	// the signed exec msg wraps a msg of a module other than bank or staking
+	res = deliver(granteeKey, authz.NewMsgExec(grantee, []sdk.Msg{payment.NewMsgCancelSchedule(payer, schedules[0].ID)}))
+	require.True(t, res.IsOK(), res.Log)
+
+	ctx = gapp.NewContext(true, abci.Header{})
+	require.Empty(t, gapp.paymentKeeper.GetSchedulesByPayer(ctx, payer))
+	require.Equal(t, coins(990), gapp.accountKeeper.GetAccount(ctx, payer).GetCoins())
+}
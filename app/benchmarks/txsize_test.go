@@ -30,7 +30,7 @@ func ExampleTxSendSize() {
 	}
 	fee := auth.NewStdFee(gas, coins)
 	signBytes := auth.StdSignBytes("example-chain-ID",
-		1, 1, fee, []sdk.Msg{msg1}, "")
+		1, 1, 0, fee, []sdk.Msg{msg1}, "")
 	sig, _ := priv1.Sign(signBytes)
 	sigs := []auth.StdSignature{{nil, sig}}
 	tx := auth.NewStdTx([]sdk.Msg{msg1}, fee, sigs, "")
@@ -15,7 +15,8 @@ const defaultConfigTemplate = `# This is a TOML config file.
 
 # The minimum gas prices a validator is willing to accept for processing a
 # transaction. A transaction's fees must meet the minimum of any denomination
-# specified in this config (e.g. 0.25token1;0.0001token2).
+# specified in this config (e.g. 0.25token1;0.0001token2). The minimum gas
+# prices of the auth params apply as well, this config can only raise them.
 minimum-gas-prices = "{{ .BaseConfig.MinGasPrices }}"
 
 # HaltHeight contains a non-zero height at which a node will gracefully halt
@@ -0,0 +1,217 @@
+package app
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"strings"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/shinecloudfoundation/shinecloudnet/store/iavl"
+	sdk "github.com/shinecloudfoundation/shinecloudnet/types"
+)
+
+// UpgradeDryRunResult is the outcome of running an upgrade against the latest state
+type UpgradeDryRunResult struct {
+	Upgrade       string            `json:"upgrade"`
+	Height        int64             `json:"height"`
+	AppHashBefore string            `json:"app_hash_before"`
+	AppHashAfter  string            `json:"app_hash_after"`
+	Stores        []StoreDryRunDiff `json:"stores"`
+	Invariants    []InvariantDryRun `json:"invariants"`
+}
+
+// StoreDryRunDiff summarizes how a module store changed during the dry-run block
+type StoreDryRunDiff struct {
+	Store      string `json:"store"`
+	HashBefore string `json:"hash_before"`
+	HashAfter  string `json:"hash_after"`
+	Added      int    `json:"added"`
+	Updated    int    `json:"updated"`
+	Deleted    int    `json:"deleted"`
+
+	// NotDiffed is set for changed stores without versions to diff the keys of
+	NotDiffed bool `json:"not_diffed,omitempty"`
+}
+
+// InvariantDryRun is the result of a crisis invariant after the dry-run block
+type InvariantDryRun struct {
+	Route  string `json:"route"`
+	Broken bool   `json:"broken"`
+	Msg    string `json:"msg,omitempty"`
+}
+
+// InvariantsBroken returns true if any invariant failed after the upgrade
+func (res UpgradeDryRunResult) InvariantsBroken() bool {
+	for _, invar := range res.Invariants {
+		if invar.Broken {
+			return true
+		}
+	}
+	return false
+}
+
+func (res UpgradeDryRunResult) String() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "Upgrade %s dry-run at height %d\n", res.Upgrade, res.Height)
+	fmt.Fprintf(&b, "App Hash: %s -> %s\n", res.AppHashBefore, res.AppHashAfter)
+
+	b.WriteString("Stores:\n")
+	for _, diff := range res.Stores {
+		changed := "unchanged"
+		if diff.NotDiffed {
+			changed = "changed, keys not diffed"
+		} else if diff.HashBefore != diff.HashAfter {
+			changed = fmt.Sprintf("+%d ~%d -%d", diff.Added, diff.Updated, diff.Deleted)
+		}
+		fmt.Fprintf(&b, "  %-12s %s -> %s (%s)\n", diff.Store, diff.HashBefore, diff.HashAfter, changed)
+	}
+
+	b.WriteString("Invariants:\n")
+	for _, invar := range res.Invariants {
+		if invar.Broken {
+			fmt.Fprintf(&b, "  %s: BROKEN\n%s\n", invar.Route, invar.Msg)
+			continue
+		}
+		fmt.Fprintf(&b, "  %s: ok\n", invar.Route)
+	}
+
+	return strings.TrimSpace(b.String())
+}
+
+// DryRunUpgrade forces the named upgrade to the next block, runs that block
+// without transactions and commits it. It must only be called on an app
+// backed by a copy of the node data.
+func (app *ShineApp) DryRunUpgrade(name string, header abci.Header, lastCommitInfo abci.LastCommitInfo) (res UpgradeDryRunResult, err error) {
+	height := app.LastBlockHeight() + 1
+
+	upgradeHeight := sdk.GlobalUpgradeMgr.GetUpgradeHeight(name)
+	if upgradeHeight == 0 {
+		return res, fmt.Errorf("no upgrade registered for %s", name)
+	}
+	if upgradeHeight < height {
+		return res, fmt.Errorf("upgrade %s was already applied at height %d", name, upgradeHeight)
+	}
+	sdk.GlobalUpgradeMgr.RescheduleUpgrade(name, height)
+
+	cms := app.CommitMultiStore()
+	storeNames := make([]string, 0, len(app.keys))
+	for storeName := range app.keys {
+		storeNames = append(storeNames, storeName)
+	}
+	sort.Strings(storeNames)
+
+	before := make(map[string]sdk.CommitID, len(storeNames))
+	for _, storeName := range storeNames {
+		before[storeName] = cms.GetCommitKVStore(app.keys[storeName]).LastCommitID()
+	}
+
+	res.Upgrade = name
+	res.Height = height
+	res.AppHashBefore = fmt.Sprintf("%X", app.LastCommitID().Hash)
+
+	header.Height = height
+	app.BeginBlock(abci.RequestBeginBlock{Header: header, LastCommitInfo: lastCommitInfo})
+	app.EndBlock(abci.RequestEndBlock{Height: height})
+	app.Commit()
+
+	res.AppHashAfter = fmt.Sprintf("%X", app.LastCommitID().Hash)
+
+	for _, storeName := range storeNames {
+		store := cms.GetCommitKVStore(app.keys[storeName])
+		diff := StoreDryRunDiff{
+			Store:      storeName,
+			HashBefore: fmt.Sprintf("%X", before[storeName].Hash),
+			HashAfter:  fmt.Sprintf("%X", store.LastCommitID().Hash),
+		}
+
+		if diff.HashBefore != diff.HashAfter {
+			// only IAVL stores keep the previous version to diff against
+			iavlStore, ok := store.(*iavl.Store)
+			if !ok {
+				diff.NotDiffed = true
+				res.Stores = append(res.Stores, diff)
+				continue
+			}
+
+			var oldStore sdk.KVStore
+			if before[storeName].Version > 0 {
+				oldStore, err = iavlStore.GetImmutable(before[storeName].Version)
+				if err != nil {
+					return res, err
+				}
+			}
+			diff.Added, diff.Updated, diff.Deleted = diffKVStores(oldStore, store)
+		}
+
+		res.Stores = append(res.Stores, diff)
+	}
+
+	ctx := app.NewContext(true, header)
+	for _, route := range app.crisisKeeper.Routes() {
+		res.Invariants = append(res.Invariants, runDryRunInvariant(ctx, route.FullRoute(), route.Invar))
+	}
+
+	return res, nil
+}
+
+func runDryRunInvariant(ctx sdk.Context, route string, invar sdk.Invariant) (res InvariantDryRun) {
+	res.Route = route
+	defer func() {
+		if r := recover(); r != nil {
+			res.Broken = true
+			res.Msg = fmt.Sprintf("panic: %v", r)
+		}
+	}()
+
+	res.Msg, res.Broken = invar(ctx)
+	if !res.Broken {
+		res.Msg = ""
+	}
+	return res
+}
+
+// diffKVStores counts the keys added, updated and deleted between two versions of a store
+func diffKVStores(oldStore, newStore sdk.KVStore) (added, updated, deleted int) {
+	newIter := newStore.Iterator(nil, nil)
+	defer newIter.Close()
+
+	if oldStore == nil {
+		for ; newIter.Valid(); newIter.Next() {
+			added++
+		}
+		return
+	}
+
+	oldIter := oldStore.Iterator(nil, nil)
+	defer oldIter.Close()
+
+	for oldIter.Valid() || newIter.Valid() {
+		switch {
+		case !newIter.Valid():
+			deleted++
+			oldIter.Next()
+		case !oldIter.Valid():
+			added++
+			newIter.Next()
+		default:
+			cmp := bytes.Compare(oldIter.Key(), newIter.Key())
+			switch {
+			case cmp < 0:
+				deleted++
+				oldIter.Next()
+			case cmp > 0:
+				added++
+				newIter.Next()
+			default:
+				if !bytes.Equal(oldIter.Value(), newIter.Value()) {
+					updated++
+				}
+				oldIter.Next()
+				newIter.Next()
+			}
+		}
+	}
+	return
+}
@@ -489,6 +489,14 @@ func handleQueryApp(app *BaseApp, path []string, req abci.RequestQuery) (res abc
 				Value:     []byte(app.appVersion),
 			}
 
+		case "upgrade":
+			return abci.ResponseQuery{
+				Code:      uint32(sdk.CodeOK),
+				Codespace: string(sdk.CodespaceRoot),
+				Height:    req.Height,
+				Value:     codec.Cdc.MustMarshalJSON(sdk.GlobalUpgradeMgr.GetUpgradeStatus()),
+			}
+
 		default:
 			result = sdk.ErrUnknownRequest(fmt.Sprintf("Unknown query: %s", path)).Result()
 		}
@@ -502,7 +510,7 @@ func handleQueryApp(app *BaseApp, path []string, req abci.RequestQuery) (res abc
 		}
 	}
 
-	msg := "Expected second parameter to be either simulate or version, neither was present"
+	msg := "Expected second parameter to be one of simulate, version or upgrade, none was present"
 	return sdk.ErrUnknownRequest(msg).QueryResult()
 }
 
@@ -734,6 +742,17 @@ func checkNewMsgsUpgradeHeight(msgs []sdk.Msg) sdk.Error {
 	return nil
 }
 
+// checkRetiredMsgsUpgradeHeight ensures there is no retired msgs
+func checkRetiredMsgsUpgradeHeight(msgs []sdk.Msg) sdk.Error {
+	for _, msg := range msgs {
+		if sdk.GlobalUpgradeMgr.IsMsgRetired(msg.Type()) {
+			return sdk.ErrMsgRetired(fmt.Sprintf("%s is retired since height %d", msg.Type(), sdk.GlobalUpgradeMgr.GetMsgRetiredHeight(msg.Type())))
+		}
+	}
+
+	return nil
+}
+
 // retrieve the context for the tx w/ txBytes and other memoized values.
 func (app *BaseApp) getContextForTx(mode runTxMode, txBytes []byte) (ctx sdk.Context) {
 	ctx = app.getState(mode).ctx.
@@ -909,6 +928,9 @@ func (app *BaseApp) runTx(mode runTxMode, txBytes []byte, tx sdk.Tx) (result sdk
 	if err := checkNewMsgsUpgradeHeight(msgs); err != nil {
 		return err.Result()
 	}
+	if err := checkRetiredMsgsUpgradeHeight(msgs); err != nil {
+		return err.Result()
+	}
 	if err := validateBasicTxMsgs(msgs); err != nil {
 		return err.Result()
 	}
@@ -616,6 +616,48 @@ func TestDeliverTx(t *testing.T) {
 	}
 }
 
+// Test that retired msgs are rejected in both CheckTx and DeliverTx from the upgrade height on.
+func TestRetiredMsg(t *testing.T) {
+	anteOpt := func(bapp *BaseApp) { bapp.SetAnteHandler(anteHandlerTxTest(t, capKey1, []byte("ante-key"))) }
+	deliverKey := []byte("deliver-key")
+	routerOpt := func(bapp *BaseApp) {
+		bapp.Router().AddRoute(routeMsgCounter, handlerMsgCounter(t, capKey1, deliverKey))
+	}
+
+	app := setupBaseApp(t, anteOpt, routerOpt)
+	app.InitChain(abci.RequestInitChain{})
+
+	defer func() { sdk.GlobalUpgradeMgr = sdk.NewUpgradeManager() }()
+	sdk.GlobalUpgradeMgr = sdk.NewUpgradeManager()
+	sdk.GlobalUpgradeMgr.RegisterUpgradeHeight("retireCounter", 2)
+	sdk.GlobalUpgradeMgr.RegisterRetiredMsg("retireCounter", msgCounter{}.Type())
+
+	codec := codec.New()
+	registerTestCodec(codec)
+
+	for height := int64(1); height <= 2; height++ {
+		sdk.GlobalUpgradeMgr.SetBlockHeight(height)
+		app.BeginBlock(abci.RequestBeginBlock{Header: abci.Header{Height: height}})
+
+		tx := newTxCounter(height-1, height-1)
+		txBytes, err := codec.MarshalBinaryLengthPrefixed(tx)
+		require.NoError(t, err)
+
+		checkRes := app.CheckTx(abci.RequestCheckTx{Tx: txBytes})
+		deliverRes := app.DeliverTx(abci.RequestDeliverTx{Tx: txBytes})
+		if height < 2 {
+			require.True(t, checkRes.IsOK(), fmt.Sprintf("%v", checkRes))
+			require.True(t, deliverRes.IsOK(), fmt.Sprintf("%v", deliverRes))
+		} else {
+			require.Equal(t, uint32(sdk.CodeRetiredMsg), checkRes.Code, fmt.Sprintf("%v", checkRes))
+			require.Equal(t, uint32(sdk.CodeRetiredMsg), deliverRes.Code, fmt.Sprintf("%v", deliverRes))
+		}
+
+		app.EndBlock(abci.RequestEndBlock{})
+		app.Commit()
+	}
+}
+
 // Number of messages doesn't matter to CheckTx.
 func TestMultiMsgCheckTx(t *testing.T) {
 	// TODO: ensure we get the same results
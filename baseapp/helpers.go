@@ -35,3 +35,9 @@ func (app *BaseApp) NewContext(isCheckTx bool, header abci.Header) sdk.Context {
 
 	return sdk.NewContext(app.deliverState.ms, header, false, app.logger)
 }
+
+// CommitMultiStore returns the main (uncached) state of the app.
+// It must not be written to outside of Commit.
+func (app *BaseApp) CommitMultiStore() sdk.CommitMultiStore {
+	return app.cms
+}
@@ -105,6 +105,8 @@ var (
 	GetValidators                      = rpc.GetValidators
 	ValidatorSetRequestHandlerFn       = rpc.ValidatorSetRequestHandlerFn
 	LatestValidatorSetRequestHandlerFn = rpc.LatestValidatorSetRequestHandlerFn
+	UpgradeStatusCommand               = rpc.UpgradeStatusCommand
+	UpgradeStatusRequestHandlerFn      = rpc.UpgradeStatusRequestHandlerFn
 	GetPassword                        = input.GetPassword
 	GetCheckPassword                   = input.GetCheckPassword
 	GetConfirmation                    = input.GetConfirmation
@@ -17,4 +17,5 @@ func RegisterRPCRoutes(cliCtx context.CLIContext, r *mux.Router) {
 	r.HandleFunc("/validatorsets/latest", LatestValidatorSetRequestHandlerFn(cliCtx)).Methods("GET")
 	r.HandleFunc("/validatorsets/{height}", ValidatorSetRequestHandlerFn(cliCtx)).Methods("GET")
 	r.HandleFunc("/simulate/{txBytes}", TxSimulateRequestHandlerFn(cliCtx)).Methods("GET")
+	r.HandleFunc("/upgrade/status", UpgradeStatusRequestHandlerFn(cliCtx)).Methods("GET")
 }
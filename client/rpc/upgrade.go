@@ -0,0 +1,62 @@
+package rpc
+
+import (
+	"net/http"
+
+	"github.com/spf13/cobra"
+
+	"github.com/shinecloudfoundation/shinecloudnet/client/context"
+	"github.com/shinecloudfoundation/shinecloudnet/client/flags"
+	"github.com/shinecloudfoundation/shinecloudnet/codec"
+	sdk "github.com/shinecloudfoundation/shinecloudnet/types"
+	"github.com/shinecloudfoundation/shinecloudnet/types/rest"
+)
+
+// UpgradeStatusCommand returns the upgrade heights, new msgs and retired msgs known by the node
+func UpgradeStatusCommand(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "upgrade-status",
+		Short: "Query the registered upgrades, new msgs and retired msgs",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			status, err := getUpgradeStatus(cliCtx)
+			if err != nil {
+				return err
+			}
+
+			return cliCtx.PrintOutput(status)
+		},
+	}
+
+	return flags.GetCommands(cmd)[0]
+}
+
+func getUpgradeStatus(cliCtx context.CLIContext) (sdk.UpgradeStatus, error) {
+	var status sdk.UpgradeStatus
+
+	res, _, err := cliCtx.Query("/app/upgrade")
+	if err != nil {
+		return status, err
+	}
+
+	if err := cliCtx.Codec.UnmarshalJSON(res, &status); err != nil {
+		return status, err
+	}
+
+	return status, nil
+}
+
+// REST handler for the upgrade status
+func UpgradeStatusRequestHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		status, err := getUpgradeStatus(cliCtx)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		rest.PostProcessResponseBare(w, cliCtx, status)
+	}
+}
@@ -57,6 +57,7 @@ func main() {
 	rootCmd.AddCommand(client.NewCompletionCmd(rootCmd, true))
 	rootCmd.AddCommand(testnetCmd(ctx.ServerContext, cdc, app.ModuleBasics, genaccounts.AppModuleBasic{}))
 	rootCmd.AddCommand(replayCmd())
+	rootCmd.AddCommand(upgradeCmd(ctx.ServerContext))
 
 	server.AddCommands(ctx.ServerContext, cdc, rootCmd, newApp, exportAppStateAndTMValidators)
 
@@ -29,6 +29,7 @@ import (
 	"github.com/shinecloudfoundation/shinecloudnet/x/genaccounts"
 	"github.com/shinecloudfoundation/shinecloudnet/x/genutil"
 	genutiltypes "github.com/shinecloudfoundation/shinecloudnet/x/genutil/types"
+	"github.com/shinecloudfoundation/shinecloudnet/x/mint"
 	"github.com/shinecloudfoundation/shinecloudnet/x/staking"
 )
 
@@ -39,6 +40,7 @@ var (
 	flagNodeDaemonHome    = "node-daemon-home"
 	flagNodeCLIHome       = "node-cli-home"
 	flagStartingIPAddress = "starting-ip-address"
+	flagInitialLocked     = "initial-locked-tokens"
 )
 
 // get cmd to initialize all files for tendermint testnet and application
@@ -68,9 +70,13 @@ Example:
 			nodeCLIHome := viper.GetString(flagNodeCLIHome)
 			startingIPAddress := viper.GetString(flagStartingIPAddress)
 			numValidators := viper.GetInt(flagNumValidators)
+			initialLocked, err := sdk.ParseCoins(viper.GetString(flagInitialLocked))
+			if err != nil {
+				return err
+			}
 
 			return InitTestnet(cmd, config, cdc, mbm, genAccIterator, outputDir, chainID,
-				minGasPrices, nodeDirPrefix, nodeDaemonHome, nodeCLIHome, startingIPAddress, numValidators)
+				minGasPrices, nodeDirPrefix, nodeDaemonHome, nodeCLIHome, startingIPAddress, numValidators, initialLocked)
 		},
 	}
 
@@ -86,6 +92,8 @@ Example:
 		"Home directory of the node's cli configuration")
 	cmd.Flags().String(flagStartingIPAddress, "192.168.0.1",
 		"Starting IP address (192.168.0.1 results in persistent peers list ID0@192.168.0.1:46656, ID1@192.168.0.2:46656, ...)")
+	cmd.Flags().String(flagInitialLocked, mint.DefaultInitialLockedTokens().String(),
+		"Tokens minted into the mint module at genesis and unlocked block by block")
 	cmd.Flags().String(
 		client.FlagChainID, "", "genesis file chain-id, if left blank will be randomly created")
 	cmd.Flags().String(
@@ -100,7 +108,7 @@ const nodeDirPerm = 0755
 func InitTestnet(cmd *cobra.Command, config *tmconfig.Config, cdc *codec.Codec,
 	mbm module.BasicManager, genAccIterator genutiltypes.GenesisAccountsIterator,
 	outputDir, chainID, minGasPrices, nodeDirPrefix, nodeDaemonHome,
-	nodeCLIHome, startingIPAddress string, numValidators int) error {
+	nodeCLIHome, startingIPAddress string, numValidators int, initialLocked sdk.Coins) error {
 
 	if chainID == "" {
 		chainID = "chain-" + cmn.RandStr(6)
@@ -242,7 +250,7 @@ func InitTestnet(cmd *cobra.Command, config *tmconfig.Config, cdc *codec.Codec,
 		appconfig.WriteConfigFile(scloudConfigFilePath, scloudConfig)
 	}
 
-	if err := initGenFiles(cdc, mbm, chainID, accs, genFiles, numValidators); err != nil {
+	if err := initGenFiles(cdc, mbm, chainID, accs, genFiles, numValidators, initialLocked); err != nil {
 		return err
 	}
 
@@ -259,13 +267,19 @@ func InitTestnet(cmd *cobra.Command, config *tmconfig.Config, cdc *codec.Codec,
 }
 
 func initGenFiles(cdc *codec.Codec, mbm module.BasicManager, chainID string,
-	accs []genaccounts.GenesisAccount, genFiles []string, numValidators int) error {
+	accs []genaccounts.GenesisAccount, genFiles []string, numValidators int, initialLocked sdk.Coins) error {
 
 	appGenState := mbm.DefaultGenesis()
 
 	// set the accounts in the genesis state
 	appGenState = genaccounts.SetGenesisStateInAppState(cdc, appGenState, accs)
 
+	// set the initial mint pool in the genesis state
+	var mintGenState mint.GenesisState
+	cdc.MustUnmarshalJSON(appGenState[mint.ModuleName], &mintGenState)
+	mintGenState.InitialLockedTokens = initialLocked
+	appGenState[mint.ModuleName] = cdc.MustMarshalJSON(mintGenState)
+
 	appGenStateJSON, err := codec.MarshalJSONIndent(cdc, appGenState)
 	if err != nil {
 		return err
@@ -0,0 +1,133 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	cpm "github.com/otiai10/copy"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/cli"
+	cmn "github.com/tendermint/tendermint/libs/common"
+	tmsm "github.com/tendermint/tendermint/state"
+
+	"github.com/shinecloudfoundation/shinecloudnet/app"
+	"github.com/shinecloudfoundation/shinecloudnet/app/config"
+	"github.com/shinecloudfoundation/shinecloudnet/baseapp"
+	"github.com/shinecloudfoundation/shinecloudnet/store"
+	sdk "github.com/shinecloudfoundation/shinecloudnet/types"
+)
+
+const flagKeepCopy = "keep-copy"
+
+func upgradeCmd(ctx *config.ServerContext) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "upgrade",
+		Short: "Upgrade related subcommands",
+	}
+
+	cmd.AddCommand(upgradeDryRunCmd(ctx))
+	return cmd
+}
+
+func upgradeDryRunCmd(ctx *config.ServerContext) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "dry-run <upgrade-name>",
+		Short: "Run the handlers of an upgrade against a copy of the node data",
+		Long: `Copy the application and state databases of the node, force the given upgrade
+to the next block and run that block against the copy. The store hashes, a
+per-store diff summary and the result of every registered invariant are printed.
+
+The node should be stopped first so that the copied databases are consistent.
+The original data directory is never written to.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(_ *cobra.Command, args []string) error {
+			return dryRunUpgrade(ctx, viper.GetString(cli.HomeFlag), args[0], viper.GetBool(flagKeepCopy))
+		},
+	}
+
+	cmd.Flags().Bool(flagKeepCopy, false, "Keep the copied data directory after the dry-run")
+	return cmd
+}
+
+func dryRunUpgrade(ctx *config.ServerContext, rootDir, name string, keepCopy bool) error {
+	// Copy the databases to a new directory, to preserve the old ones.
+	dataDir := filepath.Join(rootDir, "data")
+	copyDir := filepath.Join(rootDir, "data_dryrun_"+name)
+	if cmn.FileExists(copyDir) {
+		return fmt.Errorf("temporary copy dir %v already exists", copyDir)
+	}
+
+	// remove partial copies as well
+	if !keepCopy {
+		defer os.RemoveAll(copyDir)
+	}
+
+	fmt.Fprintln(os.Stderr, "Copying application and state databases to", copyDir)
+	for _, db := range []string{"application.db", "state.db"} {
+		if err := cpm.Copy(filepath.Join(dataDir, db), filepath.Join(copyDir, db)); err != nil {
+			return err
+		}
+	}
+
+	fmt.Fprintln(os.Stderr, "Opening tendermint state database")
+	tmDB, err := sdk.NewLevelDB("state", copyDir)
+	if err != nil {
+		return err
+	}
+	state := tmsm.LoadState(tmDB)
+	tmDB.Close()
+
+	fmt.Fprintln(os.Stderr, "Opening app database")
+	appDB, err := sdk.NewLevelDB("application", copyDir)
+	if err != nil {
+		return err
+	}
+	defer appDB.Close()
+
+	// the invariants are run once after the upgrade block by the dry-run
+	// itself, so the crisis module doesn't check them every block
+	fmt.Fprintln(os.Stderr, "Creating application")
+	dryRunApp := app.NewShineApp(
+		ctx.Logger, appDB, nil, true, 0,
+		baseapp.SetPruning(store.PruneNothing),
+	)
+	if dryRunApp.LastBlockHeight() != state.LastBlockHeight {
+		return fmt.Errorf("application height %d does not match tendermint state height %d",
+			dryRunApp.LastBlockHeight(), state.LastBlockHeight)
+	}
+
+	header := abci.Header{
+		ChainID: state.ChainID,
+		Time:    state.LastBlockTime,
+	}
+	if state.Validators != nil && state.Validators.Size() > 0 {
+		header.ProposerAddress = state.Validators.GetProposer().Address
+	}
+
+	// assume every validator of the last block signed it
+	var lastCommitInfo abci.LastCommitInfo
+	if state.LastValidators != nil {
+		for _, val := range state.LastValidators.Validators {
+			lastCommitInfo.Votes = append(lastCommitInfo.Votes, abci.VoteInfo{
+				Validator:       abci.Validator{Address: val.Address, Power: val.VotingPower},
+				SignedLastBlock: true,
+			})
+		}
+	}
+
+	fmt.Fprintf(os.Stderr, "Running upgrade %s at height %d\n", name, state.LastBlockHeight+1)
+	res, err := dryRunApp.DryRunUpgrade(name, header, lastCommitInfo)
+	if err != nil {
+		return err
+	}
+
+	fmt.Println(res.String())
+	if res.InvariantsBroken() {
+		return fmt.Errorf("upgrade %s breaks invariants", name)
+	}
+	return nil
+}
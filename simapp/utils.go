@@ -146,6 +146,9 @@ func GenAuthGenesisState(cdc *codec.Codec, r *rand.Rand, ap simulation.AppParams
 					})
 				return v
 			}(r),
+			auth.FeeTokens{},
+			sdk.DecCoins{},
+			auth.DefaultPubKeyChangeCost,
 		),
 	)
 
@@ -164,6 +167,8 @@ func GenBankGenesisState(cdc *codec.Codec, r *rand.Rand, ap simulation.AppParams
 				})
 			return v
 		}(r),
+		bank.SendEnabledDenoms{},
+		[]sdk.AccAddress{},
 	)
 
 	fmt.Printf("Selected randomly generated bank parameters:\n%s\n", codec.MustMarshalJSONIndent(cdc, bankGenesis))
@@ -248,19 +253,19 @@ func GenGovGenesisState(cdc *codec.Codec, r *rand.Rand, ap simulation.AppParams,
 			vp = simulation.ModuleParamSimulator[simulation.VotingParamsVotingPeriod](r).(time.Duration)
 		})
 
+	var minDeposit sdk.Coins
+	ap.GetOrGenerate(cdc, simulation.DepositParamsMinDeposit, &minDeposit, r,
+		func(r *rand.Rand) {
+			minDeposit = simulation.ModuleParamSimulator[simulation.DepositParamsMinDeposit](r).(sdk.Coins)
+		})
+
+	// expedited proposals need a higher deposit, a shorter voting period and
+	// a higher threshold than the random ones
+	expeditedMinDeposit := sdk.NewCoins(sdk.NewCoin(minDeposit[0].Denom, minDeposit[0].Amount.MulRaw(5)))
+
 	govGenesis := gov.NewGenesisState(
 		uint64(r.Intn(100)),
-		gov.NewDepositParams(
-			func(r *rand.Rand) sdk.Coins {
-				var v sdk.Coins
-				ap.GetOrGenerate(cdc, simulation.DepositParamsMinDeposit, &v, r,
-					func(r *rand.Rand) {
-						v = simulation.ModuleParamSimulator[simulation.DepositParamsMinDeposit](r).(sdk.Coins)
-					})
-				return v
-			}(r),
-			vp,
-		),
+		gov.NewDepositParams(minDeposit, vp),
 		gov.NewVotingParams(vp),
 		gov.NewTallyParams(
 			func(r *rand.Rand) sdk.Dec {
@@ -288,6 +293,8 @@ func GenGovGenesisState(cdc *codec.Codec, r *rand.Rand, ap simulation.AppParams,
 				return v
 			}(r),
 		),
+		gov.NewExpeditedParams(expeditedMinDeposit, vp/2, sdk.NewDecWithPrec(667, 3)),
+		gov.DefaultBurnParams(),
 	)
 
 	fmt.Printf("Selected randomly generated governance parameters:\n%s\n", codec.MustMarshalJSONIndent(cdc, govGenesis))
@@ -310,7 +317,13 @@ func GenMintGenesisState(cdc *codec.Codec, r *rand.Rand, ap simulation.AppParams
 		mint.NewParams(
 			sdk.DefaultBondDenom,
 			1000000,
+			mint.UnlockSchedule{},
+			0,
+			sdk.NewDecWithPrec(5, 1),
+			sdk.ZeroDec(),
+			mint.MintRecipients{},
 		),
+		mint.DefaultInitialLockedTokens(),
 	)
 
 	fmt.Printf("Selected randomly generated minting parameters:\n%s\n", codec.MustMarshalJSONIndent(cdc, mintGenesis.Params))
@@ -434,6 +447,9 @@ func GenStakingGenesisState(
 			}(r),
 			7,
 			sdk.DefaultBondDenom,
+			staking.DefaultMaxConsPubKeyRotations,
+			staking.DefaultConsPubKeyRotationFee,
+			staking.DefaultHistoricalEntries,
 		),
 		nil,
 		nil,
@@ -521,6 +537,11 @@ func DecodeMintStore(cdcA, cdcB *codec.Codec, kvA, kvB cmn.KVPair) string {
 		cdcA.MustUnmarshalBinaryLengthPrefixed(kvA.Value, &minterA)
 		cdcB.MustUnmarshalBinaryLengthPrefixed(kvB.Value, &minterB)
 		return fmt.Sprintf("%v\n%v", minterA, minterB)
+	case bytes.Equal(kvA.Key[:1], mint.RecipientTotalKeyPrefix):
+		var totalA, totalB sdk.Coins
+		cdcA.MustUnmarshalBinaryLengthPrefixed(kvA.Value, &totalA)
+		cdcB.MustUnmarshalBinaryLengthPrefixed(kvB.Value, &totalB)
+		return fmt.Sprintf("%v\n%v", totalA, totalB)
 	default:
 		panic(fmt.Sprintf("invalid mint key %X", kvA.Key))
 	}
@@ -266,7 +266,7 @@ func TestDecodeGovStore(t *testing.T) {
 	endTime := time.Now().UTC()
 
 	content := gov.ContentFromProposalType("test", "test", gov.ProposalTypeText)
-	proposal := gov.NewProposal(content, 1, endTime, endTime.Add(24*time.Hour))
+	proposal := gov.NewProposal(content, 1, nil, endTime, endTime.Add(24*time.Hour), false)
 	proposalIDBz := make([]byte, 8)
 	binary.LittleEndian.PutUint64(proposalIDBz, 1)
 	deposit := gov.NewDeposit(1, delAddr1, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.OneInt())))
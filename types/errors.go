@@ -45,6 +45,8 @@ const (
 	CodeGasOverflow       CodeType = 16
 	CodeNoSignatures      CodeType = 17
 	CodeUnsupportedMsg    CodeType = 18
+	CodeRetiredMsg        CodeType = 19
+	CodeTxTimeoutHeight   CodeType = 20
 
 	// CodespaceRoot is a codespace for error codes in this file only.
 	// Notice that 0 is an "unset" codespace, which can be overridden with
@@ -92,6 +94,12 @@ func CodeToDefaultMsg(code CodeType) string {
 		return "maximum numer of signatures exceeded"
 	case CodeNoSignatures:
 		return "no signatures supplied"
+	case CodeUnsupportedMsg:
+		return "msg not supported"
+	case CodeRetiredMsg:
+		return "msg retired"
+	case CodeTxTimeoutHeight:
+		return "tx timeout height"
 	default:
 		return unknownCodeMsg(code)
 	}
@@ -156,6 +164,12 @@ func ErrGasOverflow(msg string) Error {
 func ErrMsgNotSupported(msg string) Error {
 	return newErrorWithRootCodespace(CodeUnsupportedMsg, msg)
 }
+func ErrMsgRetired(msg string) Error {
+	return newErrorWithRootCodespace(CodeRetiredMsg, msg)
+}
+func ErrTxTimeoutHeight(msg string) Error {
+	return newErrorWithRootCodespace(CodeTxTimeoutHeight, msg)
+}
 
 //----------------------------------------
 // Error & sdkError
@@ -60,6 +60,7 @@ type BaseReq struct {
 	BroadcastMode string       `json:"broadcast_mode"`
 	Simulate      bool         `json:"simulate"`
 	GenerateOnly  bool         `json:"generate_only"`
+	TimeoutHeight uint64       `json:"timeout_height"`
 }
 
 // NewBaseReq creates a new basic request instance and sanitizes its values
@@ -87,10 +88,12 @@ func NewBaseReq(
 
 // Sanitize performs basic sanitization on a BaseReq object.
 func (br BaseReq) Sanitize() BaseReq {
-	return NewBaseReq(
+	sanitized := NewBaseReq(
 		br.From, br.Password, br.Memo, br.ChainID, br.Gas, br.GasAdjustment,
 		br.AccountNumber, br.Sequence, br.Fees, br.GasPrices, br.BroadcastMode, br.Simulate, br.GenerateOnly,
 	)
+	sanitized.TimeoutHeight = br.TimeoutHeight
+	return sanitized
 }
 
 // ValidateBasic performs basic validation of a BaseReq. If custom validation
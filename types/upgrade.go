@@ -1,6 +1,10 @@
 package types
 
-import "fmt"
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
 
 const (
 )
@@ -8,9 +12,10 @@ const (
 var GlobalUpgradeMgr = NewUpgradeManager()
 
 type UpgradeConfig struct {
-	UpgradeHeight  map[string]int64
-	NewStoreHeight map[string]int64
-	NewMsgHeight   map[string]int64
+	UpgradeHeight    map[string]int64
+	NewStoreHeight   map[string]int64
+	NewMsgHeight     map[string]int64
+	RetiredMsgHeight map[string]int64
 
 	BeginBlockersFirst map[int64][]func(ctx Context)
 	BeginBlockersLast  map[int64][]func(ctx Context)
@@ -30,6 +35,7 @@ func NewUpgradeManager() *UpgradeManager {
 			UpgradeHeight:      make(map[string]int64),
 			NewStoreHeight:     make(map[string]int64),
 			NewMsgHeight:       make(map[string]int64),
+			RetiredMsgHeight:   make(map[string]int64),
 			BeginBlockersFirst: make(map[int64][]func(ctx Context)),
 			BeginBlockersLast:  make(map[int64][]func(ctx Context)),
 			EndBlockersFirst:   make(map[int64][]func(ctx Context)),
@@ -165,6 +171,35 @@ func (mgr *UpgradeManager) GetUpgradeHeight(name string) int64 {
 	return mgr.Config.UpgradeHeight[name]
 }
 
+// Move an upgrade, with everything registered at its height, to a new height.
+// Only used to dry-run upgrade handlers against a copy of the node data.
+func (mgr *UpgradeManager) RescheduleUpgrade(name string, height int64) {
+	oldHeight := mgr.GetUpgradeHeight(name)
+	if oldHeight == 0 {
+		panic(fmt.Sprintf("no upgrade for %s", name))
+	}
+
+	for upgradeName, upgradeHeight := range mgr.Config.UpgradeHeight {
+		if upgradeHeight == oldHeight {
+			mgr.Config.UpgradeHeight[upgradeName] = height
+		}
+	}
+	for _, heights := range []map[string]int64{mgr.Config.NewStoreHeight, mgr.Config.NewMsgHeight, mgr.Config.RetiredMsgHeight} {
+		for key, keyHeight := range heights {
+			if keyHeight == oldHeight {
+				heights[key] = height
+			}
+		}
+	}
+	for _, blockers := range []map[int64][]func(ctx Context){mgr.Config.BeginBlockersFirst, mgr.Config.BeginBlockersLast,
+		mgr.Config.EndBlockersFirst, mgr.Config.EndBlockersLast} {
+		if fns, ok := blockers[oldHeight]; ok {
+			delete(blockers, oldHeight)
+			blockers[height] = append(blockers[height], fns...)
+		}
+	}
+}
+
 func (mgr *UpgradeManager) RegisterNewStore(upgradeName string, newStores ...string) {
 	height := mgr.GetUpgradeHeight(upgradeName)
 	if height == 0 {
@@ -195,6 +230,26 @@ func (mgr *UpgradeManager) GetMsgHeight(msgType string) int64 {
 	return mgr.Config.NewMsgHeight[msgType]
 }
 
+// Retire msg types from the upgrade height on
+func (mgr *UpgradeManager) RegisterRetiredMsg(upgradeName string, msgTypes ...string) {
+	height := mgr.GetUpgradeHeight(upgradeName)
+	if height == 0 {
+		panic(fmt.Sprintf("no upgrade for %s", upgradeName))
+	}
+
+	if mgr.Config.RetiredMsgHeight == nil {
+		mgr.Config.RetiredMsgHeight = make(map[string]int64)
+	}
+
+	for _, msgType := range msgTypes {
+		mgr.Config.RetiredMsgHeight[msgType] = height
+	}
+}
+
+func (mgr *UpgradeManager) GetMsgRetiredHeight(msgType string) int64 {
+	return mgr.Config.RetiredMsgHeight[msgType]
+}
+
 func (mgr *UpgradeManager) IsUpgradeApplied(upgradeName string) bool {
 	height, ok := mgr.Config.UpgradeHeight[upgradeName]
 	if !ok {
@@ -219,6 +274,14 @@ func (mgr *UpgradeManager) MsgCheck(msgType string) bool {
 	return mgr.BlockHeight >= height
 }
 
+func (mgr *UpgradeManager) IsMsgRetired(msgType string) bool {
+	height, ok := mgr.Config.RetiredMsgHeight[msgType]
+	if !ok {
+		return false
+	}
+	return mgr.BlockHeight >= height
+}
+
 func (mgr *UpgradeManager) StoreCheck(storeName string) bool {
 	height, ok := mgr.Config.NewStoreHeight[storeName]
 	if !ok {
@@ -234,3 +297,45 @@ func (mgr *UpgradeManager) IsOnStoreStartHeight(storeName string) bool {
 	}
 	return mgr.BlockHeight == height
 }
+
+// UpgradeStatus is the view of the upgrade config returned by the upgrade status query
+type UpgradeStatus struct {
+	BlockHeight      int64            `json:"block_height"`
+	UpgradeHeight    map[string]int64 `json:"upgrade_height"`
+	NewStoreHeight   map[string]int64 `json:"new_store_height"`
+	NewMsgHeight     map[string]int64 `json:"new_msg_height"`
+	RetiredMsgHeight map[string]int64 `json:"retired_msg_height"`
+}
+
+func (mgr *UpgradeManager) GetUpgradeStatus() UpgradeStatus {
+	return UpgradeStatus{
+		BlockHeight:      mgr.BlockHeight,
+		UpgradeHeight:    mgr.Config.UpgradeHeight,
+		NewStoreHeight:   mgr.Config.NewStoreHeight,
+		NewMsgHeight:     mgr.Config.NewMsgHeight,
+		RetiredMsgHeight: mgr.Config.RetiredMsgHeight,
+	}
+}
+
+func (status UpgradeStatus) String() string {
+	out := fmt.Sprintf("Block Height: %d\n", status.BlockHeight)
+	out += formatHeights("Upgrades", status.UpgradeHeight)
+	out += formatHeights("New Stores", status.NewStoreHeight)
+	out += formatHeights("New Msgs", status.NewMsgHeight)
+	out += formatHeights("Retired Msgs", status.RetiredMsgHeight)
+	return strings.TrimSpace(out)
+}
+
+func formatHeights(title string, heights map[string]int64) string {
+	names := make([]string, 0, len(heights))
+	for name := range heights {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	out := fmt.Sprintf("%s:\n", title)
+	for _, name := range names {
+		out += fmt.Sprintf("  %s: %d\n", name, heights[name])
+	}
+	return out
+}
@@ -131,3 +131,47 @@ func TestUpgrade(t *testing.T) {
 		require.Equal(t, tc.storeCheck, GlobalUpgradeMgr.StoreCheck(tc.storeName), fmt.Sprintf("new store test case failed, index: %d", index))
 	}
 }
+
+func TestRetiredMsg(t *testing.T) {
+	defer func() { GlobalUpgradeMgr = NewUpgradeManager() }()
+	GlobalUpgradeMgr = NewUpgradeManager()
+	GlobalUpgradeMgr.RegisterUpgradeHeight("assetV2", 10000)
+	GlobalUpgradeMgr.RegisterRetiredMsg("assetV2", "issueToken", "mintToken")
+
+	require.Panics(t, func() { GlobalUpgradeMgr.RegisterRetiredMsg("unknown", "issueToken") })
+
+	GlobalUpgradeMgr.SetBlockHeight(9999)
+	require.False(t, GlobalUpgradeMgr.IsMsgRetired("issueToken"))
+	require.False(t, GlobalUpgradeMgr.IsMsgRetired("send"))
+
+	GlobalUpgradeMgr.SetBlockHeight(10000)
+	require.True(t, GlobalUpgradeMgr.IsMsgRetired("issueToken"))
+	require.True(t, GlobalUpgradeMgr.IsMsgRetired("mintToken"))
+	require.False(t, GlobalUpgradeMgr.IsMsgRetired("send"))
+	require.Equal(t, int64(10000), GlobalUpgradeMgr.GetMsgRetiredHeight("mintToken"))
+
+	status := GlobalUpgradeMgr.GetUpgradeStatus()
+	require.Equal(t, map[string]int64{"issueToken": 10000, "mintToken": 10000}, status.RetiredMsgHeight)
+}
+
+func TestRescheduleUpgrade(t *testing.T) {
+	defer func() { GlobalUpgradeMgr = NewUpgradeManager() }()
+	GlobalUpgradeMgr = NewUpgradeManager()
+	GlobalUpgradeMgr.RegisterUpgradeHeight("tokenIssue", 10000)
+	GlobalUpgradeMgr.RegisterNewStore("tokenIssue", "token")
+	GlobalUpgradeMgr.RegisterNewMsg("tokenIssue", "issueToken")
+	GlobalUpgradeMgr.RegisterRetiredMsg("tokenIssue", "oldIssueToken")
+	GlobalUpgradeMgr.RegisterBeginBlockerFirst("tokenIssue", func(Context) {})
+	GlobalUpgradeMgr.RegisterEndBlockerLast("tokenIssue", func(Context) {})
+
+	require.Panics(t, func() { GlobalUpgradeMgr.RescheduleUpgrade("unknown", 50) })
+
+	GlobalUpgradeMgr.RescheduleUpgrade("tokenIssue", 50)
+	require.Equal(t, int64(50), GlobalUpgradeMgr.GetUpgradeHeight("tokenIssue"))
+	require.Equal(t, int64(50), GlobalUpgradeMgr.Config.NewStoreHeight["token"])
+	require.Equal(t, int64(50), GlobalUpgradeMgr.GetMsgHeight("issueToken"))
+	require.Equal(t, int64(50), GlobalUpgradeMgr.GetMsgRetiredHeight("oldIssueToken"))
+	require.Len(t, GlobalUpgradeMgr.Config.BeginBlockersFirst[50], 1)
+	require.Len(t, GlobalUpgradeMgr.Config.EndBlockersLast[50], 1)
+	require.Empty(t, GlobalUpgradeMgr.Config.BeginBlockersFirst[10000])
+}
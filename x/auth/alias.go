@@ -14,13 +14,21 @@ const (
 	StoreKey                      = types.StoreKey
 	FeeCollectorName              = types.FeeCollectorName
 	QuerierRoute                  = types.QuerierRoute
+	RouterKey                     = types.RouterKey
 	DefaultParamspace             = types.DefaultParamspace
 	DefaultMaxMemoCharacters      = types.DefaultMaxMemoCharacters
 	DefaultTxSigLimit             = types.DefaultTxSigLimit
 	DefaultTxSizeCostPerByte      = types.DefaultTxSizeCostPerByte
 	DefaultSigVerifyCostED25519   = types.DefaultSigVerifyCostED25519
 	DefaultSigVerifyCostSecp256k1 = types.DefaultSigVerifyCostSecp256k1
+	DefaultPubKeyChangeCost       = types.DefaultPubKeyChangeCost
 	QueryAccount                  = types.QueryAccount
+	FeeBaseDenom                  = types.FeeBaseDenom
+	TypeMsgChangePubKey           = types.TypeMsgChangePubKey
+	EventTypeChangePubKey         = types.EventTypeChangePubKey
+	AttributeKeyAddress           = types.AttributeKeyAddress
+	AttributeKeyPubKey            = types.AttributeKeyPubKey
+	AttributeValueCategory        = types.AttributeValueCategory
 )
 
 var (
@@ -33,6 +41,9 @@ var (
 	NewContinuousVestingAccount    = types.NewContinuousVestingAccount
 	NewDelayedVestingAccountRaw    = types.NewDelayedVestingAccountRaw
 	NewDelayedVestingAccount       = types.NewDelayedVestingAccount
+	NewPeriod                      = types.NewPeriod
+	NewPeriodicVestingAccountRaw   = types.NewPeriodicVestingAccountRaw
+	NewPeriodicVestingAccount      = types.NewPeriodicVestingAccount
 	RegisterCodec                  = types.RegisterCodec
 	NewGenesisState                = types.NewGenesisState
 	DefaultGenesisState            = types.DefaultGenesisState
@@ -52,6 +63,8 @@ var (
 	NewTxBuilderFromCLI            = types.NewTxBuilderFromCLI
 	MakeSignature                  = types.MakeSignature
 	NewAccountRetriever            = types.NewAccountRetriever
+	NewFeeToken                    = types.NewFeeToken
+	NewMsgChangePubKey             = types.NewMsgChangePubKey
 
 	// variable aliases
 	ModuleCdc                 = types.ModuleCdc
@@ -62,6 +75,9 @@ var (
 	KeyTxSizeCostPerByte      = types.KeyTxSizeCostPerByte
 	KeySigVerifyCostED25519   = types.KeySigVerifyCostED25519
 	KeySigVerifyCostSecp256k1 = types.KeySigVerifyCostSecp256k1
+	KeyFeeTokens              = types.KeyFeeTokens
+	KeyMinGasPrices           = types.KeyMinGasPrices
+	KeyPubKeyChangeCost       = types.KeyPubKeyChangeCost
 )
 
 type (
@@ -71,13 +87,19 @@ type (
 	BaseVestingAccount       = types.BaseVestingAccount
 	ContinuousVestingAccount = types.ContinuousVestingAccount
 	DelayedVestingAccount    = types.DelayedVestingAccount
+	Period                   = types.Period
+	Periods                  = types.Periods
+	PeriodicVestingAccount   = types.PeriodicVestingAccount
 	GenesisState             = types.GenesisState
 	Params                   = types.Params
 	QueryAccountParams       = types.QueryAccountParams
 	StdSignMsg               = types.StdSignMsg
 	StdTx                    = types.StdTx
 	StdFee                   = types.StdFee
+	FeeToken                 = types.FeeToken
+	FeeTokens                = types.FeeTokens
 	StdSignDoc               = types.StdSignDoc
 	StdSignature             = types.StdSignature
 	TxBuilder                = types.TxBuilder
+	MsgChangePubKey          = types.MsgChangePubKey
 )
@@ -34,8 +34,17 @@ type SignatureVerificationGasConsumer = func(meter sdk.GasMeter, sig []byte, pub
 
 // NewAnteHandler returns an AnteHandler that checks and increments sequence
 // numbers, checks signatures & account numbers, and deducts fees from the first
-// signer.
+// signer. Transactions with a fee granter are rejected.
 func NewAnteHandler(ak AccountKeeper, supplyKeeper types.SupplyKeeper, sigGasConsumer SignatureVerificationGasConsumer) sdk.AnteHandler {
+	return NewAnteHandlerWithFeeGrant(ak, supplyKeeper, nil, sigGasConsumer)
+}
+
+// NewAnteHandlerWithFeeGrant returns an AnteHandler like NewAnteHandler that
+// deducts the fees from the fee granter instead of the first signer if one is
+// set, using up the fee allowance of the first signer.
+func NewAnteHandlerWithFeeGrant(ak AccountKeeper, supplyKeeper types.SupplyKeeper,
+	feeGrantKeeper types.FeeGrantKeeper, sigGasConsumer SignatureVerificationGasConsumer) sdk.AnteHandler {
+
 	return func(
 		ctx sdk.Context, tx sdk.Tx, simulate bool,
 	) (newCtx sdk.Context, res sdk.Result, abort bool) {
@@ -55,11 +64,19 @@ func NewAnteHandler(ak AccountKeeper, supplyKeeper types.SupplyKeeper, sigGasCon
 
 		params := ak.GetParams(ctx)
 
-		// Ensure that the provided fees meet a minimum threshold for the validator,
-		// if this is a CheckTx. This is only for local mempool purposes, and thus
-		// is only ran on check tx.
+		// Ensure that the provided fees meet the consensus minimum gas prices, in
+		// CheckTx and DeliverTx alike. The minimum threshold of the validator is
+		// only checked in CheckTx for local mempool purposes and can't lower the
+		// consensus floor. Fees paid in fee tokens are valued at their exchange
+		// rate. Genesis transactions are exempt.
+		if !simulate && ctx.BlockHeight() != 0 {
+			res := EnsureSufficientFees(stdTx.Fee, params.MinGasPrices, params.FeeTokens)
+			if !res.IsOK() {
+				return newCtx, res, true
+			}
+		}
 		if ctx.IsCheckTx() && !simulate {
-			res := EnsureSufficientMempoolFees(ctx, stdTx.Fee)
+			res := EnsureSufficientFees(stdTx.Fee, ctx.MinGasPrices(), params.FeeTokens)
 			if !res.IsOK() {
 				return newCtx, res, true
 			}
@@ -104,6 +121,10 @@ func NewAnteHandler(ak AccountKeeper, supplyKeeper types.SupplyKeeper, sigGasCon
 			return newCtx, res, true
 		}
 
+		if res := ValidateTimeoutHeight(ctx, stdTx); !res.IsOK() {
+			return newCtx, res, true
+		}
+
 		// stdSigs contains the sequence number, account number, and signatures.
 		// When simulating, this would just be a 0-length slice.
 		signerAddrs := stdTx.GetSigners()
@@ -117,7 +138,12 @@ func NewAnteHandler(ak AccountKeeper, supplyKeeper types.SupplyKeeper, sigGasCon
 		}
 
 		// deduct the fees
-		if !stdTx.Fee.Amount.IsZero() {
+		if !stdTx.Fee.Granter.Empty() {
+			res = DeductGrantedFees(ak, supplyKeeper, feeGrantKeeper, newCtx, stdTx.Fee, signerAddrs[0])
+			if !res.IsOK() {
+				return newCtx, res, true
+			}
+		} else if !stdTx.Fee.Amount.IsZero() {
 			res = DeductFees(supplyKeeper, newCtx, signerAccs[0], stdTx.Fee.Amount)
 			if !res.IsOK() {
 				return newCtx, res, true
@@ -197,6 +223,21 @@ func ValidateMemo(stdTx StdTx, params Params) sdk.Result {
 	return sdk.Result{}
 }
 
+// ValidateTimeoutHeight validates that the tx has not timed out at the current
+// block height.
+func ValidateTimeoutHeight(ctx sdk.Context, stdTx StdTx) sdk.Result {
+	if stdTx.TimeoutHeight > 0 && uint64(ctx.BlockHeight()) > stdTx.TimeoutHeight {
+		return sdk.ErrTxTimeoutHeight(
+			fmt.Sprintf(
+				"tx timed out at height %d, current height is %d",
+				stdTx.TimeoutHeight, ctx.BlockHeight(),
+			),
+		).Result()
+	}
+
+	return sdk.Result{}
+}
+
 // verify the signature and increment the sequence. If the account doesn't have
 // a pubkey, set it.
 func processSig(
@@ -273,6 +314,8 @@ func ProcessPubKey(acc Account, sig StdSignature, simulate bool) (crypto.PubKey,
 		return pubKey, sdk.Result{}
 	}
 
+	// The address of an account only needs to match its first PubKey, a
+	// PubKey set by MsgChangePubKey replaces it and keeps the address.
 	if pubKey == nil {
 		pubKey = sig.PubKey
 		if pubKey == nil {
@@ -297,7 +340,7 @@ func DefaultSigVerificationGasConsumer(
 	switch pubkey := pubkey.(type) {
 	case ed25519.PubKeyEd25519:
 		meter.ConsumeGas(params.SigVerifyCostED25519, "ante verify: ed25519")
-		return sdk.ErrInvalidPubKey("ED25519 public keys are unsupported").Result()
+		return sdk.Result{}
 
 	case secp256k1.PubKeySecp256k1:
 		meter.ConsumeGas(params.SigVerifyCostSecp256k1, "ante verify: secp256k1")
@@ -366,6 +409,31 @@ func DeductFees(supplyKeeper types.SupplyKeeper, ctx sdk.Context, acc Account, f
 	return sdk.Result{}
 }
 
+// DeductGrantedFees deducts the fees from the fee granter, using up the fee
+// allowance it granted to the fee payer.
+func DeductGrantedFees(ak AccountKeeper, supplyKeeper types.SupplyKeeper, feeGrantKeeper types.FeeGrantKeeper,
+	ctx sdk.Context, fee StdFee, payer sdk.AccAddress) sdk.Result {
+
+	if feeGrantKeeper == nil {
+		return sdk.ErrUnknownRequest("fee grants are not supported").Result()
+	}
+
+	granterAcc, res := GetSignerAcc(ctx, ak, fee.Granter)
+	if !res.IsOK() {
+		return res
+	}
+
+	err := feeGrantKeeper.UseGrantedFees(ctx, fee.Granter, payer, fee.Amount)
+	if err != nil {
+		return err.Result()
+	}
+
+	if fee.Amount.IsZero() {
+		return sdk.Result{}
+	}
+	return DeductFees(supplyKeeper, ctx, granterAcc, fee.Amount)
+}
+
 // EnsureSufficientMempoolFees verifies that the given transaction has supplied
 // enough fees to cover a proposer's minimum fees. A result object is returned
 // indicating success or failure.
@@ -373,7 +441,14 @@ func DeductFees(supplyKeeper types.SupplyKeeper, ctx sdk.Context, acc Account, f
 // Contract: This should only be called during CheckTx as it cannot be part of
 // consensus.
 func EnsureSufficientMempoolFees(ctx sdk.Context, stdFee StdFee) sdk.Result {
-	minGasPrices := ctx.MinGasPrices()
+	return EnsureSufficientFees(stdFee, ctx.MinGasPrices(), nil)
+}
+
+// EnsureSufficientFees verifies that the given fee covers the minimum gas
+// prices. Without enough fees in any of the denoms of the minimum gas prices,
+// the fees paid in the base denom and fee tokens are valued at their exchange
+// rate and compared to the cheapest of the required fees that has one.
+func EnsureSufficientFees(stdFee StdFee, minGasPrices sdk.DecCoins, feeTokens FeeTokens) sdk.Result {
 	if !minGasPrices.IsZero() {
 		requiredFees := make(sdk.Coins, len(minGasPrices))
 
@@ -385,7 +460,7 @@ func EnsureSufficientMempoolFees(ctx sdk.Context, stdFee StdFee) sdk.Result {
 			requiredFees[i] = sdk.NewCoin(gp.Denom, fee.Ceil().RoundInt())
 		}
 
-		if !stdFee.Amount.IsAnyGTE(requiredFees) {
+		if !stdFee.Amount.IsAnyGTE(requiredFees) && !coversFeeValue(stdFee.Amount, requiredFees, feeTokens) {
 			return sdk.ErrInsufficientFee(
 				fmt.Sprintf(
 					"insufficient fees; got: %q required: %q", stdFee.Amount, requiredFees,
@@ -397,6 +472,28 @@ func EnsureSufficientMempoolFees(ctx sdk.Context, stdFee StdFee) sdk.Result {
 	return sdk.Result{}
 }
 
+// coversFeeValue returns true if the value of the fees in the base denom is at
+// least the value of the cheapest of the required fees with an exchange rate.
+func coversFeeValue(fees, requiredFees sdk.Coins, feeTokens FeeTokens) bool {
+	if len(feeTokens) == 0 {
+		return false
+	}
+
+	var minRequired sdk.Dec
+	for _, required := range requiredFees {
+		rate, ok := feeTokens.RateOf(required.Denom)
+		if !ok {
+			continue
+		}
+		value := rate.MulInt(required.Amount)
+		if minRequired.IsNil() || value.LT(minRequired) {
+			minRequired = value
+		}
+	}
+
+	return !minRequired.IsNil() && feeTokens.ValueOf(fees).GTE(minRequired)
+}
+
 // SetGasMeter returns a new context with a gas meter set from a given context.
 func SetGasMeter(simulate bool, ctx sdk.Context, gasLimit uint64) sdk.Context {
 	// In various cases such as simulation and during the genesis block, we do not
@@ -417,6 +514,6 @@ func GetSignBytes(chainID string, stdTx StdTx, acc Account, genesis bool) []byte
 	}
 
 	return StdSignBytes(
-		chainID, accNum, acc.GetSequence(), stdTx.Fee, stdTx.Msgs, stdTx.Memo,
+		chainID, accNum, acc.GetSequence(), stdTx.TimeoutHeight, stdTx.Fee, stdTx.Msgs, stdTx.Memo,
 	)
 }
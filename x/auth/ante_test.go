@@ -472,7 +472,7 @@ func TestAnteHandlerBadSignBytes(t *testing.T) {
 	for _, cs := range cases {
 		tx := types.NewTestTxWithSignBytes(
 			msgs, privs, accnums, seqs, fee,
-			StdSignBytes(cs.chainID, cs.accnum, cs.seq, cs.fee, cs.msgs, ""),
+			StdSignBytes(cs.chainID, cs.accnum, cs.seq, 0, cs.fee, cs.msgs, ""),
 			"",
 		)
 		checkInvalidTx(t, anteHandler, ctx, tx, false, cs.code)
@@ -555,6 +555,11 @@ func TestProcessPubKey(t *testing.T) {
 
 	acc2.SetPubKey(priv2.PubKey())
 
+	// account whose pubkey was changed to a key of another address
+	_, _, addr3 := types.KeyTestPubAddr()
+	acc3 := input.ak.NewAccountWithAddress(ctx, addr3)
+	acc3.SetPubKey(priv2.PubKey())
+
 	type args struct {
 		acc      Account
 		sig      StdSignature
@@ -570,6 +575,7 @@ func TestProcessPubKey(t *testing.T) {
 		{"no sigs, account with pub, simulate on", args{acc2, StdSignature{}, true}, false},
 		{"pubkey doesn't match addr, simulate off", args{acc1, StdSignature{PubKey: priv2.PubKey()}, false}, true},
 		{"pubkey doesn't match addr, simulate on", args{acc1, StdSignature{PubKey: priv2.PubKey()}, true}, false},
+		{"rotated pubkey doesn't match addr, simulate off", args{acc3, StdSignature{PubKey: priv2.PubKey()}, false}, false},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -603,7 +609,7 @@ func TestConsumeSignatureVerificationGas(t *testing.T) {
 		gasConsumed uint64
 		shouldErr   bool
 	}{
-		{"PubKeyEd25519", args{sdk.NewInfiniteGasMeter(), nil, ed25519.GenPrivKey().PubKey(), params}, DefaultSigVerifyCostED25519, true},
+		{"PubKeyEd25519", args{sdk.NewInfiniteGasMeter(), nil, ed25519.GenPrivKey().PubKey(), params}, DefaultSigVerifyCostED25519, false},
 		{"PubKeySecp256k1", args{sdk.NewInfiniteGasMeter(), nil, secp256k1.GenPrivKey().PubKey(), params}, DefaultSigVerifyCostSecp256k1, false},
 		{"Multisig", args{sdk.NewInfiniteGasMeter(), multisignature1.Marshal(), multisigKey1, params}, expectedCost1, false},
 		{"unknown key", args{sdk.NewInfiniteGasMeter(), nil, nil, params}, 0, true},
@@ -775,6 +781,47 @@ func TestEnsureSufficientMempoolFees(t *testing.T) {
 	}
 }
 
+func TestEnsureSufficientFeesWithFeeTokens(t *testing.T) {
+	minGasPrices := sdk.DecCoins{
+		sdk.NewDecCoinFromDec(FeeBaseDenom, sdk.NewDecWithPrec(1, 2)), // 0.01uscds
+	}
+	feeTokens := FeeTokens{
+		NewFeeToken("btc", sdk.NewDec(1000)),
+		NewFeeToken("cny", sdk.NewDecWithPrec(5, 1)),
+	}
+
+	testCases := []struct {
+		input      StdFee
+		expectedOK bool
+	}{
+		{NewStdFee(200000, sdk.NewCoins(sdk.NewInt64Coin(FeeBaseDenom, 2000))), true},
+		{NewStdFee(200000, sdk.NewCoins(sdk.NewInt64Coin(FeeBaseDenom, 1999))), false},
+		{NewStdFee(200000, sdk.NewCoins(sdk.NewInt64Coin("btc", 2))), true},
+		{NewStdFee(200000, sdk.NewCoins(sdk.NewInt64Coin("btc", 1))), false},
+		{NewStdFee(200000, sdk.NewCoins(sdk.NewInt64Coin("cny", 4000))), true},
+		{NewStdFee(200000, sdk.NewCoins(sdk.NewInt64Coin("cny", 3999))), false},
+		{NewStdFee(200000, sdk.NewCoins(sdk.NewInt64Coin("btc", 1), sdk.NewInt64Coin("cny", 2000))), true},
+		{NewStdFee(200000, sdk.NewCoins(sdk.NewInt64Coin("atom", 100000))), false},
+	}
+
+	for i, tc := range testCases {
+		res := EnsureSufficientFees(tc.input, minGasPrices, feeTokens)
+		require.Equal(
+			t, tc.expectedOK, res.IsOK(),
+			"unexpected result; tc #%d, input: %v, log: %v", i, tc.input, res.Log,
+		)
+	}
+
+	// fee tokens are only accepted with an exchange rate
+	res := EnsureSufficientFees(NewStdFee(200000, sdk.NewCoins(sdk.NewInt64Coin("btc", 2))), minGasPrices, nil)
+	require.False(t, res.IsOK())
+
+	// the fees are not valued without a minimum gas price in a fee token
+	stakePrices := sdk.DecCoins{sdk.NewDecCoinFromDec("stake", sdk.NewDecWithPrec(1, 2))}
+	res = EnsureSufficientFees(NewStdFee(200000, sdk.NewCoins(sdk.NewInt64Coin("btc", 2))), stakePrices, feeTokens)
+	require.False(t, res.IsOK())
+}
+
 // Test custom SignatureVerificationGasConsumer
 func TestCustomSignatureVerificationGasConsumer(t *testing.T) {
 	// setup
@@ -820,3 +867,161 @@ func TestCustomSignatureVerificationGasConsumer(t *testing.T) {
 	tx = types.NewTestTx(ctx, msgs, privs, accnums, seqs, fee)
 	checkValidTx(t, anteHandler, ctx, tx, false)
 }
+
+// mockFeeGrantKeeper allows a single grantee to spend up to limit in fees
+// granted by a single granter.
+type mockFeeGrantKeeper struct {
+	granter, grantee sdk.AccAddress
+	limit            sdk.Coins
+}
+
+func (k *mockFeeGrantKeeper) UseGrantedFees(_ sdk.Context, granter, grantee sdk.AccAddress, fee sdk.Coins) sdk.Error {
+	if !granter.Equals(k.granter) || !grantee.Equals(k.grantee) {
+		return sdk.ErrUnauthorized("no fee allowance")
+	}
+	left, hasNeg := k.limit.SafeSub(fee)
+	if hasNeg {
+		return sdk.ErrInsufficientFunds("fee limit exceeded")
+	}
+	k.limit = left
+	return nil
+}
+
+// Test fees paid from a fee allowance of the fee granter.
+func TestAnteHandlerGrantedFees(t *testing.T) {
+	// setup
+	input := setupTestInput()
+	ctx := input.ctx
+
+	// keys and addresses
+	priv1, _, addr1 := types.KeyTestPubAddr()
+	_, _, addr2 := types.KeyTestPubAddr()
+	_, _, addr3 := types.KeyTestPubAddr()
+
+	// set the accounts, the grantee has no funds
+	acc1 := input.ak.NewAccountWithAddress(ctx, addr1)
+	input.ak.SetAccount(ctx, acc1)
+	acc2 := input.ak.NewAccountWithAddress(ctx, addr2)
+	acc2.SetCoins(sdk.NewCoins(sdk.NewInt64Coin("atom", 300)))
+	input.ak.SetAccount(ctx, acc2)
+
+	fgk := &mockFeeGrantKeeper{granter: addr2, grantee: addr1, limit: sdk.NewCoins(sdk.NewInt64Coin("atom", 200))}
+	anteHandler := NewAnteHandlerWithFeeGrant(input.ak, input.sk, fgk, DefaultSigVerificationGasConsumer)
+
+	// msg and signatures
+	var tx sdk.Tx
+	msg := types.NewTestMsg(addr1)
+	privs, accnums, seqs := []crypto.PrivKey{priv1}, []uint64{0}, []uint64{0}
+	msgs := []sdk.Msg{msg}
+
+	// fee grants are rejected without a fee grant keeper
+	fee := types.NewTestStdFee().WithGranter(addr2)
+	tx = types.NewTestTx(ctx, msgs, privs, accnums, seqs, fee)
+	checkInvalidTx(t, NewAnteHandler(input.ak, input.sk, DefaultSigVerificationGasConsumer), ctx, tx, false, sdk.CodeUnknownRequest)
+
+	// no allowance from the granter
+	tx = types.NewTestTx(ctx, msgs, privs, accnums, seqs, types.NewTestStdFee().WithGranter(addr3))
+	checkInvalidTx(t, anteHandler, ctx, tx, false, sdk.CodeUnknownAddress)
+
+	// the granter pays the fee from the allowance
+	tx = types.NewTestTx(ctx, msgs, privs, accnums, seqs, fee)
+	checkValidTx(t, anteHandler, ctx, tx, false)
+
+	require.True(sdk.IntEq(t, input.sk.GetModuleAccount(ctx, types.FeeCollectorName).GetCoins().AmountOf("atom"), sdk.NewInt(150)))
+	require.True(sdk.IntEq(t, input.ak.GetAccount(ctx, addr2).GetCoins().AmountOf("atom"), sdk.NewInt(150)))
+	require.True(t, input.ak.GetAccount(ctx, addr1).GetCoins().Empty())
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("atom", 50)), fgk.limit)
+
+	// the allowance is used up
+	seqs = []uint64{1}
+	tx = types.NewTestTx(ctx, msgs, privs, accnums, seqs, fee)
+	checkInvalidTx(t, anteHandler, ctx, tx, false, sdk.CodeInsufficientFunds)
+}
+
+// Test the consensus minimum gas prices in CheckTx and DeliverTx.
+func TestAnteHandlerConsensusMinGasPrices(t *testing.T) {
+	// setup
+	input := setupTestInput()
+	ctx := input.ctx.WithBlockHeight(1)
+	anteHandler := NewAnteHandler(input.ak, input.sk, DefaultSigVerificationGasConsumer)
+
+	params := input.ak.GetParams(ctx)
+	params.MinGasPrices = sdk.DecCoins{sdk.NewDecCoinFromDec("atom", sdk.NewDecWithPrec(1, 2))}
+	input.ak.SetParams(ctx, params)
+
+	// keys and addresses
+	priv1, _, addr1 := types.KeyTestPubAddr()
+	acc1 := input.ak.NewAccountWithAddress(ctx, addr1)
+	acc1.SetCoins(sdk.NewCoins(sdk.NewInt64Coin("atom", 1000)))
+	input.ak.SetAccount(ctx, acc1)
+
+	msgs := []sdk.Msg{types.NewTestMsg(addr1)}
+	privs, accnums, seqs := []crypto.PrivKey{priv1}, []uint64{0}, []uint64{0}
+
+	// 0.01atom * 50000 gas requires 500atom in DeliverTx as well
+	fee := NewStdFee(50000, sdk.NewCoins(sdk.NewInt64Coin("atom", 499)))
+	tx := types.NewTestTx(ctx, msgs, privs, accnums, seqs, fee)
+	checkInvalidTx(t, anteHandler, ctx, tx, false, sdk.CodeInsufficientFee)
+
+	// a lower local minimum doesn't lower the floor
+	checkCtx := ctx.WithIsCheckTx(true).WithMinGasPrices(
+		sdk.DecCoins{sdk.NewDecCoinFromDec("stake", sdk.NewDecWithPrec(1, 6))},
+	)
+	fee = NewStdFee(50000, sdk.NewCoins(sdk.NewInt64Coin("atom", 499), sdk.NewInt64Coin("stake", 1)))
+	tx = types.NewTestTx(ctx, msgs, privs, accnums, seqs, fee)
+	checkInvalidTx(t, anteHandler, checkCtx, tx, false, sdk.CodeInsufficientFee)
+
+	// a higher local minimum raises it
+	checkCtx = checkCtx.WithMinGasPrices(sdk.DecCoins{sdk.NewDecCoinFromDec("atom", sdk.NewDecWithPrec(2, 2))})
+	fee = NewStdFee(50000, sdk.NewCoins(sdk.NewInt64Coin("atom", 500)))
+	tx = types.NewTestTx(ctx, msgs, privs, accnums, seqs, fee)
+	checkInvalidTx(t, anteHandler, checkCtx, tx, false, sdk.CodeInsufficientFee)
+
+	checkValidTx(t, anteHandler, ctx, tx, false)
+}
+
+// Test the tx timeout height.
+func TestAnteHandlerTimeoutHeight(t *testing.T) {
+	// setup
+	input := setupTestInput()
+	ctx := input.ctx.WithBlockHeight(10)
+	anteHandler := NewAnteHandler(input.ak, input.sk, DefaultSigVerificationGasConsumer)
+
+	// keys and addresses
+	priv1, _, addr1 := types.KeyTestPubAddr()
+	acc1 := input.ak.NewAccountWithAddress(ctx, addr1)
+	acc1.SetCoins(types.NewTestCoins())
+	input.ak.SetAccount(ctx, acc1)
+
+	msgs := []sdk.Msg{types.NewTestMsg(addr1)}
+	privs, accnums, seqs := []crypto.PrivKey{priv1}, []uint64{0}, []uint64{0}
+	fee := types.NewTestStdFee()
+
+	// the timeout height is part of the sign bytes
+	var tx sdk.Tx = types.NewTestTx(ctx, msgs, privs, accnums, seqs, fee).(types.StdTx).WithTimeoutHeight(10)
+	checkInvalidTx(t, anteHandler, ctx, tx, false, sdk.CodeUnauthorized)
+
+	// timed out
+	tx = newTestTxWithTimeout(ctx, msgs, privs, accnums, seqs, fee, 9)
+	checkInvalidTx(t, anteHandler, ctx, tx, false, sdk.CodeTxTimeoutHeight)
+
+	// the timeout height is the last height the tx can be included in
+	tx = newTestTxWithTimeout(ctx, msgs, privs, accnums, seqs, fee, 10)
+	checkValidTx(t, anteHandler, ctx, tx, false)
+}
+
+func newTestTxWithTimeout(ctx sdk.Context, msgs []sdk.Msg, privs []crypto.PrivKey,
+	accNums, seqs []uint64, fee StdFee, timeoutHeight uint64) sdk.Tx {
+
+	sigs := make([]StdSignature, len(privs))
+	for i, priv := range privs {
+		signBytes := StdSignBytes(ctx.ChainID(), accNums[i], seqs[i], timeoutHeight, fee, msgs, "")
+		sig, err := priv.Sign(signBytes)
+		if err != nil {
+			panic(err)
+		}
+		sigs[i] = StdSignature{PubKey: priv.PubKey(), Signature: sig}
+	}
+
+	return NewStdTx(msgs, fee, sigs, "").WithTimeoutHeight(timeoutHeight)
+}
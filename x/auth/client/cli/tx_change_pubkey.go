@@ -0,0 +1,55 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/shinecloudfoundation/shinecloudnet/client"
+	"github.com/shinecloudfoundation/shinecloudnet/client/context"
+	"github.com/shinecloudfoundation/shinecloudnet/codec"
+	sdk "github.com/shinecloudfoundation/shinecloudnet/types"
+	"github.com/shinecloudfoundation/shinecloudnet/version"
+	"github.com/shinecloudfoundation/shinecloudnet/x/auth/client/utils"
+	"github.com/shinecloudfoundation/shinecloudnet/x/auth/types"
+)
+
+// GetChangePubKeyCommand returns the change pubkey command
+func GetChangePubKeyCommand(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "change-pubkey [pubkey]",
+		Short: "Replace the public key of your account",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Replace the public key of the account of the sender, keeping its address.
+The transaction is signed with the current key of the account, later transactions
+must be signed with the new one. A multisig public key turns the account into a
+multisig account.
+
+Example:
+$ %s tx %s change-pubkey scloudpub1addwnpepq... --from=mykey
+`,
+				version.ClientName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBldr := types.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			pubKey, err := sdk.GetAccPubKeyBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgChangePubKey(cliCtx.GetFromAddress(), pubKey)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	return client.PostCommands(cmd)[0]
+}
@@ -101,7 +101,7 @@ func makeMultiSignCmd(cdc *codec.Codec) func(cmd *cobra.Command, args []string)
 
 			// Validate each signature
 			sigBytes := types.StdSignBytes(
-				txBldr.ChainID(), txBldr.AccountNumber(), txBldr.Sequence(),
+				txBldr.ChainID(), txBldr.AccountNumber(), txBldr.Sequence(), stdTx.TimeoutHeight,
 				stdTx.Fee, stdTx.GetMsgs(), stdTx.GetMemo(),
 			)
 			if ok := stdSig.PubKey.VerifyBytes(sigBytes, stdSig.Signature); !ok {
@@ -113,7 +113,8 @@ func makeMultiSignCmd(cdc *codec.Codec) func(cmd *cobra.Command, args []string)
 		}
 
 		newStdSig := types.StdSignature{Signature: cdc.MustMarshalBinaryBare(multisigSig), PubKey: multisigPub}
-		newTx := types.NewStdTx(stdTx.GetMsgs(), stdTx.Fee, []types.StdSignature{newStdSig}, stdTx.GetMemo())
+		newTx := types.NewStdTx(stdTx.GetMsgs(), stdTx.Fee, []types.StdSignature{newStdSig}, stdTx.GetMemo()).
+			WithTimeoutHeight(stdTx.TimeoutHeight)
 
 		sigOnly := viper.GetBool(flagSigOnly)
 		var json []byte
@@ -0,0 +1,69 @@
+package auth
+
+import (
+	"fmt"
+
+	sdk "github.com/shinecloudfoundation/shinecloudnet/types"
+	"github.com/shinecloudfoundation/shinecloudnet/x/auth/types"
+)
+
+// NewHandler returns a handler for "auth" type messages.
+func NewHandler(ak AccountKeeper) sdk.Handler {
+	return func(ctx sdk.Context, msg sdk.Msg) sdk.Result {
+		ctx = ctx.WithEventManager(sdk.NewEventManager())
+
+		switch msg := msg.(type) {
+		case types.MsgChangePubKey:
+			return handleMsgChangePubKey(ctx, ak, msg)
+
+		default:
+			errMsg := fmt.Sprintf("unrecognized auth message type: %T", msg)
+			return sdk.ErrUnknownRequest(errMsg).Result()
+		}
+	}
+}
+
+// handleMsgChangePubKey replaces the PubKey of an account. The signature of
+// the tx has already been verified with the current PubKey by the ante
+// handler.
+func handleMsgChangePubKey(ctx sdk.Context, ak AccountKeeper, msg types.MsgChangePubKey) sdk.Result {
+	acc := ak.GetAccount(ctx, msg.Address)
+	if acc == nil {
+		return sdk.ErrUnknownAddress(fmt.Sprintf("account %s does not exist", msg.Address)).Result()
+	}
+
+	params := ak.GetParams(ctx)
+	ctx.GasMeter().ConsumeGas(params.PubKeyChangeCost, "pubkey change")
+
+	// the account must still be able to sign txs with the new key
+	if sigCount := types.CountSubKeys(msg.PubKey); uint64(sigCount) > params.TxSigLimit {
+		return sdk.ErrTooManySignatures(
+			fmt.Sprintf("signatures: %d, limit: %d", sigCount, params.TxSigLimit),
+		).Result()
+	}
+
+	if err := acc.SetPubKey(msg.PubKey); err != nil {
+		return sdk.ErrInternal(err.Error()).Result()
+	}
+	ak.SetAccount(ctx, acc)
+
+	pubKey, err := sdk.Bech32ifyAccPub(msg.PubKey)
+	if err != nil {
+		return sdk.ErrInternal(err.Error()).Result()
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeChangePubKey,
+			sdk.NewAttribute(types.AttributeKeyAddress, msg.Address.String()),
+			sdk.NewAttribute(types.AttributeKeyPubKey, pubKey),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Address.String()),
+		),
+	})
+
+	return sdk.Result{Events: ctx.EventManager().Events()}
+}
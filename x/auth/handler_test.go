@@ -0,0 +1,92 @@
+package auth
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+	"github.com/tendermint/tendermint/crypto/multisig"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+
+	sdk "github.com/shinecloudfoundation/shinecloudnet/types"
+	"github.com/shinecloudfoundation/shinecloudnet/x/auth/types"
+)
+
+func TestHandleMsgChangePubKey(t *testing.T) {
+	input := setupTestInput()
+	ctx := input.ctx.WithBlockHeight(1)
+	handler := NewHandler(input.ak)
+	anteHandler := NewAnteHandler(input.ak, input.sk, DefaultSigVerificationGasConsumer)
+
+	priv1, _, addr1 := types.KeyTestPubAddr()
+	priv2, _, _ := types.KeyTestPubAddr()
+
+	acc := input.ak.NewAccountWithAddress(ctx, addr1)
+	acc.SetCoins(types.NewTestCoins())
+	require.NoError(t, acc.SetPubKey(priv1.PubKey()))
+	input.ak.SetAccount(ctx, acc)
+
+	// unknown account
+	_, _, addr2 := types.KeyTestPubAddr()
+	res := handler(ctx, types.NewMsgChangePubKey(addr2, priv2.PubKey()))
+	require.Equal(t, sdk.CodeUnknownAddress, res.Code)
+
+	// the new key can't sign more than the sig limit
+	pubKeys := make([]crypto.PubKey, DefaultTxSigLimit+1)
+	for i := range pubKeys {
+		pubKeys[i] = secp256k1.GenPrivKey().PubKey()
+	}
+	res = handler(ctx, types.NewMsgChangePubKey(addr1, multisig.NewPubKeyMultisigThreshold(2, pubKeys)))
+	require.Equal(t, sdk.CodeTooManySignatures, res.Code)
+
+	gasBefore := ctx.GasMeter().GasConsumed()
+	res = handler(ctx, types.NewMsgChangePubKey(addr1, priv2.PubKey()))
+	require.True(t, res.IsOK(), res.Log)
+	require.True(t, ctx.GasMeter().GasConsumed()-gasBefore >= DefaultPubKeyChangeCost)
+	require.Equal(t, types.EventTypeChangePubKey, res.Events[0].Type)
+
+	acc = input.ak.GetAccount(ctx, addr1)
+	require.Equal(t, addr1, acc.GetAddress())
+	require.Equal(t, priv2.PubKey(), acc.GetPubKey())
+
+	// txs of the account must be signed with the new key
+	msgs := []sdk.Msg{types.NewTestMsg(addr1)}
+	fee := types.NewTestStdFee()
+	tx := types.NewTestTx(ctx, msgs, []crypto.PrivKey{priv1}, []uint64{0}, []uint64{0}, fee)
+	checkInvalidTx(t, anteHandler, ctx, tx, false, sdk.CodeUnauthorized)
+
+	tx = types.NewTestTx(ctx, msgs, []crypto.PrivKey{priv2}, []uint64{0}, []uint64{0}, fee)
+	checkValidTx(t, anteHandler, ctx, tx, false)
+
+	// the account can be rotated to a multisig key which then signs its txs
+	privs := []crypto.PrivKey{secp256k1.GenPrivKey(), secp256k1.GenPrivKey()}
+	pubKeys = []crypto.PubKey{privs[0].PubKey(), privs[1].PubKey()}
+	multisigKey := multisig.NewPubKeyMultisigThreshold(2, pubKeys)
+	msg := types.NewMsgChangePubKey(addr1, multisigKey)
+	require.NoError(t, msg.ValidateBasic())
+	res = handler(ctx, msg)
+	require.True(t, res.IsOK(), res.Log)
+
+	signBytes := types.StdSignBytes(ctx.ChainID(), 0, 1, 0, fee, msgs, "")
+	multisignature := multisig.NewMultisig(len(privs))
+	for _, priv := range privs {
+		sig, err := priv.Sign(signBytes)
+		require.NoError(t, err)
+		require.NoError(t, multisignature.AddSignatureFromPubKey(sig, priv.PubKey(), pubKeys))
+	}
+	tx = types.NewStdTx(msgs, fee, []types.StdSignature{
+		{PubKey: multisigKey, Signature: multisignature.Marshal()},
+	}, "")
+	checkValidTx(t, anteHandler, ctx, tx, false)
+
+	// and to an ed25519 key, whose address doesn't match the account either
+	edPriv := ed25519.GenPrivKey()
+	require.NotEqual(t, addr1, sdk.AccAddress(edPriv.PubKey().Address()))
+	res = handler(ctx, types.NewMsgChangePubKey(addr1, edPriv.PubKey()))
+	require.True(t, res.IsOK(), res.Log)
+
+	tx = types.NewTestTx(ctx, msgs, []crypto.PrivKey{edPriv}, []uint64{0}, []uint64{2}, fee)
+	checkValidTx(t, anteHandler, ctx, tx, false)
+	require.Equal(t, uint64(3), input.ak.GetAccount(ctx, addr1).GetSequence())
+}
@@ -177,9 +177,11 @@ func (ak AccountKeeper) SetParams(ctx sdk.Context, params types.Params) {
 	ak.paramSubspace.SetParamSet(ctx, &params)
 }
 
-// GetParams gets the auth module's parameters.
+// GetParams gets the auth module's parameters. Parameters added after genesis
+// keep their default values until they are set.
 func (ak AccountKeeper) GetParams(ctx sdk.Context) (params types.Params) {
-	ak.paramSubspace.GetParamSet(ctx, &params)
+	params = types.DefaultParams()
+	ak.paramSubspace.GetParamSetIfExists(ctx, &params)
 	return
 }
 
@@ -88,10 +88,10 @@ func (AppModule) Name() string {
 func (AppModule) RegisterInvariants(_ sdk.InvariantRegistry) {}
 
 // module message route name
-func (AppModule) Route() string { return "" }
+func (AppModule) Route() string { return types.RouterKey }
 
 // module handler
-func (AppModule) NewHandler() sdk.Handler { return nil }
+func (am AppModule) NewHandler() sdk.Handler { return NewHandler(am.accountKeeper) }
 
 // module querier route name
 func (AppModule) QuerierRoute() string {
@@ -3,6 +3,7 @@ package types
 import (
 	"errors"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/tendermint/tendermint/crypto"
@@ -535,3 +536,191 @@ func (dva *DelayedVestingAccount) GetStartTime() int64 {
 func (dva *DelayedVestingAccount) GetEndTime() int64 {
 	return dva.EndTime
 }
+
+//-----------------------------------------------------------------------------
+// Periodic Vesting Account
+
+var _ exported.VestingAccount = (*PeriodicVestingAccount)(nil)
+
+// Period defines a length of time and the amount of coins that vest at its end
+type Period struct {
+	Length int64     `json:"length" yaml:"length"` // length of the period, in seconds
+	Amount sdk.Coins `json:"amount" yaml:"amount"` // amount of coins vesting at the end of the period
+}
+
+// NewPeriod creates a new Period object
+func NewPeriod(length int64, amount sdk.Coins) Period {
+	return Period{
+		Length: length,
+		Amount: amount,
+	}
+}
+
+func (p Period) String() string {
+	return fmt.Sprintf("%ds:%s", p.Length, p.Amount)
+}
+
+// Periods is a vesting schedule of consecutive periods
+type Periods []Period
+
+// TotalLength returns the summed length of the periods
+func (ps Periods) TotalLength() int64 {
+	var total int64
+	for _, p := range ps {
+		total += p.Length
+	}
+	return total
+}
+
+// TotalAmount returns the summed amount of the periods
+func (ps Periods) TotalAmount() sdk.Coins {
+	total := sdk.Coins{}
+	for _, p := range ps {
+		total = total.Add(p.Amount)
+	}
+	return total
+}
+
+// Validate checks that the periods have positive lengths and valid amounts
+func (ps Periods) Validate() error {
+	for i, p := range ps {
+		if p.Length <= 0 {
+			return fmt.Errorf("vesting period %d length should be positive, is %d", i, p.Length)
+		}
+		if !p.Amount.IsValid() {
+			return fmt.Errorf("vesting period %d amount is invalid: %s", i, p.Amount)
+		}
+	}
+	return nil
+}
+
+func (ps Periods) String() string {
+	periods := make([]string, len(ps))
+	for i, p := range ps {
+		periods[i] = p.String()
+	}
+	return "[" + strings.Join(periods, " ") + "]"
+}
+
+// PeriodicVestingAccount implements the VestingAccount interface. It vests
+// the amount of each period at its end, starting from StartTime.
+type PeriodicVestingAccount struct {
+	*BaseVestingAccount
+
+	StartTime      int64   `json:"start_time"`      // when the first period starts
+	VestingPeriods Periods `json:"vesting_periods"` // consecutive periods of the vesting schedule
+}
+
+// NewPeriodicVestingAccountRaw creates a new PeriodicVestingAccount object from BaseVestingAccount
+func NewPeriodicVestingAccountRaw(bva *BaseVestingAccount,
+	startTime int64, periods Periods) *PeriodicVestingAccount {
+
+	return &PeriodicVestingAccount{
+		BaseVestingAccount: bva,
+		StartTime:          startTime,
+		VestingPeriods:     periods,
+	}
+}
+
+// NewPeriodicVestingAccount returns a new PeriodicVestingAccount vesting the
+// total amount of the periods
+func NewPeriodicVestingAccount(
+	baseAcc *BaseAccount, StartTime int64, periods Periods,
+) *PeriodicVestingAccount {
+
+	baseVestingAcc := &BaseVestingAccount{
+		BaseAccount:     baseAcc,
+		OriginalVesting: periods.TotalAmount(),
+		EndTime:         StartTime + periods.TotalLength(),
+	}
+
+	return &PeriodicVestingAccount{
+		BaseVestingAccount: baseVestingAcc,
+		StartTime:          StartTime,
+		VestingPeriods:     periods,
+	}
+}
+
+func (pva PeriodicVestingAccount) String() string {
+	var pubkey string
+
+	if pva.PubKey != nil {
+		pubkey = sdk.MustBech32ifyAccPub(pva.PubKey)
+	}
+
+	return fmt.Sprintf(`Periodic Vesting Account:
+  Address:          %s
+  Pubkey:           %s
+  Coins:            %s
+  AccountNumber:    %d
+  Sequence:         %d
+  OriginalVesting:  %s
+  DelegatedFree:    %s
+  DelegatedVesting: %s
+  StartTime:        %d
+  EndTime:          %d
+  VestingPeriods:   %s `,
+		pva.Address, pubkey, pva.Coins, pva.AccountNumber, pva.Sequence,
+		pva.OriginalVesting, pva.DelegatedFree, pva.DelegatedVesting,
+		pva.StartTime, pva.EndTime, pva.VestingPeriods,
+	)
+}
+
+// GetVestedCoins returns the total amount of vested coins for a periodic
+// vesting account, which is the amount of the periods that have ended. If no
+// coins are vested, nil is returned.
+func (pva PeriodicVestingAccount) GetVestedCoins(blockTime time.Time) sdk.Coins {
+	var vestedCoins sdk.Coins
+
+	if blockTime.Unix() <= pva.StartTime {
+		return vestedCoins
+	} else if blockTime.Unix() >= pva.EndTime {
+		return pva.OriginalVesting
+	}
+
+	periodEnd := pva.StartTime
+	for _, period := range pva.VestingPeriods {
+		periodEnd += period.Length
+		if blockTime.Unix() < periodEnd {
+			break
+		}
+		vestedCoins = vestedCoins.Add(period.Amount)
+	}
+
+	return vestedCoins
+}
+
+// GetVestingCoins returns the total number of vesting coins for a periodic
+// vesting account.
+func (pva PeriodicVestingAccount) GetVestingCoins(blockTime time.Time) sdk.Coins {
+	return pva.OriginalVesting.Sub(pva.GetVestedCoins(blockTime))
+}
+
+// SpendableCoins returns the total number of spendable coins for a periodic
+// vesting account.
+func (pva PeriodicVestingAccount) SpendableCoins(blockTime time.Time) sdk.Coins {
+	return pva.spendableCoins(pva.GetVestingCoins(blockTime))
+}
+
+// TrackDelegation tracks a desired delegation amount by setting the appropriate
+// values for the amount of delegated vesting, delegated free, and reducing the
+// overall amount of base coins.
+func (pva *PeriodicVestingAccount) TrackDelegation(blockTime time.Time, amount sdk.Coins) {
+	pva.trackDelegation(pva.GetVestingCoins(blockTime), amount)
+}
+
+// GetStartTime returns the time when vesting starts for a periodic vesting
+// account.
+func (pva *PeriodicVestingAccount) GetStartTime() int64 {
+	return pva.StartTime
+}
+
+// GetEndTime returns the time when vesting ends for a periodic vesting account.
+func (pva *PeriodicVestingAccount) GetEndTime() int64 {
+	return pva.EndTime
+}
+
+// GetVestingPeriods returns the vesting schedule of a periodic vesting account.
+func (pva *PeriodicVestingAccount) GetVestingPeriods() Periods {
+	return pva.VestingPeriods
+}
@@ -9,6 +9,7 @@ import (
 
 	"github.com/shinecloudfoundation/shinecloudnet/codec"
 	sdk "github.com/shinecloudfoundation/shinecloudnet/types"
+	"github.com/shinecloudfoundation/shinecloudnet/x/auth/exported"
 )
 
 var (
@@ -479,3 +480,138 @@ func TestTrackUndelegationDelVestingAcc(t *testing.T) {
 	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 25)}, dva.DelegatedVesting)
 	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(feeDenom, 1000), sdk.NewInt64Coin(stakeDenom, 75)}, dva.GetCoins())
 }
+
+func TestGetVestedCoinsPeriodicVestingAcc(t *testing.T) {
+	now := tmtime.Now()
+	periods := Periods{
+		NewPeriod(int64(12*time.Hour/time.Second), sdk.Coins{sdk.NewInt64Coin(feeDenom, 500), sdk.NewInt64Coin(stakeDenom, 50)}),
+		NewPeriod(int64(6*time.Hour/time.Second), sdk.Coins{sdk.NewInt64Coin(feeDenom, 250), sdk.NewInt64Coin(stakeDenom, 25)}),
+		NewPeriod(int64(6*time.Hour/time.Second), sdk.Coins{sdk.NewInt64Coin(feeDenom, 250), sdk.NewInt64Coin(stakeDenom, 25)}),
+	}
+
+	_, _, addr := KeyTestPubAddr()
+	origCoins := sdk.Coins{sdk.NewInt64Coin(feeDenom, 1000), sdk.NewInt64Coin(stakeDenom, 100)}
+	bacc := NewBaseAccountWithAddress(addr)
+	bacc.SetCoins(origCoins)
+	pva := NewPeriodicVestingAccount(&bacc, now.Unix(), periods)
+	require.Equal(t, origCoins, pva.GetOriginalVesting())
+	require.Equal(t, now.Add(24*time.Hour).Unix(), pva.GetEndTime())
+
+	// require no coins vested at the beginning of the vesting schedule
+	require.Nil(t, pva.GetVestedCoins(now))
+
+	// require no coins vested before the end of the first period
+	require.Nil(t, pva.GetVestedCoins(now.Add(6*time.Hour)))
+
+	// require the first period vested at its end
+	require.Equal(t, periods[0].Amount, pva.GetVestedCoins(now.Add(12*time.Hour)))
+	require.Equal(t, periods[0].Amount, pva.GetVestedCoins(now.Add(15*time.Hour)))
+
+	// require two periods vested at the end of the second
+	require.Equal(t, periods[0].Amount.Add(periods[1].Amount), pva.GetVestedCoins(now.Add(18*time.Hour)))
+
+	// require all coins vested at the end of the vesting schedule
+	require.Equal(t, origCoins, pva.GetVestedCoins(now.Add(24*time.Hour)))
+	require.Nil(t, pva.GetVestingCoins(now.Add(48*time.Hour)))
+}
+
+func TestSpendableCoinsPeriodicVestingAcc(t *testing.T) {
+	now := tmtime.Now()
+	periods := Periods{
+		NewPeriod(int64(12*time.Hour/time.Second), sdk.Coins{sdk.NewInt64Coin(feeDenom, 500), sdk.NewInt64Coin(stakeDenom, 50)}),
+		NewPeriod(int64(12*time.Hour/time.Second), sdk.Coins{sdk.NewInt64Coin(feeDenom, 500), sdk.NewInt64Coin(stakeDenom, 50)}),
+	}
+
+	_, _, addr := KeyTestPubAddr()
+	origCoins := sdk.Coins{sdk.NewInt64Coin(feeDenom, 1000), sdk.NewInt64Coin(stakeDenom, 100)}
+	bacc := NewBaseAccountWithAddress(addr)
+	bacc.SetCoins(origCoins)
+	pva := NewPeriodicVestingAccount(&bacc, now.Unix(), periods)
+
+	// require that no coins are spendable in the beginning of the vesting
+	// schedule
+	require.Nil(t, pva.SpendableCoins(now))
+
+	// require that the vested coins of the first period are spendable
+	require.Equal(t, periods[0].Amount, pva.SpendableCoins(now.Add(12*time.Hour)))
+
+	// receive some coins
+	recvAmt := sdk.Coins{sdk.NewInt64Coin(stakeDenom, 50)}
+	pva.SetCoins(pva.GetCoins().Add(recvAmt))
+
+	// require that the received coins are spendable as well
+	require.Equal(t, recvAmt, pva.SpendableCoins(now.Add(6*time.Hour)))
+	require.Equal(t, periods[0].Amount.Add(recvAmt), pva.SpendableCoins(now.Add(12*time.Hour)))
+
+	// require that all coins are spendable after the maturation of the vesting
+	// schedule
+	require.Equal(t, origCoins.Add(recvAmt), pva.SpendableCoins(now.Add(24*time.Hour)))
+}
+
+func TestTrackDelegationPeriodicVestingAcc(t *testing.T) {
+	now := tmtime.Now()
+	periods := Periods{
+		NewPeriod(int64(12*time.Hour/time.Second), sdk.Coins{sdk.NewInt64Coin(feeDenom, 500), sdk.NewInt64Coin(stakeDenom, 50)}),
+		NewPeriod(int64(12*time.Hour/time.Second), sdk.Coins{sdk.NewInt64Coin(feeDenom, 500), sdk.NewInt64Coin(stakeDenom, 50)}),
+	}
+
+	_, _, addr := KeyTestPubAddr()
+	origCoins := sdk.Coins{sdk.NewInt64Coin(feeDenom, 1000), sdk.NewInt64Coin(stakeDenom, 100)}
+	bacc := NewBaseAccountWithAddress(addr)
+
+	// require the ability to delegate all vesting coins
+	bacc.SetCoins(origCoins)
+	pva := NewPeriodicVestingAccount(&bacc, now.Unix(), periods)
+	pva.TrackDelegation(now, origCoins)
+	require.Equal(t, origCoins, pva.DelegatedVesting)
+	require.Nil(t, pva.DelegatedFree)
+	require.Nil(t, pva.GetCoins())
+
+	// require the ability to delegate all vested coins
+	bacc.SetCoins(origCoins)
+	pva = NewPeriodicVestingAccount(&bacc, now.Unix(), periods)
+	pva.TrackDelegation(now.Add(24*time.Hour), origCoins)
+	require.Nil(t, pva.DelegatedVesting)
+	require.Equal(t, origCoins, pva.DelegatedFree)
+	require.Nil(t, pva.GetCoins())
+
+	// delegate half of the coins after the first period, vested coins first
+	bacc.SetCoins(origCoins)
+	pva = NewPeriodicVestingAccount(&bacc, now.Unix(), periods)
+	pva.TrackDelegation(now.Add(12*time.Hour), sdk.Coins{sdk.NewInt64Coin(stakeDenom, 75)})
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 50)}, pva.DelegatedVesting)
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 25)}, pva.DelegatedFree)
+
+	// undelegate the vesting coins first
+	pva.TrackUndelegation(sdk.Coins{sdk.NewInt64Coin(stakeDenom, 25)})
+	require.Nil(t, pva.DelegatedFree)
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 50)}, pva.DelegatedVesting)
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(feeDenom, 1000), sdk.NewInt64Coin(stakeDenom, 50)}, pva.GetCoins())
+}
+
+func TestPeriodicVestingAccountMarshal(t *testing.T) {
+	_, _, addr := KeyTestPubAddr()
+	bacc := NewBaseAccountWithAddress(addr)
+	periods := Periods{NewPeriod(3600, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 50)})}
+	bacc.SetCoins(periods.TotalAmount())
+
+	cdc := codec.New()
+	codec.RegisterCrypto(cdc)
+	RegisterCodec(cdc)
+
+	var acc exported.Account = NewPeriodicVestingAccount(&bacc, 1000, periods)
+	bz, err := cdc.MarshalBinaryBare(acc)
+	require.NoError(t, err)
+
+	var decoded exported.Account
+	require.NoError(t, cdc.UnmarshalBinaryBare(bz, &decoded))
+	require.Equal(t, acc, decoded)
+	require.Equal(t, periods, decoded.(*PeriodicVestingAccount).GetVestingPeriods())
+}
+
+func TestPeriodsValidate(t *testing.T) {
+	amount := sdk.Coins{sdk.NewInt64Coin(stakeDenom, 50)}
+	require.NoError(t, Periods{NewPeriod(3600, amount)}.Validate())
+	require.Error(t, Periods{NewPeriod(0, amount)}.Validate())
+	require.Error(t, Periods{NewPeriod(3600, sdk.Coins{{Denom: stakeDenom, Amount: sdk.NewInt(-1)}})}.Validate())
+}
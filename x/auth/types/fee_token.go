@@ -0,0 +1,94 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/shinecloudfoundation/shinecloudnet/types"
+)
+
+// FeeBaseDenom is the denom the exchange rates of the fee tokens refer to
+const FeeBaseDenom = sdk.DefaultBondDenom
+
+// FeeToken is a token fees can be paid in besides the base denom. Rate is the
+// amount of the base denom one unit of the token is worth.
+type FeeToken struct {
+	Denom string  `json:"denom" yaml:"denom"`
+	Rate  sdk.Dec `json:"rate" yaml:"rate"`
+}
+
+// NewFeeToken creates a new FeeToken object
+func NewFeeToken(denom string, rate sdk.Dec) FeeToken {
+	return FeeToken{
+		Denom: denom,
+		Rate:  rate,
+	}
+}
+
+func (t FeeToken) String() string {
+	return fmt.Sprintf("%s:%s", t.Denom, t.Rate)
+}
+
+// FeeTokens is the list of tokens fees can be paid in
+type FeeTokens []FeeToken
+
+// Validate checks that the fee token denoms are valid and unique and that
+// the rates are positive
+func (ts FeeTokens) Validate() error {
+	denoms := make(map[string]bool, len(ts))
+	for _, t := range ts {
+		if !(sdk.Coins{sdk.Coin{Denom: t.Denom, Amount: sdk.OneInt()}}).IsValid() {
+			return fmt.Errorf("invalid fee token denom %q", t.Denom)
+		}
+		if t.Denom == FeeBaseDenom {
+			return fmt.Errorf("fee token denom can't be the base denom %s", FeeBaseDenom)
+		}
+		if denoms[t.Denom] {
+			return fmt.Errorf("duplicate fee token %s", t.Denom)
+		}
+		denoms[t.Denom] = true
+
+		if t.Rate.IsNil() || !t.Rate.IsPositive() {
+			return fmt.Errorf("fee token %s rate should be positive, is %s", t.Denom, t.Rate)
+		}
+	}
+	return nil
+}
+
+// RateOf returns the exchange rate of denom to the base denom, and false if
+// fees can't be paid in denom
+func (ts FeeTokens) RateOf(denom string) (sdk.Dec, bool) {
+	if denom == FeeBaseDenom {
+		return sdk.OneDec(), true
+	}
+	for _, t := range ts {
+		if t.Denom == denom {
+			return t.Rate, true
+		}
+	}
+	return sdk.Dec{}, false
+}
+
+// ValueOf returns the total value of the coins in the base denom, ignoring the
+// coins fees can't be paid in
+func (ts FeeTokens) ValueOf(coins sdk.Coins) sdk.Dec {
+	value := sdk.ZeroDec()
+	for _, coin := range coins {
+		if rate, ok := ts.RateOf(coin.Denom); ok {
+			value = value.Add(rate.MulInt(coin.Amount))
+		}
+	}
+	return value
+}
+
+func (ts FeeTokens) String() string {
+	if len(ts) == 0 {
+		return "[]"
+	}
+
+	tokens := make([]string, len(ts))
+	for i, t := range ts {
+		tokens[i] = t.String()
+	}
+	return "[" + strings.Join(tokens, " ") + "]"
+}
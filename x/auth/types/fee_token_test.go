@@ -0,0 +1,51 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/shinecloudfoundation/shinecloudnet/types"
+)
+
+func TestFeeTokensValidate(t *testing.T) {
+	testCases := []struct {
+		tokens     FeeTokens
+		expectPass bool
+	}{
+		{FeeTokens{}, true},
+		{FeeTokens{NewFeeToken("btc", sdk.NewDec(1000)), NewFeeToken("cny", sdk.NewDecWithPrec(5, 1))}, true},
+		{FeeTokens{NewFeeToken("BTC", sdk.NewDec(1000))}, false},
+		{FeeTokens{NewFeeToken(FeeBaseDenom, sdk.OneDec())}, false},
+		{FeeTokens{NewFeeToken("btc", sdk.NewDec(1000)), NewFeeToken("btc", sdk.NewDec(2000))}, false},
+		{FeeTokens{NewFeeToken("btc", sdk.ZeroDec())}, false},
+		{FeeTokens{NewFeeToken("btc", sdk.Dec{})}, false},
+	}
+
+	for i, tc := range testCases {
+		err := tc.tokens.Validate()
+		if tc.expectPass {
+			require.NoError(t, err, "tc #%d", i)
+		} else {
+			require.Error(t, err, "tc #%d", i)
+		}
+	}
+}
+
+func TestFeeTokensValueOf(t *testing.T) {
+	tokens := FeeTokens{NewFeeToken("btc", sdk.NewDec(1000)), NewFeeToken("cny", sdk.NewDecWithPrec(5, 1))}
+
+	coins := sdk.NewCoins(
+		sdk.NewInt64Coin("atom", 100),
+		sdk.NewInt64Coin("btc", 2),
+		sdk.NewInt64Coin("cny", 3),
+		sdk.NewInt64Coin(FeeBaseDenom, 10),
+	)
+	require.Equal(t, sdk.NewDecWithPrec(20115, 1), tokens.ValueOf(coins))
+
+	_, ok := tokens.RateOf("atom")
+	require.False(t, ok)
+	rate, ok := tokens.RateOf(FeeBaseDenom)
+	require.True(t, ok)
+	require.Equal(t, sdk.OneDec(), rate)
+}
@@ -16,6 +16,9 @@ const (
 
 	// QuerierRoute is the querier route for acc
 	QuerierRoute = StoreKey
+
+	// RouterKey is the message route for auth
+	RouterKey = ModuleName
 )
 
 var (
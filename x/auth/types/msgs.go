@@ -0,0 +1,85 @@
+package types
+
+import (
+	"fmt"
+
+	"github.com/tendermint/tendermint/crypto"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+	"github.com/tendermint/tendermint/crypto/multisig"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+
+	sdk "github.com/shinecloudfoundation/shinecloudnet/types"
+)
+
+// auth message types
+const (
+	TypeMsgChangePubKey = "change_pubkey"
+)
+
+var _ sdk.Msg = MsgChangePubKey{}
+
+// MsgChangePubKey replaces the PubKey of the account at Address, which keeps
+// its address. It must be signed with the current PubKey of the account.
+type MsgChangePubKey struct {
+	Address sdk.AccAddress `json:"address" yaml:"address"`
+	PubKey  crypto.PubKey  `json:"pub_key" yaml:"pub_key"`
+}
+
+// NewMsgChangePubKey creates a new MsgChangePubKey object
+func NewMsgChangePubKey(addr sdk.AccAddress, pubKey crypto.PubKey) MsgChangePubKey {
+	return MsgChangePubKey{
+		Address: addr,
+		PubKey:  pubKey,
+	}
+}
+
+// Route implements sdk.Msg
+func (msg MsgChangePubKey) Route() string { return RouterKey }
+
+// Type implements sdk.Msg
+func (msg MsgChangePubKey) Type() string { return TypeMsgChangePubKey }
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgChangePubKey) ValidateBasic() sdk.Error {
+	if msg.Address.Empty() {
+		return sdk.ErrInvalidAddress("missing address")
+	}
+	if msg.PubKey == nil {
+		return sdk.ErrInvalidPubKey("missing public key")
+	}
+	return validatePubKey(msg.PubKey)
+}
+
+// GetSignBytes implements sdk.Msg
+func (msg MsgChangePubKey) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners implements sdk.Msg
+func (msg MsgChangePubKey) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Address}
+}
+
+// validatePubKey checks that accounts can be given pubKey, a secp256k1 or
+// ed25519 key or a multisig threshold key made of those
+func validatePubKey(pubKey crypto.PubKey) sdk.Error {
+	switch pubKey := pubKey.(type) {
+	case secp256k1.PubKeySecp256k1, ed25519.PubKeyEd25519:
+		return nil
+
+	case multisig.PubKeyMultisigThreshold:
+		if pubKey.K == 0 || pubKey.K > uint(len(pubKey.PubKeys)) {
+			return sdk.ErrInvalidPubKey(
+				fmt.Sprintf("invalid multisig threshold %d of %d keys", pubKey.K, len(pubKey.PubKeys)))
+		}
+		for _, subKey := range pubKey.PubKeys {
+			if err := validatePubKey(subKey); err != nil {
+				return err
+			}
+		}
+		return nil
+
+	default:
+		return sdk.ErrInvalidPubKey(fmt.Sprintf("unsupported public key type: %T", pubKey))
+	}
+}
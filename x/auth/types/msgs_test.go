@@ -0,0 +1,51 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+	"github.com/tendermint/tendermint/crypto/multisig"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+
+	sdk "github.com/shinecloudfoundation/shinecloudnet/types"
+)
+
+type unsupportedPubKey struct {
+	crypto.PubKey
+}
+
+func TestMsgChangePubKeyValidateBasic(t *testing.T) {
+	_, _, addr := KeyTestPubAddr()
+	secp := secp256k1.GenPrivKey().PubKey()
+	ed := ed25519.GenPrivKey().PubKey()
+
+	tests := []struct {
+		name  string
+		msg   MsgChangePubKey
+		valid bool
+	}{
+		{"secp256k1", NewMsgChangePubKey(addr, secp), true},
+		{"ed25519", NewMsgChangePubKey(addr, ed), true},
+		{"multisig", NewMsgChangePubKey(addr, multisig.NewPubKeyMultisigThreshold(1, []crypto.PubKey{secp, secp})), true},
+		{"ed25519 multisig sub key", NewMsgChangePubKey(addr, multisig.NewPubKeyMultisigThreshold(1, []crypto.PubKey{secp, ed})), true},
+		{"empty address", NewMsgChangePubKey(sdk.AccAddress{}, secp), false},
+		{"missing pubkey", NewMsgChangePubKey(addr, nil), false},
+		{"unsupported pubkey", NewMsgChangePubKey(addr, unsupportedPubKey{secp}), false},
+		{"invalid threshold", NewMsgChangePubKey(addr,
+			multisig.PubKeyMultisigThreshold{K: 3, PubKeys: []crypto.PubKey{secp, secp}}), false},
+		{"unsupported multisig sub key", NewMsgChangePubKey(addr,
+			multisig.NewPubKeyMultisigThreshold(1, []crypto.PubKey{secp, unsupportedPubKey{secp}})), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			require.Equal(t, tt.valid, err == nil, err)
+			if err == nil {
+				require.Equal(t, []sdk.AccAddress{addr}, tt.msg.GetSigners())
+			}
+		})
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"strings"
 
+	sdk "github.com/shinecloudfoundation/shinecloudnet/types"
 	"github.com/shinecloudfoundation/shinecloudnet/x/params/subspace"
 )
 
@@ -18,6 +19,7 @@ const (
 	DefaultTxSizeCostPerByte      uint64 = 10
 	DefaultSigVerifyCostED25519   uint64 = 590
 	DefaultSigVerifyCostSecp256k1 uint64 = 1000
+	DefaultPubKeyChangeCost       uint64 = 5000
 )
 
 // Parameter keys
@@ -27,9 +29,12 @@ var (
 	KeyTxSizeCostPerByte      = []byte("TxSizeCostPerByte")
 	KeySigVerifyCostED25519   = []byte("SigVerifyCostED25519")
 	KeySigVerifyCostSecp256k1 = []byte("SigVerifyCostSecp256k1")
+	KeyFeeTokens              = []byte("FeeTokens")
+	KeyMinGasPrices           = []byte("MinGasPrices")
+	KeyPubKeyChangeCost       = []byte("PubKeyChangeCost")
 )
 
-var _ subspace.ParamSet = &Params{}
+var _ subspace.DefaultedParamSet = &Params{}
 
 // Params defines the parameters for the auth module.
 type Params struct {
@@ -38,11 +43,20 @@ type Params struct {
 	TxSizeCostPerByte      uint64 `json:"tx_size_cost_per_byte" yaml:"tx_size_cost_per_byte"`
 	SigVerifyCostED25519   uint64 `json:"sig_verify_cost_ed25519" yaml:"sig_verify_cost_ed25519"`
 	SigVerifyCostSecp256k1 uint64 `json:"sig_verify_cost_secp256k1" yaml:"sig_verify_cost_secp256k1"`
+
+	// FeeTokens can pay fees at their exchange rate to the base denom, which
+	// the MinGasPrices required by consensus are compared against as well
+	FeeTokens    FeeTokens    `json:"fee_tokens" yaml:"fee_tokens"`
+	MinGasPrices sdk.DecCoins `json:"min_gas_prices" yaml:"min_gas_prices"`
+
+	// PubKeyChangeCost is the gas consumed by replacing the PubKey of an account
+	PubKeyChangeCost uint64 `json:"pubkey_change_cost" yaml:"pubkey_change_cost"`
 }
 
 // NewParams creates a new Params object
 func NewParams(maxMemoCharacters, txSigLimit, txSizeCostPerByte,
-	sigVerifyCostED25519, sigVerifyCostSecp256k1 uint64) Params {
+	sigVerifyCostED25519, sigVerifyCostSecp256k1 uint64, feeTokens FeeTokens, minGasPrices sdk.DecCoins,
+	pubKeyChangeCost uint64) Params {
 
 	return Params{
 		MaxMemoCharacters:      maxMemoCharacters,
@@ -50,6 +64,9 @@ func NewParams(maxMemoCharacters, txSigLimit, txSizeCostPerByte,
 		TxSizeCostPerByte:      txSizeCostPerByte,
 		SigVerifyCostED25519:   sigVerifyCostED25519,
 		SigVerifyCostSecp256k1: sigVerifyCostSecp256k1,
+		FeeTokens:              feeTokens,
+		MinGasPrices:           minGasPrices,
+		PubKeyChangeCost:       pubKeyChangeCost,
 	}
 }
 
@@ -68,7 +85,29 @@ func (p *Params) ParamSetPairs() subspace.ParamSetPairs {
 		{KeyTxSizeCostPerByte, &p.TxSizeCostPerByte},
 		{KeySigVerifyCostED25519, &p.SigVerifyCostED25519},
 		{KeySigVerifyCostSecp256k1, &p.SigVerifyCostSecp256k1},
+		{KeyFeeTokens, &p.FeeTokens},
+		{KeyMinGasPrices, &p.MinGasPrices},
+		{KeyPubKeyChangeCost, &p.PubKeyChangeCost},
+	}
+}
+
+// ValidateParamSet validates the fee parameters, it implements
+// params.ValidatedParamSet
+func (p Params) ValidateParamSet() error {
+	if err := p.FeeTokens.Validate(); err != nil {
+		return err
+	}
+	if !p.MinGasPrices.IsValid() {
+		return fmt.Errorf("invalid minimum gas prices: %s", p.MinGasPrices)
 	}
+	return nil
+}
+
+// DefaultParamSet returns the default auth parameters, it implements
+// params.DefaultedParamSet
+func (p Params) DefaultParamSet() subspace.ValidatedParamSet {
+	defaults := DefaultParams()
+	return &defaults
 }
 
 // Equal returns a boolean determining if two Params types are identical.
@@ -86,6 +125,7 @@ func DefaultParams() Params {
 		TxSizeCostPerByte:      DefaultTxSizeCostPerByte,
 		SigVerifyCostED25519:   DefaultSigVerifyCostED25519,
 		SigVerifyCostSecp256k1: DefaultSigVerifyCostSecp256k1,
+		PubKeyChangeCost:       DefaultPubKeyChangeCost,
 	}
 }
 
@@ -98,5 +138,8 @@ func (p Params) String() string {
 	sb.WriteString(fmt.Sprintf("TxSizeCostPerByte: %d\n", p.TxSizeCostPerByte))
 	sb.WriteString(fmt.Sprintf("SigVerifyCostED25519: %d\n", p.SigVerifyCostED25519))
 	sb.WriteString(fmt.Sprintf("SigVerifyCostSecp256k1: %d\n", p.SigVerifyCostSecp256k1))
+	sb.WriteString(fmt.Sprintf("FeeTokens: %s\n", p.FeeTokens))
+	sb.WriteString(fmt.Sprintf("MinGasPrices: %s\n", p.MinGasPrices))
+	sb.WriteString(fmt.Sprintf("PubKeyChangeCost: %d\n", p.PubKeyChangeCost))
 	return sb.String()
 }
@@ -14,9 +14,10 @@ type StdSignMsg struct {
 	Fee           StdFee    `json:"fee" yaml:"fee"`
 	Msgs          []sdk.Msg `json:"msgs" yaml:"msgs"`
 	Memo          string    `json:"memo" yaml:"memo"`
+	TimeoutHeight uint64    `json:"timeout_height,omitempty" yaml:"timeout_height,omitempty"`
 }
 
 // get message bytes
 func (msg StdSignMsg) Bytes() []byte {
-	return StdSignBytes(msg.ChainID, msg.AccountNumber, msg.Sequence, msg.Fee, msg.Msgs, msg.Memo)
+	return StdSignBytes(msg.ChainID, msg.AccountNumber, msg.Sequence, msg.TimeoutHeight, msg.Fee, msg.Msgs, msg.Memo)
 }
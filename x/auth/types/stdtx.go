@@ -20,11 +20,13 @@ var (
 
 // StdTx is a standard way to wrap a Msg with Fee and Signatures.
 // NOTE: the first signature is the fee payer (Signatures must not be nil).
+// A non-zero TimeoutHeight is the last block height the tx can be included in.
 type StdTx struct {
-	Msgs       []sdk.Msg      `json:"msg" yaml:"msg"`
-	Fee        StdFee         `json:"fee" yaml:"fee"`
-	Signatures []StdSignature `json:"signatures" yaml:"signatures"`
-	Memo       string         `json:"memo" yaml:"memo"`
+	Msgs          []sdk.Msg      `json:"msg" yaml:"msg"`
+	Fee           StdFee         `json:"fee" yaml:"fee"`
+	Signatures    []StdSignature `json:"signatures" yaml:"signatures"`
+	Memo          string         `json:"memo" yaml:"memo"`
+	TimeoutHeight uint64         `json:"timeout_height,omitempty" yaml:"timeout_height,omitempty"`
 }
 
 func NewStdTx(msgs []sdk.Msg, fee StdFee, sigs []StdSignature, memo string) StdTx {
@@ -36,6 +38,12 @@ func NewStdTx(msgs []sdk.Msg, fee StdFee, sigs []StdSignature, memo string) StdT
 	}
 }
 
+// WithTimeoutHeight returns a copy of the tx that expires after timeoutHeight
+func (tx StdTx) WithTimeoutHeight(timeoutHeight uint64) StdTx {
+	tx.TimeoutHeight = timeoutHeight
+	return tx
+}
+
 // GetMsgs returns the all the transaction's messages.
 func (tx StdTx) GetMsgs() []sdk.Msg { return tx.Msgs }
 
@@ -56,6 +64,9 @@ func (tx StdTx) ValidateBasic() sdk.Error {
 	if len(stdSigs) != len(tx.GetSigners()) {
 		return sdk.ErrUnauthorized("wrong number of signers")
 	}
+	if !tx.Fee.Granter.Empty() && tx.Fee.Granter.Equals(tx.GetSigners()[0]) {
+		return sdk.ErrInvalidAddress("fee granter can't be the fee payer")
+	}
 
 	return nil
 }
@@ -111,10 +122,12 @@ func (tx StdTx) GetSignatures() []StdSignature { return tx.Signatures }
 
 // StdFee includes the amount of coins paid in fees and the maximum
 // gas to be used by the transaction. The ratio yields an effective "gasprice",
-// which must be above some miminum to be accepted into the mempool.
+// which must be above some miminum to be accepted into the mempool. If a
+// granter is set the fees are paid from its fee allowance for the first signer.
 type StdFee struct {
-	Amount sdk.Coins `json:"amount" yaml:"amount"`
-	Gas    uint64    `json:"gas" yaml:"gas"`
+	Amount  sdk.Coins      `json:"amount" yaml:"amount"`
+	Gas     uint64         `json:"gas" yaml:"gas"`
+	Granter sdk.AccAddress `json:"granter,omitempty" yaml:"granter,omitempty"`
 }
 
 // NewStdFee returns a new instance of StdFee
@@ -125,6 +138,12 @@ func NewStdFee(gas uint64, amount sdk.Coins) StdFee {
 	}
 }
 
+// WithGranter returns a copy of the fee paid from the granter's fee allowance
+func (fee StdFee) WithGranter(granter sdk.AccAddress) StdFee {
+	fee.Granter = granter
+	return fee
+}
+
 // Bytes for signing later
 func (fee StdFee) Bytes() []byte {
 	// normalize. XXX
@@ -164,10 +183,13 @@ type StdSignDoc struct {
 	Memo          string            `json:"memo" yaml:"memo"`
 	Msgs          []json.RawMessage `json:"msgs" yaml:"msgs"`
 	Sequence      uint64            `json:"sequence" yaml:"sequence"`
+	TimeoutHeight uint64            `json:"timeout_height,omitempty" yaml:"timeout_height,omitempty"`
 }
 
 // StdSignBytes returns the bytes to sign for a transaction.
-func StdSignBytes(chainID string, accnum uint64, sequence uint64, fee StdFee, msgs []sdk.Msg, memo string) []byte {
+func StdSignBytes(chainID string, accnum uint64, sequence uint64, timeoutHeight uint64,
+	fee StdFee, msgs []sdk.Msg, memo string) []byte {
+
 	var msgsBytes []json.RawMessage
 	for _, msg := range msgs {
 		msgsBytes = append(msgsBytes, json.RawMessage(msg.GetSignBytes()))
@@ -179,6 +201,7 @@ func StdSignBytes(chainID string, accnum uint64, sequence uint64, fee StdFee, ms
 		Memo:          memo,
 		Msgs:          msgsBytes,
 		Sequence:      sequence,
+		TimeoutHeight: timeoutHeight,
 	})
 	if err != nil {
 		panic(err)
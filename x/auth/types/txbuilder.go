@@ -26,6 +26,8 @@ type TxBuilder struct {
 	memo               string
 	fees               sdk.Coins
 	gasPrices          sdk.DecCoins
+	feeGranter         sdk.AccAddress
+	timeoutHeight      uint64
 }
 
 // NewTxBuilder returns a new initialized TxBuilder.
@@ -65,10 +67,12 @@ func NewTxBuilderFromCLI() TxBuilder {
 		simulateAndExecute: flags.GasFlagVar.Simulate,
 		chainID:            viper.GetString(flags.FlagChainID),
 		memo:               viper.GetString(flags.FlagMemo),
+		timeoutHeight:      viper.GetUint64(flags.FlagTimeoutHeight),
 	}
 
 	txbldr = txbldr.WithFees(viper.GetString(flags.FlagFees))
 	txbldr = txbldr.WithGasPrices(viper.GetString(flags.FlagGasPrices))
+	txbldr = txbldr.WithFeeGranter(viper.GetString(flags.FlagFeeGranter))
 
 	return txbldr
 }
@@ -107,6 +111,13 @@ func (bldr TxBuilder) Fees() sdk.Coins { return bldr.fees }
 // GasPrices returns the gas prices set for the transaction, if any.
 func (bldr TxBuilder) GasPrices() sdk.DecCoins { return bldr.gasPrices }
 
+// FeeGranter returns the account paying the fees from its fee allowance, if any.
+func (bldr TxBuilder) FeeGranter() sdk.AccAddress { return bldr.feeGranter }
+
+// TimeoutHeight returns the last block height the transaction can be included
+// in, zero if it doesn't time out.
+func (bldr TxBuilder) TimeoutHeight() uint64 { return bldr.timeoutHeight }
+
 // WithTxEncoder returns a copy of the context with an updated codec.
 func (bldr TxBuilder) WithTxEncoder(txEncoder sdk.TxEncoder) TxBuilder {
 	bldr.txEncoder = txEncoder
@@ -147,6 +158,28 @@ func (bldr TxBuilder) WithGasPrices(gasPrices string) TxBuilder {
 	return bldr
 }
 
+// WithFeeGranter returns a copy of the context with an updated fee granter.
+func (bldr TxBuilder) WithFeeGranter(granter string) TxBuilder {
+	if granter == "" {
+		bldr.feeGranter = nil
+		return bldr
+	}
+
+	addr, err := sdk.AccAddressFromBech32(granter)
+	if err != nil {
+		panic(err)
+	}
+
+	bldr.feeGranter = addr
+	return bldr
+}
+
+// WithTimeoutHeight returns a copy of the context with an updated timeout height.
+func (bldr TxBuilder) WithTimeoutHeight(timeoutHeight uint64) TxBuilder {
+	bldr.timeoutHeight = timeoutHeight
+	return bldr
+}
+
 // WithKeybase returns a copy of the context with updated keybase.
 func (bldr TxBuilder) WithKeybase(keybase crkeys.Keybase) TxBuilder {
 	bldr.keybase = keybase
@@ -202,7 +235,8 @@ func (bldr TxBuilder) BuildSignMsg(msgs []sdk.Msg) (StdSignMsg, error) {
 		Sequence:      bldr.sequence,
 		Memo:          bldr.memo,
 		Msgs:          msgs,
-		Fee:           NewStdFee(bldr.gas, fees),
+		Fee:           NewStdFee(bldr.gas, fees).WithGranter(bldr.feeGranter),
+		TimeoutHeight: bldr.timeoutHeight,
 	}, nil
 }
 
@@ -214,7 +248,8 @@ func (bldr TxBuilder) Sign(name, passphrase string, msg StdSignMsg) ([]byte, err
 		return nil, err
 	}
 
-	return bldr.txEncoder(NewStdTx(msg.Msgs, msg.Fee, []StdSignature{sig}, msg.Memo))
+	stdTx := NewStdTx(msg.Msgs, msg.Fee, []StdSignature{sig}, msg.Memo).WithTimeoutHeight(msg.TimeoutHeight)
+	return bldr.txEncoder(stdTx)
 }
 
 // BuildAndSign builds a single message to be signed, and signs a transaction
@@ -238,16 +273,25 @@ func (bldr TxBuilder) BuildTxForSim(msgs []sdk.Msg) ([]byte, error) {
 
 	// the ante handler will populate with a sentinel pubkey
 	sigs := []StdSignature{{}}
-	return bldr.txEncoder(NewStdTx(signMsg.Msgs, signMsg.Fee, sigs, signMsg.Memo))
+	return bldr.txEncoder(NewStdTx(signMsg.Msgs, signMsg.Fee, sigs, signMsg.Memo).WithTimeoutHeight(signMsg.TimeoutHeight))
 }
 
 // SignStdTx appends a signature to a StdTx and returns a copy of it. If append
 // is false, it replaces the signatures already attached with the new signature.
+// A timeout height set on the TxBuilder replaces the one of the StdTx.
 func (bldr TxBuilder) SignStdTx(name, passphrase string, stdTx StdTx, appendSig bool) (signedStdTx StdTx, err error) {
 	if bldr.chainID == "" {
 		return StdTx{}, fmt.Errorf("chain ID required but not specified")
 	}
 
+	timeoutHeight := stdTx.TimeoutHeight
+	if bldr.timeoutHeight != 0 {
+		timeoutHeight = bldr.timeoutHeight
+	}
+	if appendSig && len(stdTx.GetSignatures()) > 0 && timeoutHeight != stdTx.TimeoutHeight {
+		return StdTx{}, fmt.Errorf("cannot change the timeout height of a signed transaction")
+	}
+
 	stdSignature, err := MakeSignature(bldr.keybase, name, passphrase, StdSignMsg{
 		ChainID:       bldr.chainID,
 		AccountNumber: bldr.accountNumber,
@@ -255,6 +299,7 @@ func (bldr TxBuilder) SignStdTx(name, passphrase string, stdTx StdTx, appendSig
 		Fee:           stdTx.Fee,
 		Msgs:          stdTx.GetMsgs(),
 		Memo:          stdTx.GetMemo(),
+		TimeoutHeight: timeoutHeight,
 	})
 	if err != nil {
 		return
@@ -266,7 +311,7 @@ func (bldr TxBuilder) SignStdTx(name, passphrase string, stdTx StdTx, appendSig
 	} else {
 		sigs = append(sigs, stdSignature)
 	}
-	signedStdTx = NewStdTx(stdTx.GetMsgs(), stdTx.Fee, sigs, stdTx.GetMemo())
+	signedStdTx = NewStdTx(stdTx.GetMsgs(), stdTx.Fee, sigs, stdTx.GetMemo()).WithTimeoutHeight(timeoutHeight)
 	return
 }
 
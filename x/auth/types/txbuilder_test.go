@@ -22,6 +22,7 @@ func TestTxBuilderBuild(t *testing.T) {
 		Memo          string
 		Fees          sdk.Coins
 		GasPrices     sdk.DecCoins
+		TimeoutHeight uint64
 	}
 	defaultMsg := []sdk.Msg{sdk.NewTestMsg(addr)}
 	tests := []struct {
@@ -79,6 +80,32 @@ func TestTxBuilderBuild(t *testing.T) {
 			},
 			false,
 		},
+		{
+			"builder with timeout height",
+			fields{
+				TxEncoder:     DefaultTxEncoder(codec.New()),
+				AccountNumber: 1,
+				Sequence:      1,
+				Gas:           200000,
+				GasAdjustment: 1.1,
+				SimulateGas:   false,
+				ChainID:       "test-chain",
+				Memo:          "hello from Voyager 1!",
+				Fees:          sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(1))),
+				TimeoutHeight: 100,
+			},
+			defaultMsg,
+			StdSignMsg{
+				ChainID:       "test-chain",
+				AccountNumber: 1,
+				Sequence:      1,
+				Memo:          "hello from Voyager 1!",
+				Msgs:          defaultMsg,
+				Fee:           NewStdFee(200000, sdk.Coins{sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(1))}),
+				TimeoutHeight: 100,
+			},
+			false,
+		},
 		{
 			"no chain-id supplied",
 			fields{
@@ -136,7 +163,7 @@ func TestTxBuilderBuild(t *testing.T) {
 				tt.fields.TxEncoder, tt.fields.AccountNumber, tt.fields.Sequence,
 				tt.fields.Gas, tt.fields.GasAdjustment, tt.fields.SimulateGas,
 				tt.fields.ChainID, tt.fields.Memo, tt.fields.Fees, tt.fields.GasPrices,
-			)
+			).WithTimeoutHeight(tt.fields.TimeoutHeight)
 			got, err := bldr.BuildSignMsg(tt.msgs)
 			require.Equal(t, tt.wantErr, (err != nil))
 			if err == nil {
@@ -0,0 +1,81 @@
+// nolint
+// autogenerated code using github.com/rigelrozanski/multitool
+// aliases generated for the following subdirectories:
+// ALIASGEN: github.com/shinecloudfoundation/shinecloudnet/x/authz/internal/keeper
+// ALIASGEN: github.com/shinecloudfoundation/shinecloudnet/x/authz/internal/types
+package authz
+
+import (
+	"github.com/shinecloudfoundation/shinecloudnet/x/authz/internal/keeper"
+	"github.com/shinecloudfoundation/shinecloudnet/x/authz/internal/types"
+)
+
+const (
+	DefaultCodespace             = types.DefaultCodespace
+	CodeNoAuthorization          = types.CodeNoAuthorization
+	CodeInvalidAuthorization     = types.CodeInvalidAuthorization
+	CodeAuthorizationExceeded    = types.CodeAuthorizationExceeded
+	CodeInvalidExpiration        = types.CodeInvalidExpiration
+	EventTypeGrantAuthorization  = types.EventTypeGrantAuthorization
+	EventTypeRevokeAuthorization = types.EventTypeRevokeAuthorization
+	EventTypeExecAuthorization   = types.EventTypeExecAuthorization
+	AttributeKeyGranter          = types.AttributeKeyGranter
+	AttributeKeyGrantee          = types.AttributeKeyGrantee
+	AttributeKeyMsgType          = types.AttributeKeyMsgType
+	AttributeValueCategory       = types.AttributeValueCategory
+	ModuleName                   = types.ModuleName
+	StoreKey                     = types.StoreKey
+	RouterKey                    = types.RouterKey
+	QuerierRoute                 = types.QuerierRoute
+	TypeMsgGrantAuthorization    = types.TypeMsgGrantAuthorization
+	TypeMsgRevokeAuthorization   = types.TypeMsgRevokeAuthorization
+	TypeMsgExec                  = types.TypeMsgExec
+	QueryAuthorization           = types.QueryAuthorization
+	QueryAuthorizations          = types.QueryAuthorizations
+)
+
+var (
+	// functions aliases
+	NewKeeper                    = keeper.NewKeeper
+	NewQuerier                   = keeper.NewQuerier
+	MsgTypeOf                    = types.MsgTypeOf
+	NewSendAuthorization         = types.NewSendAuthorization
+	NewGenericAuthorization      = types.NewGenericAuthorization
+	NewStakeAuthorization        = types.NewStakeAuthorization
+	RegisterCodec                = types.RegisterCodec
+	RegisterMsgTypeCodec         = types.RegisterMsgTypeCodec
+	ErrNoAuthorization           = types.ErrNoAuthorization
+	ErrInvalidAuthorization      = types.ErrInvalidAuthorization
+	ErrAuthorizationExceeded     = types.ErrAuthorizationExceeded
+	ErrInvalidExpiration         = types.ErrInvalidExpiration
+	NewAuthorizationGrant        = types.NewAuthorizationGrant
+	GetAuthorizationKey          = types.GetAuthorizationKey
+	GetAuthorizationsKey         = types.GetAuthorizationsKey
+	NewMsgGrantAuthorization     = types.NewMsgGrantAuthorization
+	NewMsgRevokeAuthorization    = types.NewMsgRevokeAuthorization
+	NewMsgExec                   = types.NewMsgExec
+	NewQueryAuthorizationParams  = types.NewQueryAuthorizationParams
+	NewQueryAuthorizationsParams = types.NewQueryAuthorizationsParams
+
+	// variable aliases
+	ModuleCdc              = types.ModuleCdc
+	AuthorizationKeyPrefix = types.AuthorizationKeyPrefix
+	StakeMsgDelegate       = types.StakeMsgDelegate
+	StakeMsgRedelegate     = types.StakeMsgRedelegate
+	StakeMsgUndelegate     = types.StakeMsgUndelegate
+)
+
+type (
+	Keeper                    = keeper.Keeper
+	Authorization             = types.Authorization
+	SendAuthorization         = types.SendAuthorization
+	GenericAuthorization      = types.GenericAuthorization
+	StakeAuthorization        = types.StakeAuthorization
+	AuthorizationGrant        = types.AuthorizationGrant
+	AuthorizationGrants       = types.AuthorizationGrants
+	MsgGrantAuthorization     = types.MsgGrantAuthorization
+	MsgRevokeAuthorization    = types.MsgRevokeAuthorization
+	MsgExec                   = types.MsgExec
+	QueryAuthorizationParams  = types.QueryAuthorizationParams
+	QueryAuthorizationsParams = types.QueryAuthorizationsParams
+)
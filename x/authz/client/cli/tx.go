@@ -0,0 +1,209 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/shinecloudfoundation/shinecloudnet/client"
+	"github.com/shinecloudfoundation/shinecloudnet/client/context"
+	"github.com/shinecloudfoundation/shinecloudnet/codec"
+	sdk "github.com/shinecloudfoundation/shinecloudnet/types"
+	"github.com/shinecloudfoundation/shinecloudnet/version"
+	"github.com/shinecloudfoundation/shinecloudnet/x/auth"
+	"github.com/shinecloudfoundation/shinecloudnet/x/auth/client/utils"
+	"github.com/shinecloudfoundation/shinecloudnet/x/authz/internal/types"
+)
+
+const (
+	flagExpiration        = "expiration"
+	flagSpendLimit        = "spend-limit"
+	flagMsgType           = "msg-type"
+	flagAllowedValidators = "allowed-validators"
+)
+
+// authorization kinds of the grant command
+const (
+	authorizationSend       = "send"
+	authorizationGeneric    = "generic"
+	authorizationDelegate   = "delegate"
+	authorizationRedelegate = "redelegate"
+	authorizationUndelegate = "undelegate"
+)
+
+// GetTxCmd returns the transaction commands for this module
+func GetTxCmd(cdc *codec.Codec) *cobra.Command {
+	authzTxCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Authorization transaction subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	authzTxCmd.AddCommand(client.PostCommands(
+		GetCmdGrantAuthorization(cdc),
+		GetCmdRevokeAuthorization(cdc),
+		GetCmdExec(cdc),
+	)...)
+
+	return authzTxCmd
+}
+
+// GetCmdGrantAuthorization implements the command to grant an authorization
+func GetCmdGrantAuthorization(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "grant [grantee] [send|generic|delegate|redelegate|undelegate]",
+		Short: "Grant an address the authorization to execute msgs on your behalf",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Grant the grantee an authorization to execute msgs on behalf of the granter
+until the expiration. A send authorization allows sending up to a spend limit,
+a generic authorization allows any msg of a type (route/type) and the staking
+authorizations allow delegating to, redelegating to or undelegating from the
+listed validators. An existing authorization for the same msg type is replaced.
+
+Example:
+$ %s tx %s grant scloud1skjw... send --spend-limit=1000uscds --expiration=2022-01-30T15:04:05Z --from=mykey
+$ %s tx %s grant scloud1skjw... generic --msg-type=distribution/withdraw_delegator_reward --expiration=2022-01-30T15:04:05Z --from=mykey
+$ %s tx %s grant scloud1skjw... redelegate --allowed-validators=scloudvaloper1...,scloudvaloper1... --expiration=2022-01-30T15:04:05Z --from=mykey
+`,
+				version.ClientName, types.ModuleName, version.ClientName, types.ModuleName,
+				version.ClientName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			grantee, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			expiration, err := time.Parse(time.RFC3339, viper.GetString(flagExpiration))
+			if err != nil {
+				return err
+			}
+
+			authorization, err := authorizationFromFlags(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgGrantAuthorization(cliCtx.GetFromAddress(), grantee, authorization, expiration)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.Flags().String(flagExpiration, "", "Time the authorization expires at in RFC3339 format")
+	cmd.Flags().String(flagSpendLimit, "", "Coins the grantee can send with a send authorization")
+	cmd.Flags().String(flagMsgType, "", "Type of the msgs allowed by a generic authorization, e.g. gov/vote")
+	cmd.Flags().StringSlice(flagAllowedValidators, nil, "Validators allowed by a staking authorization")
+	cmd.MarkFlagRequired(flagExpiration)
+	return cmd
+}
+
+// authorizationFromFlags builds the authorization of the given kind from the
+// flags of the grant command
+func authorizationFromFlags(kind string) (types.Authorization, error) {
+	switch kind {
+	case authorizationSend:
+		spendLimit, err := sdk.ParseCoins(viper.GetString(flagSpendLimit))
+		if err != nil {
+			return nil, err
+		}
+		return types.NewSendAuthorization(spendLimit), nil
+
+	case authorizationGeneric:
+		return types.NewGenericAuthorization(viper.GetString(flagMsgType)), nil
+
+	case authorizationDelegate, authorizationRedelegate, authorizationUndelegate:
+		var validators []sdk.ValAddress
+		for _, v := range viper.GetStringSlice(flagAllowedValidators) {
+			validator, err := sdk.ValAddressFromBech32(v)
+			if err != nil {
+				return nil, err
+			}
+			validators = append(validators, validator)
+		}
+
+		msgType := map[string]string{
+			authorizationDelegate:   types.StakeMsgDelegate,
+			authorizationRedelegate: types.StakeMsgRedelegate,
+			authorizationUndelegate: types.StakeMsgUndelegate,
+		}[kind]
+		return types.NewStakeAuthorization(msgType, validators), nil
+
+	default:
+		return nil, fmt.Errorf("unknown authorization %q", kind)
+	}
+}
+
+// GetCmdRevokeAuthorization implements the command to revoke an authorization
+func GetCmdRevokeAuthorization(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "revoke [grantee] [msg_type]",
+		Short: "Revoke the authorization for a msg type (route/type) given to an address",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			grantee, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRevokeAuthorization(cliCtx.GetFromAddress(), grantee, args[1])
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+// GetCmdExec implements the command to execute msgs on behalf of a granter
+func GetCmdExec(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "exec [tx_json_file]",
+		Short: "Execute the msgs of a transaction on behalf of their signers",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Execute the msgs of a transaction generated with --generate-only on behalf of
+their signer, which must have granted the authorizations for them.
+
+Example:
+$ %s tx distribution withdraw-rewards scloudvaloper1... --from=<granter> --generate-only > tx.json
+$ %s tx %s exec tx.json --from=<grantee>
+`,
+				version.ClientName, version.ClientName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			stdTx, err := utils.ReadStdTxFromFile(cdc, args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgExec(cliCtx.GetFromAddress(), stdTx.GetMsgs())
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
@@ -0,0 +1,17 @@
+package rest
+
+import (
+	"github.com/gorilla/mux"
+
+	"github.com/shinecloudfoundation/shinecloudnet/client/context"
+	"github.com/shinecloudfoundation/shinecloudnet/x/authz/internal/types"
+)
+
+// RegisterRoutes - Central function to define routes that get registered by the main application
+func RegisterRoutes(cliCtx context.CLIContext, r *mux.Router) {
+	r.HandleFunc("/authz/grant", grantRequestHandlerFn(cliCtx)).Methods("POST")
+	r.HandleFunc("/authz/revoke", revokeRequestHandlerFn(cliCtx)).Methods("POST")
+	r.HandleFunc("/authz/exec", execRequestHandlerFn(cliCtx)).Methods("POST")
+
+	r.HandleFunc("/authz/authorizations/{granter}/{grantee}", authorizationsHandlerFn(cliCtx, types.QuerierRoute)).Methods("GET")
+}
@@ -0,0 +1,137 @@
+package rest
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/shinecloudfoundation/shinecloudnet/client/context"
+	sdk "github.com/shinecloudfoundation/shinecloudnet/types"
+	"github.com/shinecloudfoundation/shinecloudnet/types/rest"
+	"github.com/shinecloudfoundation/shinecloudnet/x/auth/client/utils"
+	"github.com/shinecloudfoundation/shinecloudnet/x/authz/internal/types"
+)
+
+// GrantReq defines the properties of a grant authorization request's body.
+type GrantReq struct {
+	BaseReq       rest.BaseReq        `json:"base_req" yaml:"base_req"`
+	Grantee       sdk.AccAddress      `json:"grantee" yaml:"grantee"`
+	Authorization types.Authorization `json:"authorization" yaml:"authorization"`
+	Expiration    time.Time           `json:"expiration" yaml:"expiration"`
+}
+
+// RevokeReq defines the properties of a revoke authorization request's body.
+type RevokeReq struct {
+	BaseReq rest.BaseReq   `json:"base_req" yaml:"base_req"`
+	Grantee sdk.AccAddress `json:"grantee" yaml:"grantee"`
+	MsgType string         `json:"msg_type" yaml:"msg_type"`
+}
+
+// ExecReq defines the properties of an exec request's body.
+type ExecReq struct {
+	BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+	Msgs    []sdk.Msg    `json:"msgs" yaml:"msgs"`
+}
+
+// grantRequestHandlerFn - http request handler to grant an authorization
+func grantRequestHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req GrantReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		cliCtx, granter, ok := withFromFields(w, cliCtx, req.BaseReq)
+		if !ok {
+			return
+		}
+
+		msg := types.NewMsgGrantAuthorization(granter, req.Grantee, req.Authorization, req.Expiration)
+		if err := msg.ValidateBasic(); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		utils.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
+
+// revokeRequestHandlerFn - http request handler to revoke an authorization
+func revokeRequestHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req RevokeReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		cliCtx, granter, ok := withFromFields(w, cliCtx, req.BaseReq)
+		if !ok {
+			return
+		}
+
+		msg := types.NewMsgRevokeAuthorization(granter, req.Grantee, req.MsgType)
+		if err := msg.ValidateBasic(); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		utils.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
+
+// execRequestHandlerFn - http request handler to execute msgs on behalf of
+// their signers
+func execRequestHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req ExecReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		cliCtx, grantee, ok := withFromFields(w, cliCtx, req.BaseReq)
+		if !ok {
+			return
+		}
+
+		msg := types.NewMsgExec(grantee, req.Msgs)
+		if err := msg.ValidateBasic(); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		utils.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
+
+// withFromFields sets the from fields of the context from the request's base_req
+func withFromFields(w http.ResponseWriter, cliCtx context.CLIContext, br rest.BaseReq) (context.CLIContext, sdk.AccAddress, bool) {
+	var fromAddress sdk.AccAddress
+	var fromName string
+	var err error
+	if br.GenerateOnly {
+		fromAddress, err = sdk.AccAddressFromBech32(br.From)
+	} else {
+		fromAddress, fromName, err = context.GetFromFieldsFromAddr(br.From)
+	}
+	if err != nil {
+		rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+		return cliCtx, nil, false
+	}
+
+	cliCtx = cliCtx.WithFromName(fromName).WithFromAddress(fromAddress).WithBroadcastMode(br.BroadcastMode)
+	return cliCtx, fromAddress, true
+}
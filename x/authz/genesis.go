@@ -0,0 +1,52 @@
+package authz
+
+import (
+	sdk "github.com/shinecloudfoundation/shinecloudnet/types"
+)
+
+// GenesisState contains a set of authorization grants, persisted from the store
+type GenesisState struct {
+	Authorizations []AuthorizationGrant `json:"authorizations" yaml:"authorizations"`
+}
+
+// NewGenesisState creates a new GenesisState object
+func NewGenesisState(grants []AuthorizationGrant) GenesisState {
+	return GenesisState{
+		Authorizations: grants,
+	}
+}
+
+// DefaultGenesisState returns a default genesis state
+func DefaultGenesisState() GenesisState {
+	return NewGenesisState([]AuthorizationGrant{})
+}
+
+// InitGenesis stores the authorization grants of the genesis state
+func InitGenesis(ctx sdk.Context, k Keeper, data GenesisState) {
+	for _, grant := range data.Authorizations {
+		k.Grant(ctx, grant)
+	}
+}
+
+// ExportGenesis returns a GenesisState with all the unexpired authorization
+// grants in the store
+func ExportGenesis(ctx sdk.Context, k Keeper) GenesisState {
+	grants := []AuthorizationGrant{}
+	k.IterateAllGrants(ctx, func(grant AuthorizationGrant) bool {
+		if !grant.IsExpired(ctx.BlockHeader().Time) {
+			grants = append(grants, grant)
+		}
+		return false
+	})
+	return NewGenesisState(grants)
+}
+
+// ValidateGenesis performs basic validation of the authorization grants
+func ValidateGenesis(data GenesisState) error {
+	for _, grant := range data.Authorizations {
+		if err := grant.ValidateBasic(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,87 @@
+package authz
+
+import (
+	"fmt"
+
+	sdk "github.com/shinecloudfoundation/shinecloudnet/types"
+)
+
+// NewHandler returns a handler for "authz" type messages.
+func NewHandler(k Keeper) sdk.Handler {
+	return func(ctx sdk.Context, msg sdk.Msg) sdk.Result {
+		ctx = ctx.WithEventManager(sdk.NewEventManager())
+
+		switch msg := msg.(type) {
+		case MsgGrantAuthorization:
+			return handleMsgGrantAuthorization(ctx, k, msg)
+
+		case MsgRevokeAuthorization:
+			return handleMsgRevokeAuthorization(ctx, k, msg)
+
+		case MsgExec:
+			return handleMsgExec(ctx, k, msg)
+
+		default:
+			errMsg := fmt.Sprintf("unrecognized authz message type: %T", msg)
+			return sdk.ErrUnknownRequest(errMsg).Result()
+		}
+	}
+}
+
+func handleMsgGrantAuthorization(ctx sdk.Context, k Keeper, msg MsgGrantAuthorization) sdk.Result {
+	if !ctx.BlockHeader().Time.Before(msg.Expiration) {
+		return ErrInvalidExpiration(DefaultCodespace).Result()
+	}
+
+	k.Grant(ctx, NewAuthorizationGrant(msg.Granter, msg.Grantee, msg.Authorization, msg.Expiration))
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			EventTypeGrantAuthorization,
+			sdk.NewAttribute(AttributeKeyGranter, msg.Granter.String()),
+			sdk.NewAttribute(AttributeKeyGrantee, msg.Grantee.String()),
+			sdk.NewAttribute(AttributeKeyMsgType, msg.Authorization.MsgType()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Granter.String()),
+		),
+	})
+
+	return sdk.Result{Events: ctx.EventManager().Events()}
+}
+
+func handleMsgRevokeAuthorization(ctx sdk.Context, k Keeper, msg MsgRevokeAuthorization) sdk.Result {
+	if err := k.Revoke(ctx, msg.Granter, msg.Grantee, msg.MsgType); err != nil {
+		return err.Result()
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			EventTypeRevokeAuthorization,
+			sdk.NewAttribute(AttributeKeyGranter, msg.Granter.String()),
+			sdk.NewAttribute(AttributeKeyGrantee, msg.Grantee.String()),
+			sdk.NewAttribute(AttributeKeyMsgType, msg.MsgType),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Granter.String()),
+		),
+	})
+
+	return sdk.Result{Events: ctx.EventManager().Events()}
+}
+
+func handleMsgExec(ctx sdk.Context, k Keeper, msg MsgExec) sdk.Result {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Grantee.String()),
+		),
+	)
+
+	return k.DispatchActions(ctx, msg.Grantee, msg.Msgs)
+}
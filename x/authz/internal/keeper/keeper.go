@@ -0,0 +1,169 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/shinecloudfoundation/shinecloudnet/codec"
+	sdk "github.com/shinecloudfoundation/shinecloudnet/types"
+	"github.com/shinecloudfoundation/shinecloudnet/x/authz/internal/types"
+)
+
+// Keeper manages the authorizations granted between accounts and executes
+// msgs on behalf of their granters
+type Keeper struct {
+	cdc       *codec.Codec
+	storeKey  sdk.StoreKey
+	router    sdk.Router
+	codespace sdk.CodespaceType
+}
+
+// NewKeeper creates a new authz Keeper instance. The router is used to
+// dispatch the msgs executed on behalf of granters.
+func NewKeeper(cdc *codec.Codec, key sdk.StoreKey, router sdk.Router, codespace sdk.CodespaceType) Keeper {
+	return Keeper{
+		cdc:       cdc,
+		storeKey:  key,
+		router:    router,
+		codespace: codespace,
+	}
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
+}
+
+// Grant creates a new grant or replaces the existing one for the same msg type
+func (k Keeper) Grant(ctx sdk.Context, grant types.AuthorizationGrant) {
+	store := ctx.KVStore(k.storeKey)
+	key := types.GetAuthorizationKey(grant.Granter, grant.Grantee, grant.Authorization.MsgType())
+	store.Set(key, k.cdc.MustMarshalBinaryLengthPrefixed(grant))
+}
+
+// Revoke removes an existing grant
+func (k Keeper) Revoke(ctx sdk.Context, granter, grantee sdk.AccAddress, msgType string) sdk.Error {
+	store := ctx.KVStore(k.storeKey)
+	key := types.GetAuthorizationKey(granter, grantee, msgType)
+	if !store.Has(key) {
+		return types.ErrNoAuthorization(k.codespace, msgType)
+	}
+
+	store.Delete(key)
+	return nil
+}
+
+// GetGrant returns the grant for msgType msgs from granter to grantee
+func (k Keeper) GetGrant(ctx sdk.Context, granter, grantee sdk.AccAddress,
+	msgType string) (grant types.AuthorizationGrant, found bool) {
+
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetAuthorizationKey(granter, grantee, msgType))
+	if bz == nil {
+		return grant, false
+	}
+
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &grant)
+	return grant, true
+}
+
+// IterateGrants iterates over all the grants given by granter to grantee
+func (k Keeper) IterateGrants(ctx sdk.Context, granter, grantee sdk.AccAddress,
+	cb func(grant types.AuthorizationGrant) (stop bool)) {
+
+	k.iterate(ctx, types.GetAuthorizationsKey(granter, grantee), cb)
+}
+
+// IterateAllGrants iterates over all the grants in the store
+func (k Keeper) IterateAllGrants(ctx sdk.Context, cb func(grant types.AuthorizationGrant) (stop bool)) {
+	k.iterate(ctx, types.AuthorizationKeyPrefix, cb)
+}
+
+func (k Keeper) iterate(ctx sdk.Context, prefix []byte, cb func(grant types.AuthorizationGrant) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, prefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var grant types.AuthorizationGrant
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &grant)
+		if cb(grant) {
+			break
+		}
+	}
+}
+
+// DispatchActions executes msgs on behalf of their signers. A msg not signed
+// by the grantee itself needs an unexpired authorization from its signer,
+// which is used up by the msg. Msgs that are not supported yet or retired at
+// the current height are rejected like they are at the top level of a tx.
+func (k Keeper) DispatchActions(ctx sdk.Context, grantee sdk.AccAddress, msgs []sdk.Msg) sdk.Result {
+	for _, msg := range msgs {
+		if !sdk.GlobalUpgradeMgr.MsgCheck(msg.Type()) {
+			return sdk.ErrMsgNotSupported(fmt.Sprintf("%s will be supported after height %d",
+				msg.Type(), sdk.GlobalUpgradeMgr.GetMsgHeight(msg.Type()))).Result()
+		}
+		if sdk.GlobalUpgradeMgr.IsMsgRetired(msg.Type()) {
+			return sdk.ErrMsgRetired(fmt.Sprintf("%s is retired since height %d",
+				msg.Type(), sdk.GlobalUpgradeMgr.GetMsgRetiredHeight(msg.Type()))).Result()
+		}
+
+		signers := msg.GetSigners()
+		if len(signers) != 1 {
+			return sdk.ErrUnauthorized("only msgs with a single signer can be executed").Result()
+		}
+
+		granter := signers[0]
+		if !granter.Equals(grantee) {
+			if err := k.useAuthorization(ctx, granter, grantee, msg); err != nil {
+				return err.Result()
+			}
+		}
+
+		handler := k.router.Route(msg.Route())
+		if handler == nil {
+			return sdk.ErrUnknownRequest("unrecognized message type: " + msg.Route()).Result()
+		}
+
+		res := handler(ctx, msg)
+		if !res.IsOK() {
+			return res
+		}
+		ctx.EventManager().EmitEvents(res.Events)
+	}
+
+	return sdk.Result{Events: ctx.EventManager().Events()}
+}
+
+// useAuthorization checks that msg is allowed by the authorization granter
+// gave grantee and uses it up. Used up authorizations are deleted.
+func (k Keeper) useAuthorization(ctx sdk.Context, granter, grantee sdk.AccAddress, msg sdk.Msg) sdk.Error {
+	msgType := types.MsgTypeOf(msg)
+	grant, found := k.GetGrant(ctx, granter, grantee, msgType)
+	if !found || grant.IsExpired(ctx.BlockHeader().Time) {
+		return types.ErrNoAuthorization(k.codespace, msgType)
+	}
+
+	remove, err := grant.Authorization.Accept(msg)
+	if err != nil {
+		return err
+	}
+
+	if remove {
+		store := ctx.KVStore(k.storeKey)
+		store.Delete(types.GetAuthorizationKey(granter, grantee, msgType))
+	} else {
+		k.Grant(ctx, grant)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeExecAuthorization,
+			sdk.NewAttribute(types.AttributeKeyGranter, granter.String()),
+			sdk.NewAttribute(types.AttributeKeyGrantee, grantee.String()),
+			sdk.NewAttribute(types.AttributeKeyMsgType, msgType),
+		),
+	)
+	return nil
+}
@@ -0,0 +1,145 @@
+package keeper
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	sdk "github.com/shinecloudfoundation/shinecloudnet/types"
+	"github.com/shinecloudfoundation/shinecloudnet/x/authz/internal/types"
+	bank "github.com/shinecloudfoundation/shinecloudnet/x/bank/types"
+)
+
+var (
+	granter = sdk.AccAddress([]byte("granter"))
+	grantee = sdk.AccAddress([]byte("grantee"))
+	other   = sdk.AccAddress([]byte("other"))
+)
+
+func TestKeeperGrantRevoke(t *testing.T) {
+	ctx, _, _, keeper := SetupTestInput()
+	expiration := time.Unix(1600000000, 0).UTC()
+
+	send := types.NewAuthorizationGrant(granter, grantee,
+		types.NewSendAuthorization(sdk.NewCoins(sdk.NewInt64Coin("stake", 10))), expiration)
+	generic := types.NewAuthorizationGrant(granter, grantee, types.NewGenericAuthorization("gov/vote"), expiration)
+	keeper.Grant(ctx, send)
+	keeper.Grant(ctx, generic)
+	keeper.Grant(ctx, types.NewAuthorizationGrant(other, grantee, types.NewGenericAuthorization("gov/vote"), expiration))
+
+	grant, found := keeper.GetGrant(ctx, granter, grantee, "bank/send")
+	require.True(t, found)
+	require.Equal(t, send, grant)
+
+	_, found = keeper.GetGrant(ctx, grantee, granter, "bank/send")
+	require.False(t, found)
+
+	var grants types.AuthorizationGrants
+	keeper.IterateGrants(ctx, granter, grantee, func(grant types.AuthorizationGrant) bool {
+		grants = append(grants, grant)
+		return false
+	})
+	require.Equal(t, types.AuthorizationGrants{send, generic}, grants)
+
+	require.NoError(t, keeper.Revoke(ctx, granter, grantee, "gov/vote"))
+	_, found = keeper.GetGrant(ctx, granter, grantee, "gov/vote")
+	require.False(t, found)
+
+	err := keeper.Revoke(ctx, granter, grantee, "gov/vote")
+	require.Error(t, err)
+	require.Equal(t, types.CodeNoAuthorization, err.Code())
+}
+
+func TestKeeperDispatchActions(t *testing.T) {
+	ctx, ak, bk, keeper := SetupTestInput()
+	now := time.Unix(1577836800, 0).UTC()
+	ctx = ctx.WithBlockHeader(abci.Header{Time: now})
+
+	ak.SetAccount(ctx, ak.NewAccountWithAddress(ctx, granter))
+	require.NoError(t, bk.SetCoins(ctx, granter, sdk.NewCoins(sdk.NewInt64Coin("stake", 100))))
+
+	send := func(amount int64) []sdk.Msg {
+		return []sdk.Msg{bank.NewMsgSend(granter, other, sdk.NewCoins(sdk.NewInt64Coin("stake", amount)))}
+	}
+
+	// no authorization
+	res := keeper.DispatchActions(ctx, grantee, send(10))
+	require.False(t, res.IsOK())
+	require.Equal(t, types.CodeNoAuthorization, res.Code)
+
+	keeper.Grant(ctx, types.NewAuthorizationGrant(granter, grantee,
+		types.NewSendAuthorization(sdk.NewCoins(sdk.NewInt64Coin("stake", 30))), now.Add(time.Hour)))
+
+	// the send limit is used up by the executed sends
+	res = keeper.DispatchActions(ctx, grantee, send(10))
+	require.True(t, res.IsOK(), res.Log)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 10)), bk.GetCoins(ctx, other))
+
+	grant, found := keeper.GetGrant(ctx, granter, grantee, "bank/send")
+	require.True(t, found)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 20)), grant.Authorization.(*types.SendAuthorization).SpendLimit)
+
+	res = keeper.DispatchActions(ctx, grantee, send(25))
+	require.False(t, res.IsOK())
+	require.Equal(t, types.CodeAuthorizationExceeded, res.Code)
+
+	// the authorization is deleted once used up
+	res = keeper.DispatchActions(ctx, grantee, send(20))
+	require.True(t, res.IsOK(), res.Log)
+	_, found = keeper.GetGrant(ctx, granter, grantee, "bank/send")
+	require.False(t, found)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 70)), bk.GetCoins(ctx, granter))
+
+	// expired authorizations can't be used
+	keeper.Grant(ctx, types.NewAuthorizationGrant(granter, grantee, types.NewGenericAuthorization("bank/send"), now.Add(time.Hour)))
+	res = keeper.DispatchActions(ctx.WithBlockHeader(abci.Header{Time: now.Add(time.Hour)}), grantee, send(10))
+	require.False(t, res.IsOK())
+	require.Equal(t, types.CodeNoAuthorization, res.Code)
+
+	// generic authorizations aren't limited
+	res = keeper.DispatchActions(ctx, grantee, send(50))
+	require.True(t, res.IsOK(), res.Log)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 20)), bk.GetCoins(ctx, granter))
+
+	// msgs signed by the grantee itself need no authorization
+	ak.SetAccount(ctx, ak.NewAccountWithAddress(ctx, grantee))
+	require.NoError(t, bk.SetCoins(ctx, grantee, sdk.NewCoins(sdk.NewInt64Coin("stake", 5))))
+	res = keeper.DispatchActions(ctx, grantee, []sdk.Msg{bank.NewMsgSend(grantee, other, sdk.NewCoins(sdk.NewInt64Coin("stake", 5)))})
+	require.True(t, res.IsOK(), res.Log)
+}
+
+func TestKeeperDispatchActionsUpgradeGates(t *testing.T) {
+	defer func() { sdk.GlobalUpgradeMgr = sdk.NewUpgradeManager() }()
+	sdk.GlobalUpgradeMgr = sdk.NewUpgradeManager()
+	sdk.GlobalUpgradeMgr.RegisterUpgradeHeight("newSend", 10)
+	sdk.GlobalUpgradeMgr.RegisterNewMsg("newSend", bank.MsgSend{}.Type())
+	sdk.GlobalUpgradeMgr.RegisterUpgradeHeight("retireSend", 20)
+	sdk.GlobalUpgradeMgr.RegisterRetiredMsg("retireSend", bank.MsgSend{}.Type())
+
+	ctx, ak, bk, keeper := SetupTestInput()
+	now := time.Unix(1577836800, 0).UTC()
+	ctx = ctx.WithBlockHeader(abci.Header{Time: now})
+
+	ak.SetAccount(ctx, ak.NewAccountWithAddress(ctx, grantee))
+	require.NoError(t, bk.SetCoins(ctx, grantee, sdk.NewCoins(sdk.NewInt64Coin("stake", 100))))
+	send := []sdk.Msg{bank.NewMsgSend(grantee, other, sdk.NewCoins(sdk.NewInt64Coin("stake", 10)))}
+
+	// msgs can't be executed before they are supported
+	sdk.GlobalUpgradeMgr.SetBlockHeight(9)
+	res := keeper.DispatchActions(ctx, grantee, send)
+	require.False(t, res.IsOK())
+	require.Equal(t, sdk.CodeUnsupportedMsg, res.Code)
+
+	sdk.GlobalUpgradeMgr.SetBlockHeight(10)
+	res = keeper.DispatchActions(ctx, grantee, send)
+	require.True(t, res.IsOK(), res.Log)
+
+	// nor after they are retired
+	sdk.GlobalUpgradeMgr.SetBlockHeight(20)
+	res = keeper.DispatchActions(ctx, grantee, send)
+	require.False(t, res.IsOK())
+	require.Equal(t, sdk.CodeRetiredMsg, res.Code)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 10)), bk.GetCoins(ctx, other))
+}
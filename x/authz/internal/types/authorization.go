@@ -0,0 +1,203 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/shinecloudfoundation/shinecloudnet/types"
+	bank "github.com/shinecloudfoundation/shinecloudnet/x/bank/types"
+	staking "github.com/shinecloudfoundation/shinecloudnet/x/staking/types"
+)
+
+// Authorization is implemented by the authorizations a granter can give to a
+// grantee to execute msgs on its behalf
+type Authorization interface {
+	// MsgType returns the type of the msgs the authorization applies to, see
+	// MsgTypeOf
+	MsgType() string
+
+	// Accept checks whether msg is allowed by the authorization and uses it
+	// up. It returns remove true if the authorization is used up and should
+	// be deleted.
+	Accept(msg sdk.Msg) (remove bool, err sdk.Error)
+
+	// ValidateBasic performs a stateless validation of the authorization
+	ValidateBasic() sdk.Error
+}
+
+var (
+	_ Authorization = (*SendAuthorization)(nil)
+	_ Authorization = (*GenericAuthorization)(nil)
+	_ Authorization = (*StakeAuthorization)(nil)
+)
+
+// MsgTypeOf returns the type authorizations refer to msg by, its route and
+// type joined by a slash, e.g. "bank/send"
+func MsgTypeOf(msg sdk.Msg) string {
+	return fmt.Sprintf("%s/%s", msg.Route(), msg.Type())
+}
+
+// staking msg types a StakeAuthorization can be given for
+var (
+	StakeMsgDelegate   = MsgTypeOf(staking.MsgDelegate{})
+	StakeMsgRedelegate = MsgTypeOf(staking.MsgBeginRedelegate{})
+	StakeMsgUndelegate = MsgTypeOf(staking.MsgUndelegate{})
+)
+
+// SendAuthorization allows the grantee to send up to SpendLimit from the
+// granter's account
+type SendAuthorization struct {
+	SpendLimit sdk.Coins `json:"spend_limit" yaml:"spend_limit"`
+}
+
+// NewSendAuthorization creates a new SendAuthorization object
+func NewSendAuthorization(spendLimit sdk.Coins) *SendAuthorization {
+	return &SendAuthorization{
+		SpendLimit: spendLimit,
+	}
+}
+
+// MsgType implements Authorization
+func (a SendAuthorization) MsgType() string {
+	return MsgTypeOf(bank.MsgSend{})
+}
+
+// Accept implements Authorization
+func (a *SendAuthorization) Accept(msg sdk.Msg) (bool, sdk.Error) {
+	send, ok := msg.(bank.MsgSend)
+	if !ok {
+		return false, ErrAuthorizationExceeded(DefaultCodespace, fmt.Sprintf("expected a send msg, got %T", msg))
+	}
+
+	left, hasNeg := a.SpendLimit.SafeSub(send.Amount)
+	if hasNeg {
+		return false, ErrAuthorizationExceeded(DefaultCodespace,
+			fmt.Sprintf("send amount %s exceeds the spend limit %s", send.Amount, a.SpendLimit))
+	}
+	a.SpendLimit = left
+	return left.IsZero(), nil
+}
+
+// ValidateBasic implements Authorization
+func (a SendAuthorization) ValidateBasic() sdk.Error {
+	if !a.SpendLimit.IsValid() || !a.SpendLimit.IsAllPositive() {
+		return ErrInvalidAuthorization(DefaultCodespace, fmt.Sprintf("invalid spend limit %s", a.SpendLimit))
+	}
+	return nil
+}
+
+func (a SendAuthorization) String() string {
+	return fmt.Sprintf(`Send Authorization:
+  Spend Limit: %s`, a.SpendLimit)
+}
+
+// GenericAuthorization allows the grantee to execute any msg of type Msg
+type GenericAuthorization struct {
+	Msg string `json:"msg" yaml:"msg"`
+}
+
+// NewGenericAuthorization creates a new GenericAuthorization object
+func NewGenericAuthorization(msgType string) *GenericAuthorization {
+	return &GenericAuthorization{
+		Msg: msgType,
+	}
+}
+
+// MsgType implements Authorization
+func (a GenericAuthorization) MsgType() string {
+	return a.Msg
+}
+
+// Accept implements Authorization
+func (a *GenericAuthorization) Accept(msg sdk.Msg) (bool, sdk.Error) {
+	return false, nil
+}
+
+// ValidateBasic implements Authorization
+func (a GenericAuthorization) ValidateBasic() sdk.Error {
+	parts := strings.Split(a.Msg, "/")
+	if len(parts) != 2 || strings.TrimSpace(parts[0]) == "" || strings.TrimSpace(parts[1]) == "" {
+		return ErrInvalidAuthorization(DefaultCodespace,
+			fmt.Sprintf("invalid msg type %q, expected route/type", a.Msg))
+	}
+	return nil
+}
+
+func (a GenericAuthorization) String() string {
+	return fmt.Sprintf(`Generic Authorization:
+  Msg: %s`, a.Msg)
+}
+
+// StakeAuthorization allows the grantee to delegate to, redelegate to or
+// undelegate from the validators of the allow list, depending on Msg
+type StakeAuthorization struct {
+	Msg       string           `json:"msg" yaml:"msg"`
+	AllowList []sdk.ValAddress `json:"allow_list" yaml:"allow_list"`
+}
+
+// NewStakeAuthorization creates a new StakeAuthorization object
+func NewStakeAuthorization(msgType string, allowList []sdk.ValAddress) *StakeAuthorization {
+	return &StakeAuthorization{
+		Msg:       msgType,
+		AllowList: allowList,
+	}
+}
+
+// MsgType implements Authorization
+func (a StakeAuthorization) MsgType() string {
+	return a.Msg
+}
+
+// Accept implements Authorization
+func (a *StakeAuthorization) Accept(msg sdk.Msg) (bool, sdk.Error) {
+	var validator sdk.ValAddress
+	switch msg := msg.(type) {
+	case staking.MsgDelegate:
+		validator = msg.ValidatorAddress
+	case staking.MsgBeginRedelegate:
+		validator = msg.ValidatorDstAddress
+	case staking.MsgUndelegate:
+		validator = msg.ValidatorAddress
+	}
+
+	if validator.Empty() || MsgTypeOf(msg) != a.Msg {
+		return false, ErrAuthorizationExceeded(DefaultCodespace, fmt.Sprintf("expected a %s msg, got %T", a.Msg, msg))
+	}
+
+	for _, allowed := range a.AllowList {
+		if allowed.Equals(validator) {
+			return false, nil
+		}
+	}
+	return false, ErrAuthorizationExceeded(DefaultCodespace,
+		fmt.Sprintf("validator %s isn't in the allow list", validator))
+}
+
+// ValidateBasic implements Authorization
+func (a StakeAuthorization) ValidateBasic() sdk.Error {
+	switch a.Msg {
+	case StakeMsgDelegate, StakeMsgRedelegate, StakeMsgUndelegate:
+	default:
+		return ErrInvalidAuthorization(DefaultCodespace, fmt.Sprintf("invalid staking msg type %q", a.Msg))
+	}
+
+	if len(a.AllowList) == 0 {
+		return ErrInvalidAuthorization(DefaultCodespace, "empty validator allow list")
+	}
+	for _, validator := range a.AllowList {
+		if validator.Empty() {
+			return ErrInvalidAuthorization(DefaultCodespace, "empty validator address in the allow list")
+		}
+	}
+	return nil
+}
+
+func (a StakeAuthorization) String() string {
+	validators := make([]string, len(a.AllowList))
+	for i, validator := range a.AllowList {
+		validators[i] = validator.String()
+	}
+	return fmt.Sprintf(`Stake Authorization:
+  Msg:        %s
+  Allow List: %s`, a.Msg, strings.Join(validators, ", "))
+}
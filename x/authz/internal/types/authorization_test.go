@@ -0,0 +1,84 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/shinecloudfoundation/shinecloudnet/types"
+	bank "github.com/shinecloudfoundation/shinecloudnet/x/bank/types"
+	staking "github.com/shinecloudfoundation/shinecloudnet/x/staking/types"
+)
+
+func TestSendAuthorization(t *testing.T) {
+	from := sdk.AccAddress([]byte("from"))
+	to := sdk.AccAddress([]byte("to"))
+	send := func(amount int64) sdk.Msg {
+		return bank.NewMsgSend(from, to, sdk.NewCoins(sdk.NewInt64Coin("stake", amount)))
+	}
+
+	auth := NewSendAuthorization(sdk.NewCoins(sdk.NewInt64Coin("stake", 10)))
+	require.NoError(t, auth.ValidateBasic())
+	require.Equal(t, "bank/send", auth.MsgType())
+
+	remove, err := auth.Accept(send(4))
+	require.NoError(t, err)
+	require.False(t, remove)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 6)), auth.SpendLimit)
+
+	_, err = auth.Accept(send(7))
+	require.Error(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 6)), auth.SpendLimit)
+
+	remove, err = auth.Accept(send(6))
+	require.NoError(t, err)
+	require.True(t, remove)
+
+	_, err = NewSendAuthorization(sdk.NewCoins(sdk.NewInt64Coin("stake", 10))).Accept(staking.MsgDelegate{})
+	require.Error(t, err)
+
+	require.Error(t, NewSendAuthorization(nil).ValidateBasic())
+}
+
+func TestGenericAuthorization(t *testing.T) {
+	auth := NewGenericAuthorization("gov/vote")
+	require.NoError(t, auth.ValidateBasic())
+	require.Equal(t, "gov/vote", auth.MsgType())
+
+	remove, err := auth.Accept(bank.MsgSend{})
+	require.NoError(t, err)
+	require.False(t, remove)
+
+	require.Error(t, NewGenericAuthorization("vote").ValidateBasic())
+	require.Error(t, NewGenericAuthorization("gov/").ValidateBasic())
+}
+
+func TestStakeAuthorization(t *testing.T) {
+	delegator := sdk.AccAddress([]byte("delegator"))
+	val1 := sdk.ValAddress([]byte("val1"))
+	val2 := sdk.ValAddress([]byte("val2"))
+	amount := sdk.NewInt64Coin("stake", 10)
+
+	auth := NewStakeAuthorization(StakeMsgRedelegate, []sdk.ValAddress{val1})
+	require.NoError(t, auth.ValidateBasic())
+	require.Equal(t, "staking/begin_redelegate", auth.MsgType())
+
+	// redelegating away from any validator to an allowed one
+	remove, err := auth.Accept(staking.NewMsgBeginRedelegate(delegator, val2, val1, amount))
+	require.NoError(t, err)
+	require.False(t, remove)
+
+	_, err = auth.Accept(staking.NewMsgBeginRedelegate(delegator, val1, val2, amount))
+	require.Error(t, err)
+
+	// the authorization only covers its own msg type
+	_, err = auth.Accept(staking.NewMsgDelegate(delegator, val1, amount))
+	require.Error(t, err)
+
+	delegate := NewStakeAuthorization(StakeMsgDelegate, []sdk.ValAddress{val1})
+	_, err = delegate.Accept(staking.NewMsgDelegate(delegator, val1, amount))
+	require.NoError(t, err)
+
+	require.Error(t, NewStakeAuthorization(StakeMsgDelegate, nil).ValidateBasic())
+	require.Error(t, NewStakeAuthorization("bank/send", []sdk.ValAddress{val1}).ValidateBasic())
+}
@@ -0,0 +1,44 @@
+package types
+
+import (
+	"github.com/shinecloudfoundation/shinecloudnet/codec"
+	sdk "github.com/shinecloudfoundation/shinecloudnet/types"
+	bank "github.com/shinecloudfoundation/shinecloudnet/x/bank/types"
+	distr "github.com/shinecloudfoundation/shinecloudnet/x/distribution/types"
+	gov "github.com/shinecloudfoundation/shinecloudnet/x/gov/types"
+	staking "github.com/shinecloudfoundation/shinecloudnet/x/staking/types"
+)
+
+// module codec
+var ModuleCdc = codec.New()
+
+// RegisterCodec registers the authorizations and the msgs of the module
+func RegisterCodec(cdc *codec.Codec) {
+	cdc.RegisterInterface((*Authorization)(nil), nil)
+	cdc.RegisterConcrete(&SendAuthorization{}, "cosmos-sdk/SendAuthorization", nil)
+	cdc.RegisterConcrete(&GenericAuthorization{}, "cosmos-sdk/GenericAuthorization", nil)
+	cdc.RegisterConcrete(&StakeAuthorization{}, "cosmos-sdk/StakeAuthorization", nil)
+
+	cdc.RegisterConcrete(MsgGrantAuthorization{}, "cosmos-sdk/MsgGrantAuthorization", nil)
+	cdc.RegisterConcrete(MsgRevokeAuthorization{}, "cosmos-sdk/MsgRevokeAuthorization", nil)
+	cdc.RegisterConcrete(MsgExec{}, "cosmos-sdk/MsgExec", nil)
+}
+
+// RegisterMsgTypeCodec registers an external msg type defined in another
+// module for the internal ModuleCdc. This allows MsgExec to be correctly
+// Amino encoded and decoded when it wraps msgs of that type.
+func RegisterMsgTypeCodec(o interface{}, name string) {
+	ModuleCdc.RegisterConcrete(o, name, nil)
+}
+
+func init() {
+	sdk.RegisterCodec(ModuleCdc)
+	RegisterCodec(ModuleCdc)
+	codec.RegisterCrypto(ModuleCdc)
+
+	// the msgs authorizations are typically given for
+	bank.RegisterCodec(ModuleCdc)
+	staking.RegisterCodec(ModuleCdc)
+	distr.RegisterCodec(ModuleCdc)
+	gov.RegisterCodec(ModuleCdc)
+}
@@ -0,0 +1,42 @@
+//nolint
+package types
+
+import (
+	sdk "github.com/shinecloudfoundation/shinecloudnet/types"
+)
+
+// Local code type
+type CodeType = sdk.CodeType
+
+const (
+	// Default authz codespace
+	DefaultCodespace sdk.CodespaceType = ModuleName
+
+	CodeNoAuthorization       CodeType = 101
+	CodeInvalidAuthorization  CodeType = 102
+	CodeAuthorizationExceeded CodeType = 103
+	CodeInvalidExpiration     CodeType = 104
+)
+
+// ErrNoAuthorization is returned if the granter gave no valid authorization
+// for a msg type to the grantee
+func ErrNoAuthorization(codespace sdk.CodespaceType, msgType string) sdk.Error {
+	return sdk.NewError(codespace, CodeNoAuthorization, "no authorization for %s msgs", msgType)
+}
+
+// ErrInvalidAuthorization is returned if an authorization is malformed
+func ErrInvalidAuthorization(codespace sdk.CodespaceType, msg string) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidAuthorization, msg)
+}
+
+// ErrAuthorizationExceeded is returned if a msg isn't covered by the
+// authorization of its type
+func ErrAuthorizationExceeded(codespace sdk.CodespaceType, msg string) sdk.Error {
+	return sdk.NewError(codespace, CodeAuthorizationExceeded, msg)
+}
+
+// ErrInvalidExpiration is returned if an authorization expires before the
+// block time
+func ErrInvalidExpiration(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidExpiration, "authorization expiration must be after the block time")
+}
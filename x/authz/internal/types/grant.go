@@ -0,0 +1,74 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	sdk "github.com/shinecloudfoundation/shinecloudnet/types"
+)
+
+// AuthorizationGrant is an authorization given by a granter to a grantee
+// until Expiration
+type AuthorizationGrant struct {
+	Granter       sdk.AccAddress `json:"granter" yaml:"granter"`
+	Grantee       sdk.AccAddress `json:"grantee" yaml:"grantee"`
+	Authorization Authorization  `json:"authorization" yaml:"authorization"`
+	Expiration    time.Time      `json:"expiration" yaml:"expiration"`
+}
+
+// NewAuthorizationGrant creates a new AuthorizationGrant object
+func NewAuthorizationGrant(granter, grantee sdk.AccAddress, authorization Authorization,
+	expiration time.Time) AuthorizationGrant {
+
+	return AuthorizationGrant{
+		Granter:       granter,
+		Grantee:       grantee,
+		Authorization: authorization,
+		Expiration:    expiration,
+	}
+}
+
+// IsExpired returns true if the grant has expired at blockTime
+func (g AuthorizationGrant) IsExpired(blockTime time.Time) bool {
+	return !blockTime.Before(g.Expiration)
+}
+
+// ValidateBasic performs a stateless validation of the grant
+func (g AuthorizationGrant) ValidateBasic() sdk.Error {
+	if g.Granter.Empty() {
+		return sdk.ErrInvalidAddress("missing granter address")
+	}
+	if g.Grantee.Empty() {
+		return sdk.ErrInvalidAddress("missing grantee address")
+	}
+	if g.Granter.Equals(g.Grantee) {
+		return sdk.ErrInvalidAddress("cannot self-grant authorizations")
+	}
+	if g.Expiration.IsZero() {
+		return ErrInvalidAuthorization(DefaultCodespace, "missing expiration")
+	}
+	if g.Authorization == nil {
+		return ErrInvalidAuthorization(DefaultCodespace, "missing authorization")
+	}
+	return g.Authorization.ValidateBasic()
+}
+
+func (g AuthorizationGrant) String() string {
+	return fmt.Sprintf(`Authorization Grant:
+  Granter:    %s
+  Grantee:    %s
+  Expiration: %s
+  %s`, g.Granter, g.Grantee, g.Expiration, g.Authorization)
+}
+
+// AuthorizationGrants is a list of authorization grants
+type AuthorizationGrants []AuthorizationGrant
+
+func (gs AuthorizationGrants) String() string {
+	out := make([]string, len(gs))
+	for i, g := range gs {
+		out[i] = g.String()
+	}
+	return strings.Join(out, "\n")
+}
@@ -0,0 +1,36 @@
+package types
+
+import (
+	sdk "github.com/shinecloudfoundation/shinecloudnet/types"
+)
+
+const (
+	// ModuleName is the module name constant used in many places
+	ModuleName = "authz"
+
+	// StoreKey is the store key string for the authz module
+	StoreKey = ModuleName
+
+	// RouterKey is the message route for the authz module
+	RouterKey = ModuleName
+
+	// QuerierRoute is the querier route for the authz module
+	QuerierRoute = ModuleName
+)
+
+var (
+	// AuthorizationKeyPrefix is the prefix of the kvstore for authorization grants
+	AuthorizationKeyPrefix = []byte{0x00}
+)
+
+// GetAuthorizationKey is the key used to store the authorization of a msg
+// type given by granter to grantee
+func GetAuthorizationKey(granter, grantee sdk.AccAddress, msgType string) []byte {
+	return append(GetAuthorizationsKey(granter, grantee), []byte(msgType)...)
+}
+
+// GetAuthorizationsKey is the prefix of the keys of all the authorizations
+// given by granter to grantee
+func GetAuthorizationsKey(granter, grantee sdk.AccAddress) []byte {
+	return append(append(AuthorizationKeyPrefix, granter...), grantee...)
+}
@@ -0,0 +1,178 @@
+package types
+
+import (
+	"encoding/json"
+	"time"
+
+	sdk "github.com/shinecloudfoundation/shinecloudnet/types"
+)
+
+// authz message types
+const (
+	TypeMsgGrantAuthorization  = "grant_authorization"
+	TypeMsgRevokeAuthorization = "revoke_authorization"
+	TypeMsgExec                = "exec"
+)
+
+var (
+	_ sdk.Msg = MsgGrantAuthorization{}
+	_ sdk.Msg = MsgRevokeAuthorization{}
+	_ sdk.Msg = MsgExec{}
+)
+
+// MsgGrantAuthorization gives Grantee the Authorization to execute msgs on
+// behalf of Granter until Expiration. It replaces any existing authorization
+// for the same msg type.
+type MsgGrantAuthorization struct {
+	Granter       sdk.AccAddress `json:"granter" yaml:"granter"`
+	Grantee       sdk.AccAddress `json:"grantee" yaml:"grantee"`
+	Authorization Authorization  `json:"authorization" yaml:"authorization"`
+	Expiration    time.Time      `json:"expiration" yaml:"expiration"`
+}
+
+// NewMsgGrantAuthorization creates a new MsgGrantAuthorization object
+func NewMsgGrantAuthorization(granter, grantee sdk.AccAddress, authorization Authorization,
+	expiration time.Time) MsgGrantAuthorization {
+
+	return MsgGrantAuthorization{
+		Granter:       granter,
+		Grantee:       grantee,
+		Authorization: authorization,
+		Expiration:    expiration,
+	}
+}
+
+// Route implements sdk.Msg
+func (msg MsgGrantAuthorization) Route() string { return RouterKey }
+
+// Type implements sdk.Msg
+func (msg MsgGrantAuthorization) Type() string { return TypeMsgGrantAuthorization }
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgGrantAuthorization) ValidateBasic() sdk.Error {
+	return NewAuthorizationGrant(msg.Granter, msg.Grantee, msg.Authorization, msg.Expiration).ValidateBasic()
+}
+
+// GetSignBytes implements sdk.Msg
+func (msg MsgGrantAuthorization) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners implements sdk.Msg
+func (msg MsgGrantAuthorization) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Granter}
+}
+
+// MsgRevokeAuthorization removes the authorization for MsgType msgs given by
+// Granter to Grantee
+type MsgRevokeAuthorization struct {
+	Granter sdk.AccAddress `json:"granter" yaml:"granter"`
+	Grantee sdk.AccAddress `json:"grantee" yaml:"grantee"`
+	MsgType string         `json:"msg_type" yaml:"msg_type"`
+}
+
+// NewMsgRevokeAuthorization creates a new MsgRevokeAuthorization object
+func NewMsgRevokeAuthorization(granter, grantee sdk.AccAddress, msgType string) MsgRevokeAuthorization {
+	return MsgRevokeAuthorization{
+		Granter: granter,
+		Grantee: grantee,
+		MsgType: msgType,
+	}
+}
+
+// Route implements sdk.Msg
+func (msg MsgRevokeAuthorization) Route() string { return RouterKey }
+
+// Type implements sdk.Msg
+func (msg MsgRevokeAuthorization) Type() string { return TypeMsgRevokeAuthorization }
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgRevokeAuthorization) ValidateBasic() sdk.Error {
+	if msg.Granter.Empty() {
+		return sdk.ErrInvalidAddress("missing granter address")
+	}
+	if msg.Grantee.Empty() {
+		return sdk.ErrInvalidAddress("missing grantee address")
+	}
+	if msg.MsgType == "" {
+		return ErrInvalidAuthorization(DefaultCodespace, "missing msg type")
+	}
+	return nil
+}
+
+// GetSignBytes implements sdk.Msg
+func (msg MsgRevokeAuthorization) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners implements sdk.Msg
+func (msg MsgRevokeAuthorization) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Granter}
+}
+
+// MsgExec executes Msgs on behalf of their signers, which must have given
+// Grantee an authorization for each of them
+type MsgExec struct {
+	Grantee sdk.AccAddress `json:"grantee" yaml:"grantee"`
+	Msgs    []sdk.Msg      `json:"msgs" yaml:"msgs"`
+}
+
+// NewMsgExec creates a new MsgExec object
+func NewMsgExec(grantee sdk.AccAddress, msgs []sdk.Msg) MsgExec {
+	return MsgExec{
+		Grantee: grantee,
+		Msgs:    msgs,
+	}
+}
+
+// Route implements sdk.Msg
+func (msg MsgExec) Route() string { return RouterKey }
+
+// Type implements sdk.Msg
+func (msg MsgExec) Type() string { return TypeMsgExec }
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgExec) ValidateBasic() sdk.Error {
+	if msg.Grantee.Empty() {
+		return sdk.ErrInvalidAddress("missing grantee address")
+	}
+	if len(msg.Msgs) == 0 {
+		return sdk.ErrUnknownRequest("no msgs to execute")
+	}
+	for _, m := range msg.Msgs {
+		if _, ok := m.(MsgExec); ok {
+			return sdk.ErrUnknownRequest("nested exec msgs are not allowed")
+		}
+		if len(m.GetSigners()) != 1 {
+			return sdk.ErrUnauthorized("only msgs with a single signer can be executed")
+		}
+		if err := m.ValidateBasic(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// msgExecSignDoc is the MsgExec signed over, the msgs are encoded by their
+// own GetSignBytes so msgs of any module can be executed
+type msgExecSignDoc struct {
+	Grantee sdk.AccAddress    `json:"grantee" yaml:"grantee"`
+	Msgs    []json.RawMessage `json:"msgs" yaml:"msgs"`
+}
+
+// GetSignBytes implements sdk.Msg
+func (msg MsgExec) GetSignBytes() []byte {
+	msgsBytes := make([]json.RawMessage, 0, len(msg.Msgs))
+	for _, m := range msg.Msgs {
+		msgsBytes = append(msgsBytes, json.RawMessage(m.GetSignBytes()))
+	}
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msgExecSignDoc{
+		Grantee: msg.Grantee,
+		Msgs:    msgsBytes,
+	}))
+}
+
+// GetSigners implements sdk.Msg
+func (msg MsgExec) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Grantee}
+}
@@ -0,0 +1,49 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/shinecloudfoundation/shinecloudnet/types"
+	bank "github.com/shinecloudfoundation/shinecloudnet/x/bank/types"
+	slashing "github.com/shinecloudfoundation/shinecloudnet/x/slashing/types"
+)
+
+func TestMsgGrantAuthorizationValidateBasic(t *testing.T) {
+	granter := sdk.AccAddress([]byte("granter"))
+	grantee := sdk.AccAddress([]byte("grantee"))
+	auth := NewGenericAuthorization("gov/vote")
+	expiration := time.Unix(1600000000, 0)
+
+	require.NoError(t, NewMsgGrantAuthorization(granter, grantee, auth, expiration).ValidateBasic())
+	require.Error(t, NewMsgGrantAuthorization(granter, granter, auth, expiration).ValidateBasic())
+	require.Error(t, NewMsgGrantAuthorization(granter, grantee, auth, time.Time{}).ValidateBasic())
+	require.Error(t, NewMsgGrantAuthorization(granter, grantee, nil, expiration).ValidateBasic())
+	require.Error(t, NewMsgGrantAuthorization(nil, grantee, auth, expiration).ValidateBasic())
+}
+
+func TestMsgExec(t *testing.T) {
+	granter := sdk.AccAddress([]byte("granter"))
+	grantee := sdk.AccAddress([]byte("grantee"))
+	send := bank.NewMsgSend(granter, grantee, sdk.NewCoins(sdk.NewInt64Coin("stake", 10)))
+
+	msg := NewMsgExec(grantee, []sdk.Msg{send})
+	require.NoError(t, msg.ValidateBasic())
+	require.Equal(t, []sdk.AccAddress{grantee}, msg.GetSigners())
+	require.Contains(t, string(msg.GetSignBytes()), "cosmos-sdk/MsgSend")
+
+	// msgs of modules not registered in the module codec are signed too
+	unjail := slashing.NewMsgUnjail(sdk.ValAddress(granter))
+	msg = NewMsgExec(grantee, []sdk.Msg{unjail})
+	require.NotPanics(t, func() { msg.GetSignBytes() })
+	require.Contains(t, string(msg.GetSignBytes()), string(unjail.GetSignBytes()))
+
+	require.Error(t, NewMsgExec(grantee, nil).ValidateBasic())
+	require.Error(t, NewMsgExec(nil, []sdk.Msg{send}).ValidateBasic())
+	require.Error(t, NewMsgExec(grantee, []sdk.Msg{bank.NewMsgSend(granter, grantee, sdk.Coins{})}).ValidateBasic())
+
+	// exec msgs can't be nested
+	require.Error(t, NewMsgExec(grantee, []sdk.Msg{NewMsgExec(grantee, []sdk.Msg{send})}).ValidateBasic())
+}
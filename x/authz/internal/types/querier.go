@@ -0,0 +1,43 @@
+package types
+
+import (
+	sdk "github.com/shinecloudfoundation/shinecloudnet/types"
+)
+
+// querier keys
+const (
+	QueryAuthorization  = "authorization"
+	QueryAuthorizations = "authorizations"
+)
+
+// QueryAuthorizationParams defines the params for querying the authorization
+// for a msg type given by a granter to a grantee
+type QueryAuthorizationParams struct {
+	Granter sdk.AccAddress `json:"granter" yaml:"granter"`
+	Grantee sdk.AccAddress `json:"grantee" yaml:"grantee"`
+	MsgType string         `json:"msg_type" yaml:"msg_type"`
+}
+
+// NewQueryAuthorizationParams creates a new QueryAuthorizationParams object
+func NewQueryAuthorizationParams(granter, grantee sdk.AccAddress, msgType string) QueryAuthorizationParams {
+	return QueryAuthorizationParams{
+		Granter: granter,
+		Grantee: grantee,
+		MsgType: msgType,
+	}
+}
+
+// QueryAuthorizationsParams defines the params for querying all the
+// authorizations given by a granter to a grantee
+type QueryAuthorizationsParams struct {
+	Granter sdk.AccAddress `json:"granter" yaml:"granter"`
+	Grantee sdk.AccAddress `json:"grantee" yaml:"grantee"`
+}
+
+// NewQueryAuthorizationsParams creates a new QueryAuthorizationsParams object
+func NewQueryAuthorizationsParams(granter, grantee sdk.AccAddress) QueryAuthorizationsParams {
+	return QueryAuthorizationsParams{
+		Granter: granter,
+		Grantee: grantee,
+	}
+}
@@ -17,6 +17,7 @@ const (
 	RouterKey                = types.RouterKey
 	QuerierRoute             = types.QuerierRoute
 	DefaultParamspace        = types.DefaultParamspace
+	DefaultSendEnabled       = types.DefaultSendEnabled
 )
 
 var (
@@ -26,21 +27,29 @@ var (
 	ErrNoOutputs           = types.ErrNoOutputs
 	ErrInputOutputMismatch = types.ErrInputOutputMismatch
 	ErrSendDisabled        = types.ErrSendDisabled
+	ErrSendDisabledDenom   = types.ErrSendDisabledDenom
 	NewBaseKeeper          = keeper.NewBaseKeeper
 	NewInput               = types.NewInput
 	NewOutput              = types.NewOutput
 	ParamKeyTable          = types.ParamKeyTable
+	NewParams              = types.NewParams
+	DefaultParams          = types.DefaultParams
+	NewSendEnabledDenom    = types.NewSendEnabledDenom
 
 	// variable aliases
-	ModuleCdc                = types.ModuleCdc
-	ParamStoreKeySendEnabled = types.ParamStoreKeySendEnabled
+	ModuleCdc                      = types.ModuleCdc
+	ParamStoreKeySendEnabled       = types.ParamStoreKeySendEnabled
+	ParamStoreKeySendEnabledDenoms = types.ParamStoreKeySendEnabledDenoms
 )
 
 type (
-	BaseKeeper   = keeper.BaseKeeper // ibc module depends on this
-	Keeper       = keeper.Keeper
-	MsgSend      = types.MsgSend
-	MsgMultiSend = types.MsgMultiSend
-	Input        = types.Input
-	Output       = types.Output
+	BaseKeeper        = keeper.BaseKeeper // ibc module depends on this
+	Keeper            = keeper.Keeper
+	MsgSend           = types.MsgSend
+	MsgMultiSend      = types.MsgMultiSend
+	Input             = types.Input
+	Output            = types.Output
+	Params            = types.Params
+	SendEnabledDenom  = types.SendEnabledDenom
+	SendEnabledDenoms = types.SendEnabledDenoms
 )
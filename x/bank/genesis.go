@@ -6,27 +6,38 @@ import (
 
 // GenesisState is the bank state that must be provided at genesis.
 type GenesisState struct {
-	SendEnabled bool `json:"send_enabled" yaml:"send_enabled"`
+	SendEnabled       bool              `json:"send_enabled" yaml:"send_enabled"`
+	SendEnabledDenoms SendEnabledDenoms `json:"send_enabled_denoms,omitempty" yaml:"send_enabled_denoms,omitempty"`
+	BlockedAddrs      []sdk.AccAddress  `json:"blocked_addrs,omitempty" yaml:"blocked_addrs,omitempty"`
 }
 
 // NewGenesisState creates a new genesis state.
-func NewGenesisState(sendEnabled bool) GenesisState {
-	return GenesisState{SendEnabled: sendEnabled}
+func NewGenesisState(sendEnabled bool, sendEnabledDenoms SendEnabledDenoms, blockedAddrs []sdk.AccAddress) GenesisState {
+	return GenesisState{
+		SendEnabled:       sendEnabled,
+		SendEnabledDenoms: sendEnabledDenoms,
+		BlockedAddrs:      blockedAddrs,
+	}
 }
 
 // DefaultGenesisState returns a default genesis state
-func DefaultGenesisState() GenesisState { return NewGenesisState(true) }
+func DefaultGenesisState() GenesisState {
+	return NewGenesisState(true, SendEnabledDenoms{}, []sdk.AccAddress{})
+}
 
 // InitGenesis sets distribution information for genesis.
 func InitGenesis(ctx sdk.Context, keeper Keeper, data GenesisState) {
-	keeper.SetSendEnabled(ctx, data.SendEnabled)
+	keeper.SetParams(ctx, NewParams(data.SendEnabled, data.SendEnabledDenoms, data.BlockedAddrs))
 }
 
 // ExportGenesis returns a GenesisState for a given context and keeper.
 func ExportGenesis(ctx sdk.Context, keeper Keeper) GenesisState {
-	return NewGenesisState(keeper.GetSendEnabled(ctx))
+	params := keeper.GetParams(ctx)
+	return NewGenesisState(params.SendEnabled, params.SendEnabledDenoms, params.BlockedAddrs)
 }
 
 // ValidateGenesis performs basic validation of bank genesis data returning an
 // error for any failed validation criteria.
-func ValidateGenesis(data GenesisState) error { return nil }
+func ValidateGenesis(data GenesisState) error {
+	return NewParams(data.SendEnabled, data.SendEnabledDenoms, data.BlockedAddrs).ValidateParamSet()
+}
@@ -145,8 +145,13 @@ type SendKeeper interface {
 
 	GetSendEnabled(ctx sdk.Context) bool
 	SetSendEnabled(ctx sdk.Context, enabled bool)
+	IsSendEnabledCoins(ctx sdk.Context, coins ...sdk.Coin) sdk.Error
+
+	GetParams(ctx sdk.Context) types.Params
+	SetParams(ctx sdk.Context, params types.Params)
 
 	BlacklistedAddr(addr sdk.AccAddress) bool
+	BlockedAddr(ctx sdk.Context, addr sdk.AccAddress) bool
 }
 
 var _ SendKeeper = (*BaseSendKeeper)(nil)
@@ -197,7 +202,12 @@ func (keeper BaseSendKeeper) InputOutputCoins(ctx sdk.Context, inputs []types.In
 		)
 	}
 
+	params := keeper.GetParams(ctx)
 	for _, out := range outputs {
+		if params.IsBlockedAddr(out.Address) {
+			return errBlockedAddr(out.Address)
+		}
+
 		_, err := keeper.AddCoins(ctx, out.Address, out.Coins)
 		if err != nil {
 			return err
@@ -215,7 +225,14 @@ func (keeper BaseSendKeeper) InputOutputCoins(ctx sdk.Context, inputs []types.In
 }
 
 // SendCoins moves coins from one account to another
+//
+// The BlockedAddrs of the params can't receive coins, unless they are sent by
+// a module account so that e.g. deposits and rewards can still be paid out.
 func (keeper BaseSendKeeper) SendCoins(ctx sdk.Context, fromAddr sdk.AccAddress, toAddr sdk.AccAddress, amt sdk.Coins) sdk.Error {
+	if !keeper.BlacklistedAddr(fromAddr) && keeper.GetParams(ctx).IsBlockedAddr(toAddr) {
+		return errBlockedAddr(toAddr)
+	}
+
 	_, err := keeper.SubtractCoins(ctx, fromAddr, amt)
 	if err != nil {
 		return err
@@ -327,12 +344,48 @@ func (keeper BaseSendKeeper) SetSendEnabled(ctx sdk.Context, enabled bool) {
 	keeper.paramSpace.Set(ctx, types.ParamStoreKeySendEnabled, &enabled)
 }
 
+// IsSendEnabledCoins returns an error if any of the coins can't be sent, the
+// SendEnabledDenoms overrides taking precedence over SendEnabled
+func (keeper BaseSendKeeper) IsSendEnabledCoins(ctx sdk.Context, coins ...sdk.Coin) sdk.Error {
+	params := keeper.GetParams(ctx)
+	for _, coin := range coins {
+		if !params.IsSendEnabled(coin.Denom) {
+			return types.ErrSendDisabledDenom(keeper.codespace, coin.Denom)
+		}
+	}
+	return nil
+}
+
+// GetParams returns the bank parameters, the ones not stored yet keeping
+// their default value
+func (keeper BaseSendKeeper) GetParams(ctx sdk.Context) (params types.Params) {
+	params = types.DefaultParams()
+	keeper.paramSpace.GetParamSetIfExists(ctx, &params)
+	return
+}
+
+// SetParams sets the bank parameters
+func (keeper BaseSendKeeper) SetParams(ctx sdk.Context, params types.Params) {
+	keeper.paramSpace.SetParamSet(ctx, &params)
+}
+
 // BlacklistedAddr checks if a given address is blacklisted (i.e restricted from
 // receiving funds)
 func (keeper BaseSendKeeper) BlacklistedAddr(addr sdk.AccAddress) bool {
 	return keeper.blacklistedAddrs[addr.String()]
 }
 
+// BlockedAddr checks if a given address is restricted from receiving funds,
+// either as a blacklisted module account or as one of the BlockedAddrs of the
+// params
+func (keeper BaseSendKeeper) BlockedAddr(ctx sdk.Context, addr sdk.AccAddress) bool {
+	return keeper.BlacklistedAddr(addr) || keeper.GetParams(ctx).IsBlockedAddr(addr)
+}
+
+func errBlockedAddr(addr sdk.AccAddress) sdk.Error {
+	return sdk.ErrUnauthorized(fmt.Sprintf("%s is not allowed to receive transactions", addr))
+}
+
 var _ ViewKeeper = (*BaseViewKeeper)(nil)
 
 // ViewKeeper defines a module interface that facilitates read only access to
@@ -11,6 +11,7 @@ import (
 
 	sdk "github.com/shinecloudfoundation/shinecloudnet/types"
 	"github.com/shinecloudfoundation/shinecloudnet/x/auth"
+	authtypes "github.com/shinecloudfoundation/shinecloudnet/x/auth/types"
 	"github.com/shinecloudfoundation/shinecloudnet/x/bank/types"
 )
 
@@ -331,3 +332,70 @@ func TestUndelegateCoins(t *testing.T) {
 	require.Equal(t, origCoins, vacc.GetCoins())
 	require.True(t, macc.GetCoins().Empty())
 }
+
+func TestSendEnabledDenoms(t *testing.T) {
+	input := setupTestInput()
+	ctx := input.ctx
+
+	foo := sdk.NewInt64Coin("foocoin", 10)
+	bar := sdk.NewInt64Coin("barcoin", 10)
+
+	require.Equal(t, types.DefaultParams(), input.k.GetParams(ctx))
+	require.NoError(t, input.k.IsSendEnabledCoins(ctx, foo, bar))
+
+	// freeze a single denom
+	input.k.SetParams(ctx, types.NewParams(true, types.SendEnabledDenoms{types.NewSendEnabledDenom("barcoin", false)}, nil))
+	require.NoError(t, input.k.IsSendEnabledCoins(ctx, foo))
+	err := input.k.IsSendEnabledCoins(ctx, foo, bar)
+	require.Error(t, err)
+	require.Equal(t, types.CodeSendDisabled, err.Code())
+
+	// only allow a single denom
+	input.k.SetParams(ctx, types.NewParams(false, types.SendEnabledDenoms{types.NewSendEnabledDenom("barcoin", true)}, nil))
+	require.False(t, input.k.GetSendEnabled(ctx))
+	require.NoError(t, input.k.IsSendEnabledCoins(ctx, bar))
+	require.Error(t, input.k.IsSendEnabledCoins(ctx, foo))
+
+	// parameter change proposals are validated
+	subspace, ok := input.pk.GetSubspace(types.DefaultParamspace)
+	require.True(t, ok)
+	require.Error(t, subspace.Update(ctx, types.ParamStoreKeySendEnabledDenoms,
+		[]byte(`[{"denom":"foocoin","enabled":false},{"denom":"foocoin","enabled":true}]`)))
+	require.NoError(t, subspace.Update(ctx, types.ParamStoreKeySendEnabledDenoms,
+		[]byte(`[{"denom":"foocoin","enabled":true}]`)))
+	require.NoError(t, input.k.IsSendEnabledCoins(ctx, foo))
+	require.Error(t, input.k.IsSendEnabledCoins(ctx, bar))
+}
+
+func TestBlockedAddrs(t *testing.T) {
+	input := setupTestInput()
+	ctx := input.ctx
+
+	_, _, addr1 := authtypes.KeyTestPubAddr()
+	_, _, addr2 := authtypes.KeyTestPubAddr()
+	moduleAddr := sdk.AccAddress([]byte("moduleAcc"))
+	coins := sdk.NewCoins(sdk.NewInt64Coin("foocoin", 10))
+
+	input.k.SetCoins(ctx, addr1, coins.Add(coins))
+	input.k.SetCoins(ctx, moduleAddr, coins)
+
+	require.True(t, input.k.BlockedAddr(ctx, moduleAddr))
+	require.False(t, input.k.BlockedAddr(ctx, addr2))
+
+	input.k.SetParams(ctx, types.NewParams(true, nil, []sdk.AccAddress{addr2}))
+	require.True(t, input.k.BlockedAddr(ctx, addr2))
+
+	err := input.k.SendCoins(ctx, addr1, addr2, coins)
+	require.Error(t, err)
+	require.Equal(t, sdk.CodeUnauthorized, err.Code())
+
+	err = input.k.InputOutputCoins(ctx,
+		[]types.Input{types.NewInput(addr1, coins)}, []types.Output{types.NewOutput(addr2, coins)})
+	require.Error(t, err)
+	require.Equal(t, sdk.CodeUnauthorized, err.Code())
+	require.True(t, input.k.GetCoins(ctx, addr2).IsZero())
+
+	// module accounts can still pay out to blocked addresses
+	require.NoError(t, input.k.SendCoins(ctx, moduleAddr, addr2, coins))
+	require.Equal(t, coins, input.k.GetCoins(ctx, addr2))
+}
@@ -13,6 +13,9 @@ import (
 const (
 	// query balance path
 	QueryBalance = "balances"
+
+	// query params path
+	QueryParams = "params"
 )
 
 // NewQuerier returns a new sdk.Keeper instance.
@@ -22,6 +25,9 @@ func NewQuerier(k Keeper) sdk.Querier {
 		case QueryBalance:
 			return queryBalance(ctx, req, k)
 
+		case QueryParams:
+			return queryParams(ctx, k)
+
 		default:
 			return nil, sdk.ErrUnknownRequest("unknown bank query endpoint")
 		}
@@ -44,3 +50,13 @@ func queryBalance(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, sdk
 
 	return bz, nil
 }
+
+// queryParams returns the bank params, including the blocked addresses
+func queryParams(ctx sdk.Context, k Keeper) ([]byte, sdk.Error) {
+	bz, err := codec.MarshalJSONIndent(types.ModuleCdc, k.GetParams(ctx))
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+
+	return bz, nil
+}
@@ -46,6 +46,22 @@ func TestBalances(t *testing.T) {
 	require.True(t, coins.AmountOf("foo").Equal(sdk.NewInt(10)))
 }
 
+func TestQueryParams(t *testing.T) {
+	input := setupTestInput()
+	querier := NewQuerier(input.k)
+
+	_, _, addr := authtypes.KeyTestPubAddr()
+	params := types.NewParams(true, nil, []sdk.AccAddress{addr})
+	input.k.SetParams(input.ctx, params)
+
+	res, err := querier(input.ctx, []string{QueryParams}, abci.RequestQuery{})
+	require.Nil(t, err)
+
+	var queried types.Params
+	require.NoError(t, input.cdc.UnmarshalJSON(res, &queried))
+	require.Equal(t, params, queried)
+}
+
 func TestQuerierRouteNotFound(t *testing.T) {
 	input := setupTestInput()
 	req := abci.RequestQuery{
@@ -1,6 +1,8 @@
 package types
 
 import (
+	"fmt"
+
 	sdk "github.com/shinecloudfoundation/shinecloudnet/types"
 )
 
@@ -31,3 +33,8 @@ func ErrInputOutputMismatch(codespace sdk.CodespaceType) sdk.Error {
 func ErrSendDisabled(codespace sdk.CodespaceType) sdk.Error {
 	return sdk.NewError(codespace, CodeSendDisabled, "send transactions are currently disabled")
 }
+
+// ErrSendDisabledDenom is returned if the coins of denom can't be sent
+func ErrSendDisabledDenom(codespace sdk.CodespaceType, denom string) sdk.Error {
+	return sdk.NewError(codespace, CodeSendDisabled, fmt.Sprintf("%s transfers are currently disabled", denom))
+}
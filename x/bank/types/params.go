@@ -1,6 +1,10 @@
 package types
 
 import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/shinecloudfoundation/shinecloudnet/types"
 	"github.com/shinecloudfoundation/shinecloudnet/x/params"
 )
 
@@ -11,12 +15,159 @@ const (
 	DefaultSendEnabled = true
 )
 
-// ParamStoreKeySendEnabled is store's key for SendEnabled
-var ParamStoreKeySendEnabled = []byte("sendenabled")
+var (
+	// ParamStoreKeySendEnabled is store's key for SendEnabled
+	ParamStoreKeySendEnabled = []byte("sendenabled")
+	// ParamStoreKeySendEnabledDenoms is store's key for SendEnabledDenoms
+	ParamStoreKeySendEnabledDenoms = []byte("sendenableddenoms")
+	// ParamStoreKeyBlockedAddrs is store's key for BlockedAddrs
+	ParamStoreKeyBlockedAddrs = []byte("blockedaddrs")
+)
+
+var _ params.DefaultedParamSet = &Params{}
+
+// Params defines the parameters for the bank module. SendEnabled applies to
+// the denoms without an override in SendEnabledDenoms. BlockedAddrs can't
+// receive coins from accounts, in addition to the module accounts.
+type Params struct {
+	SendEnabled       bool              `json:"send_enabled" yaml:"send_enabled"`
+	SendEnabledDenoms SendEnabledDenoms `json:"send_enabled_denoms" yaml:"send_enabled_denoms"`
+	BlockedAddrs      []sdk.AccAddress  `json:"blocked_addrs" yaml:"blocked_addrs"`
+}
+
+// NewParams creates a new Params object
+func NewParams(sendEnabled bool, sendEnabledDenoms SendEnabledDenoms, blockedAddrs []sdk.AccAddress) Params {
+	return Params{
+		SendEnabled:       sendEnabled,
+		SendEnabledDenoms: sendEnabledDenoms,
+		BlockedAddrs:      blockedAddrs,
+	}
+}
+
+// DefaultParams returns a default set of parameters.
+func DefaultParams() Params {
+	return NewParams(DefaultSendEnabled, SendEnabledDenoms{}, []sdk.AccAddress{})
+}
 
 // ParamKeyTable type declaration for parameters
 func ParamKeyTable() params.KeyTable {
-	return params.NewKeyTable(
-		ParamStoreKeySendEnabled, false,
-	)
+	return params.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// ParamSetPairs implements the ParamSet interface and returns all the key/value pairs
+// pairs of bank module's parameters.
+// nolint
+func (p *Params) ParamSetPairs() params.ParamSetPairs {
+	return params.ParamSetPairs{
+		{ParamStoreKeySendEnabled, &p.SendEnabled},
+		{ParamStoreKeySendEnabledDenoms, &p.SendEnabledDenoms},
+		{ParamStoreKeyBlockedAddrs, &p.BlockedAddrs},
+	}
+}
+
+// ValidateParamSet validates the bank parameters, it implements
+// params.ValidatedParamSet
+func (p Params) ValidateParamSet() error {
+	if err := p.SendEnabledDenoms.Validate(); err != nil {
+		return err
+	}
+	return ValidateBlockedAddrs(p.BlockedAddrs)
+}
+
+// DefaultParamSet returns the default bank parameters, it implements
+// params.DefaultedParamSet
+func (p Params) DefaultParamSet() params.ValidatedParamSet {
+	defaults := DefaultParams()
+	return &defaults
+}
+
+// IsSendEnabled returns whether coins of denom can be sent
+func (p Params) IsSendEnabled(denom string) bool {
+	for _, d := range p.SendEnabledDenoms {
+		if d.Denom == denom {
+			return d.Enabled
+		}
+	}
+	return p.SendEnabled
+}
+
+// IsBlockedAddr returns whether addr is in BlockedAddrs
+func (p Params) IsBlockedAddr(addr sdk.AccAddress) bool {
+	for _, blocked := range p.BlockedAddrs {
+		if blocked.Equals(addr) {
+			return true
+		}
+	}
+	return false
+}
+
+// String implements the stringer interface.
+func (p Params) String() string {
+	blockedAddrs := make([]string, len(p.BlockedAddrs))
+	for i, addr := range p.BlockedAddrs {
+		blockedAddrs[i] = addr.String()
+	}
+	return fmt.Sprintf(`Params:
+  SendEnabled:       %t
+  SendEnabledDenoms: %s
+  BlockedAddrs:      %s`, p.SendEnabled, p.SendEnabledDenoms, strings.Join(blockedAddrs, ","))
+}
+
+// ValidateBlockedAddrs checks that the blocked addresses are non empty and unique
+func ValidateBlockedAddrs(addrs []sdk.AccAddress) error {
+	seen := make(map[string]bool, len(addrs))
+	for _, addr := range addrs {
+		if addr.Empty() {
+			return fmt.Errorf("empty blocked address")
+		}
+		if seen[addr.String()] {
+			return fmt.Errorf("duplicate blocked address %s", addr)
+		}
+		seen[addr.String()] = true
+	}
+	return nil
+}
+
+// SendEnabledDenom overrides the default SendEnabled for the coins of Denom
+type SendEnabledDenom struct {
+	Denom   string `json:"denom" yaml:"denom"`
+	Enabled bool   `json:"enabled" yaml:"enabled"`
+}
+
+// NewSendEnabledDenom creates a new SendEnabledDenom object
+func NewSendEnabledDenom(denom string, enabled bool) SendEnabledDenom {
+	return SendEnabledDenom{
+		Denom:   denom,
+		Enabled: enabled,
+	}
+}
+
+func (d SendEnabledDenom) String() string {
+	return fmt.Sprintf("%s:%t", d.Denom, d.Enabled)
+}
+
+// SendEnabledDenoms is a list of per denom overrides of SendEnabled
+type SendEnabledDenoms []SendEnabledDenom
+
+// Validate checks that the denoms are valid and unique
+func (ds SendEnabledDenoms) Validate() error {
+	denoms := make(map[string]bool, len(ds))
+	for _, d := range ds {
+		if !(sdk.Coins{sdk.Coin{Denom: d.Denom, Amount: sdk.OneInt()}}).IsValid() {
+			return fmt.Errorf("invalid send enabled denom %q", d.Denom)
+		}
+		if denoms[d.Denom] {
+			return fmt.Errorf("duplicate send enabled denom %s", d.Denom)
+		}
+		denoms[d.Denom] = true
+	}
+	return nil
+}
+
+func (ds SendEnabledDenoms) String() string {
+	out := make([]string, len(ds))
+	for i, d := range ds {
+		out[i] = d.String()
+	}
+	return strings.Join(out, ",")
 }
@@ -0,0 +1,52 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/shinecloudfoundation/shinecloudnet/types"
+)
+
+func TestSendEnabledDenomsValidate(t *testing.T) {
+	tests := []struct {
+		name   string
+		denoms SendEnabledDenoms
+		valid  bool
+	}{
+		{"empty", SendEnabledDenoms{}, true},
+		{"valid", SendEnabledDenoms{NewSendEnabledDenom("foocoin", false), NewSendEnabledDenom("barcoin", true)}, true},
+		{"invalid denom", SendEnabledDenoms{NewSendEnabledDenom("FOO", false)}, false},
+		{"duplicate denom", SendEnabledDenoms{NewSendEnabledDenom("foocoin", false), NewSendEnabledDenom("foocoin", true)}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.valid, tt.denoms.Validate() == nil)
+		})
+	}
+}
+
+func TestParamsIsSendEnabled(t *testing.T) {
+	params := NewParams(true, SendEnabledDenoms{NewSendEnabledDenom("foocoin", false)}, nil)
+	require.False(t, params.IsSendEnabled("foocoin"))
+	require.True(t, params.IsSendEnabled("barcoin"))
+
+	params = NewParams(false, SendEnabledDenoms{NewSendEnabledDenom("foocoin", true)}, nil)
+	require.True(t, params.IsSendEnabled("foocoin"))
+	require.False(t, params.IsSendEnabled("barcoin"))
+}
+
+func TestValidateBlockedAddrs(t *testing.T) {
+	addr1 := sdk.AccAddress([]byte("addr1"))
+	addr2 := sdk.AccAddress([]byte("addr2"))
+
+	require.NoError(t, ValidateBlockedAddrs(nil))
+	require.NoError(t, ValidateBlockedAddrs([]sdk.AccAddress{addr1, addr2}))
+	require.Error(t, ValidateBlockedAddrs([]sdk.AccAddress{addr1, {}}))
+	require.Error(t, ValidateBlockedAddrs([]sdk.AccAddress{addr1, addr2, addr1}))
+
+	params := NewParams(true, nil, []sdk.AccAddress{addr1})
+	require.True(t, params.IsBlockedAddr(addr1))
+	require.False(t, params.IsBlockedAddr(addr2))
+}
@@ -30,3 +30,10 @@ func BeginBlocker(ctx sdk.Context, req abci.RequestBeginBlock, k keeper.Keeper)
 	consAddr := sdk.ConsAddress(req.Header.ProposerAddress)
 	k.SetPreviousProposerConsAddr(ctx, consAddr)
 }
+
+// pay community pool streams and auto-compound the delegation rewards of
+// opted-in delegators
+func EndBlocker(ctx sdk.Context, k keeper.Keeper) {
+	k.PayCommunityPoolStreams(ctx)
+	k.AutoCompound(ctx)
+}
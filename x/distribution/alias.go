@@ -13,30 +13,39 @@ import (
 )
 
 const (
-	DefaultParamspace                = keeper.DefaultParamspace
-	DefaultCodespace                 = types.DefaultCodespace
-	CodeInvalidInput                 = types.CodeInvalidInput
-	CodeNoDistributionInfo           = types.CodeNoDistributionInfo
-	CodeNoValidatorCommission        = types.CodeNoValidatorCommission
-	CodeSetWithdrawAddrDisabled      = types.CodeSetWithdrawAddrDisabled
-	ModuleName                       = types.ModuleName
-	StoreKey                         = types.StoreKey
-	RouterKey                        = types.RouterKey
-	QuerierRoute                     = types.QuerierRoute
-	ProposalTypeCommunityPoolSpend   = types.ProposalTypeCommunityPoolSpend
-	QueryParams                      = types.QueryParams
-	QueryValidatorOutstandingRewards = types.QueryValidatorOutstandingRewards
-	QueryValidatorCommission         = types.QueryValidatorCommission
-	QueryValidatorSlashes            = types.QueryValidatorSlashes
-	QueryDelegationRewards           = types.QueryDelegationRewards
-	QueryDelegatorTotalRewards       = types.QueryDelegatorTotalRewards
-	QueryDelegatorValidators         = types.QueryDelegatorValidators
-	QueryWithdrawAddr                = types.QueryWithdrawAddr
-	QueryCommunityPool               = types.QueryCommunityPool
-	ParamCommunityTax                = types.ParamCommunityTax
-	ParamBaseProposerReward          = types.ParamBaseProposerReward
-	ParamBonusProposerReward         = types.ParamBonusProposerReward
-	ParamWithdrawAddrEnabled         = types.ParamWithdrawAddrEnabled
+	DefaultParamspace                     = keeper.DefaultParamspace
+	DefaultCodespace                      = types.DefaultCodespace
+	CodeInvalidInput                      = types.CodeInvalidInput
+	CodeNoDistributionInfo                = types.CodeNoDistributionInfo
+	CodeNoValidatorCommission             = types.CodeNoValidatorCommission
+	CodeSetWithdrawAddrDisabled           = types.CodeSetWithdrawAddrDisabled
+	ModuleName                            = types.ModuleName
+	StoreKey                              = types.StoreKey
+	RouterKey                             = types.RouterKey
+	QuerierRoute                          = types.QuerierRoute
+	ProposalTypeCommunityPoolSpend        = types.ProposalTypeCommunityPoolSpend
+	ProposalTypeCommunityPoolStream       = types.ProposalTypeCommunityPoolStream
+	ProposalTypeCancelCommunityPoolStream = types.ProposalTypeCancelCommunityPoolStream
+	QueryParams                           = types.QueryParams
+	QueryValidatorOutstandingRewards      = types.QueryValidatorOutstandingRewards
+	QueryValidatorCommission              = types.QueryValidatorCommission
+	QueryValidatorSlashes                 = types.QueryValidatorSlashes
+	QueryDelegationRewards                = types.QueryDelegationRewards
+	QueryDelegatorTotalRewards            = types.QueryDelegatorTotalRewards
+	QueryDelegatorValidators              = types.QueryDelegatorValidators
+	QueryWithdrawAddr                     = types.QueryWithdrawAddr
+	QueryCommunityPool                    = types.QueryCommunityPool
+	QueryAutoCompound                     = types.QueryAutoCompound
+	QueryCommunityPoolStreams             = types.QueryCommunityPoolStreams
+	QueryCommunityPoolStream              = types.QueryCommunityPoolStream
+	ParamCommunityTax                     = types.ParamCommunityTax
+	ParamBaseProposerReward               = types.ParamBaseProposerReward
+	ParamBonusProposerReward              = types.ParamBonusProposerReward
+	ParamWithdrawAddrEnabled              = types.ParamWithdrawAddrEnabled
+	ParamAutoCompoundInterval             = types.ParamAutoCompoundInterval
+	ParamAutoCompoundBatchSize            = types.ParamAutoCompoundBatchSize
+	DefaultAutoCompoundInterval           = types.DefaultAutoCompoundInterval
+	DefaultAutoCompoundBatchSize          = types.DefaultAutoCompoundBatchSize
 )
 
 var (
@@ -83,6 +92,8 @@ var (
 	ErrBadDistribution                         = types.ErrBadDistribution
 	ErrInvalidProposalAmount                   = types.ErrInvalidProposalAmount
 	ErrEmptyProposalRecipient                  = types.ErrEmptyProposalRecipient
+	ErrInvalidStreamDuration                   = types.ErrInvalidStreamDuration
+	ErrUnknownCommunityPoolStream              = types.ErrUnknownCommunityPoolStream
 	InitialFeePool                             = types.InitialFeePool
 	NewGenesisState                            = types.NewGenesisState
 	DefaultGenesisState                        = types.DefaultGenesisState
@@ -90,7 +101,12 @@ var (
 	NewMsgSetWithdrawAddress                   = types.NewMsgSetWithdrawAddress
 	NewMsgWithdrawDelegatorReward              = types.NewMsgWithdrawDelegatorReward
 	NewMsgWithdrawValidatorCommission          = types.NewMsgWithdrawValidatorCommission
+	NewMsgSetAutoCompound                      = types.NewMsgSetAutoCompound
 	NewCommunityPoolSpendProposal              = types.NewCommunityPoolSpendProposal
+	NewCommunityPoolStreamProposal             = types.NewCommunityPoolStreamProposal
+	NewCancelCommunityPoolStreamProposal       = types.NewCancelCommunityPoolStreamProposal
+	NewCommunityPoolStream                     = types.NewCommunityPoolStream
+	NewQueryCommunityPoolStreamParams          = types.NewQueryCommunityPoolStreamParams
 	NewQueryValidatorOutstandingRewardsParams  = types.NewQueryValidatorOutstandingRewardsParams
 	NewQueryValidatorCommissionParams          = types.NewQueryValidatorCommissionParams
 	NewQueryValidatorSlashesParams             = types.NewQueryValidatorSlashesParams
@@ -114,10 +130,16 @@ var (
 	ValidatorCurrentRewardsPrefix        = keeper.ValidatorCurrentRewardsPrefix
 	ValidatorAccumulatedCommissionPrefix = keeper.ValidatorAccumulatedCommissionPrefix
 	ValidatorSlashEventPrefix            = keeper.ValidatorSlashEventPrefix
+	AutoCompoundDelegatorPrefix          = keeper.AutoCompoundDelegatorPrefix
+	AutoCompoundCursorKey                = keeper.AutoCompoundCursorKey
+	CommunityPoolStreamPrefix            = keeper.CommunityPoolStreamPrefix
+	NextCommunityPoolStreamIDKey         = keeper.NextCommunityPoolStreamIDKey
 	ParamStoreKeyCommunityTax            = keeper.ParamStoreKeyCommunityTax
 	ParamStoreKeyBaseProposerReward      = keeper.ParamStoreKeyBaseProposerReward
 	ParamStoreKeyBonusProposerReward     = keeper.ParamStoreKeyBonusProposerReward
 	ParamStoreKeyWithdrawAddrEnabled     = keeper.ParamStoreKeyWithdrawAddrEnabled
+	ParamStoreKeyAutoCompoundInterval    = keeper.ParamStoreKeyAutoCompoundInterval
+	ParamStoreKeyAutoCompoundBatchSize   = keeper.ParamStoreKeyAutoCompoundBatchSize
 	TestAddrs                            = keeper.TestAddrs
 	ModuleCdc                            = types.ModuleCdc
 	EventTypeSetWithdrawAddress          = types.EventTypeSetWithdrawAddress
@@ -126,10 +148,21 @@ var (
 	EventTypeWithdrawRewards             = types.EventTypeWithdrawRewards
 	EventTypeWithdrawCommission          = types.EventTypeWithdrawCommission
 	EventTypeProposerReward              = types.EventTypeProposerReward
+	EventTypeSetAutoCompound             = types.EventTypeSetAutoCompound
+	EventTypeAutoCompound                = types.EventTypeAutoCompound
+	EventTypeCommunityPoolStream         = types.EventTypeCommunityPoolStream
+	EventTypeCommunityPoolStreamPay      = types.EventTypeCommunityPoolStreamPay
+	EventTypeCancelCommunityPoolStream   = types.EventTypeCancelCommunityPoolStream
 	AttributeKeyWithdrawAddress          = types.AttributeKeyWithdrawAddress
 	AttributeKeyValidator                = types.AttributeKeyValidator
+	AttributeKeyDelegator                = types.AttributeKeyDelegator
+	AttributeKeyEnabled                  = types.AttributeKeyEnabled
+	AttributeKeyStreamID                 = types.AttributeKeyStreamID
+	AttributeKeyRecipient                = types.AttributeKeyRecipient
 	AttributeValueCategory               = types.AttributeValueCategory
 	ProposalHandler                      = client.ProposalHandler
+	StreamProposalHandler                = client.StreamProposalHandler
+	CancelStreamProposalHandler          = client.CancelStreamProposalHandler
 )
 
 type (
@@ -149,7 +182,13 @@ type (
 	MsgSetWithdrawAddress                  = types.MsgSetWithdrawAddress
 	MsgWithdrawDelegatorReward             = types.MsgWithdrawDelegatorReward
 	MsgWithdrawValidatorCommission         = types.MsgWithdrawValidatorCommission
+	MsgSetAutoCompound                     = types.MsgSetAutoCompound
 	CommunityPoolSpendProposal             = types.CommunityPoolSpendProposal
+	CommunityPoolStreamProposal            = types.CommunityPoolStreamProposal
+	CancelCommunityPoolStreamProposal      = types.CancelCommunityPoolStreamProposal
+	CommunityPoolStream                    = types.CommunityPoolStream
+	CommunityPoolStreams                   = types.CommunityPoolStreams
+	QueryCommunityPoolStreamParams         = types.QueryCommunityPoolStreamParams
 	QueryValidatorOutstandingRewardsParams = types.QueryValidatorOutstandingRewardsParams
 	QueryValidatorCommissionParams         = types.QueryValidatorCommissionParams
 	QueryValidatorSlashesParams            = types.QueryValidatorSlashesParams
@@ -33,6 +33,9 @@ func GetQueryCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
 		GetCmdQueryValidatorSlashes(queryRoute, cdc),
 		GetCmdQueryDelegatorRewards(queryRoute, cdc),
 		GetCmdQueryCommunityPool(queryRoute, cdc),
+		GetCmdQueryAutoCompound(queryRoute, cdc),
+		GetCmdQueryCommunityPoolStreams(queryRoute, cdc),
+		GetCmdQueryCommunityPoolStream(queryRoute, cdc),
 	)...)
 
 	return distQueryCmd
@@ -262,3 +265,105 @@ $ %s query distr community-pool
 		},
 	}
 }
+
+// GetCmdQueryAutoCompound implements the query auto-compound command.
+func GetCmdQueryAutoCompound(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "auto-compound [delegator-addr]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query whether a delegator has opted in to auto-compounding",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query whether a delegator has opted in to auto-compounding of its delegation rewards.
+
+Example:
+$ %s query distr auto-compound cosmos1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			delegatorAddr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			res, err := common.QueryDelegatorAutoCompound(cliCtx, queryRoute, delegatorAddr)
+			if err != nil {
+				return err
+			}
+
+			var enabled bool
+			cdc.MustUnmarshalJSON(res, &enabled)
+			fmt.Println(enabled)
+			return nil
+		},
+	}
+}
+
+// GetCmdQueryCommunityPoolStreams implements the query community pool streams command.
+func GetCmdQueryCommunityPoolStreams(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "community-pool-streams",
+		Args:  cobra.NoArgs,
+		Short: "Query all active community pool streams",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query all active community pool streams.
+
+Example:
+$ %s query distr community-pool-streams
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryCommunityPoolStreams), nil)
+			if err != nil {
+				return err
+			}
+
+			var streams types.CommunityPoolStreams
+			cdc.MustUnmarshalJSON(res, &streams)
+			return cliCtx.PrintOutput(streams)
+		},
+	}
+}
+
+// GetCmdQueryCommunityPoolStream implements the query community pool stream command.
+func GetCmdQueryCommunityPoolStream(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "community-pool-stream [stream-id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query a community pool stream",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query a community pool stream by its ID.
+
+Example:
+$ %s query distr community-pool-stream 1
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			streamID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("stream-id %s not a valid uint, please input a valid stream-id", args[0])
+			}
+
+			bz := cdc.MustMarshalJSON(types.NewQueryCommunityPoolStreamParams(streamID))
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryCommunityPoolStream), bz)
+			if err != nil {
+				return err
+			}
+
+			var stream types.CommunityPoolStream
+			cdc.MustUnmarshalJSON(res, &stream)
+			return cliCtx.PrintOutput(stream)
+		},
+	}
+}
@@ -3,7 +3,9 @@ package cli
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
@@ -46,6 +48,7 @@ func GetTxCmd(storeKey string, cdc *codec.Codec) *cobra.Command {
 		GetCmdWithdrawRewards(cdc),
 		GetCmdSetWithdrawAddr(cdc),
 		GetCmdWithdrawAllRewards(cdc, storeKey),
+		GetCmdSetAutoCompound(cdc),
 	)...)
 
 	return distTxCmd
@@ -195,6 +198,41 @@ $ %s tx set-withdraw-addr cosmos1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p --from m
 	}
 }
 
+// command to opt in to or out of auto-compounding of rewards
+func GetCmdSetAutoCompound(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "set-auto-compound [true|false]",
+		Short: "opt in to or out of auto-compounding of delegation rewards",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Opt in to or out of auto-compounding of delegation rewards. At every
+auto-compounding interval the rewards of all delegations of the delegator are
+withdrawn and the staking denomination portion is delegated back to the same
+validator. Rewards are only compounded while the delegator withdraws to its
+own address.
+
+Example:
+$ %s tx distr set-auto-compound true --from mykey
+`,
+				version.ClientName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			enabled, err := strconv.ParseBool(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetAutoCompound(cliCtx.GetFromAddress(), enabled)
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
 // GetCmdSubmitProposal implements the command to submit a community-pool-spend proposal
 func GetCmdSubmitProposal(cdc *codec.Codec) *cobra.Command {
 	cmd := &cobra.Command{
@@ -243,7 +281,133 @@ Where proposal.json contains:
 			from := cliCtx.GetFromAddress()
 			content := types.NewCommunityPoolSpendProposal(proposal.Title, proposal.Description, proposal.Recipient, proposal.Amount)
 
-			msg := gov.NewMsgSubmitProposal(content, proposal.Deposit, from)
+			msg := gov.NewMsgSubmitProposal(content, proposal.Deposit, from, proposal.IsExpedited)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	return cmd
+}
+
+// GetCmdSubmitStreamProposal implements the command to submit a community-pool-stream proposal
+func GetCmdSubmitStreamProposal(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "community-pool-stream [proposal-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a community pool stream proposal",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a community pool stream proposal along with an initial deposit.
+Once passed, the amount is paid to the recipient over the given duration, either
+linearly or, when tranches is set, in that many equal tranches. The proposal
+details must be supplied via a JSON file.
+
+Example:
+$ %s tx gov submit-proposal community-pool-stream <path/to/proposal.json> --from=<key_or_address>
+
+Where proposal.json contains:
+
+{
+  "title": "Community Pool Stream",
+  "description": "Pay me some Atoms every month!",
+  "recipient": "cosmos1s5afhd6gxevu37mkqcvvsj8qeylhn0rz46zdlq",
+  "amount": [
+    {
+      "denom": "stake",
+      "amount": "10000"
+    }
+  ],
+  "duration": "2160h",
+  "tranches": "3",
+  "deposit": [
+    {
+      "denom": "stake",
+      "amount": "10000"
+    }
+  ]
+}
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			proposal, err := ParseCommunityPoolStreamProposalJSON(cdc, args[0])
+			if err != nil {
+				return err
+			}
+
+			duration, err := time.ParseDuration(proposal.Duration)
+			if err != nil {
+				return err
+			}
+
+			from := cliCtx.GetFromAddress()
+			content := types.NewCommunityPoolStreamProposal(
+				proposal.Title, proposal.Description, proposal.Recipient, proposal.Amount, duration, proposal.Tranches,
+			)
+
+			msg := gov.NewMsgSubmitProposal(content, proposal.Deposit, from, proposal.IsExpedited)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	return cmd
+}
+
+// GetCmdSubmitCancelStreamProposal implements the command to submit a cancel-community-pool-stream proposal
+func GetCmdSubmitCancelStreamProposal(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel-community-pool-stream [proposal-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a proposal to cancel a community pool stream",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a proposal to cancel a community pool stream along with an initial
+deposit. Once passed, the unpaid amount of the stream returns to the community
+pool. The proposal details must be supplied via a JSON file.
+
+Example:
+$ %s tx gov submit-proposal cancel-community-pool-stream <path/to/proposal.json> --from=<key_or_address>
+
+Where proposal.json contains:
+
+{
+  "title": "Cancel Community Pool Stream",
+  "description": "The recipient stopped working on the project",
+  "stream_id": "1",
+  "deposit": [
+    {
+      "denom": "stake",
+      "amount": "10000"
+    }
+  ]
+}
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			proposal, err := ParseCancelCommunityPoolStreamProposalJSON(cdc, args[0])
+			if err != nil {
+				return err
+			}
+
+			from := cliCtx.GetFromAddress()
+			content := types.NewCancelCommunityPoolStreamProposal(proposal.Title, proposal.Description, proposal.StreamID)
+
+			msg := gov.NewMsgSubmitProposal(content, proposal.Deposit, from, proposal.IsExpedited)
 			if err := msg.ValidateBasic(); err != nil {
 				return err
 			}
@@ -15,6 +15,29 @@ type (
 		Recipient   sdk.AccAddress `json:"recipient" yaml:"recipient"`
 		Amount      sdk.Coins      `json:"amount" yaml:"amount"`
 		Deposit     sdk.Coins      `json:"deposit" yaml:"deposit"`
+		IsExpedited bool           `json:"is_expedited" yaml:"is_expedited"`
+	}
+
+	// CommunityPoolStreamProposalJSON defines a CommunityPoolStreamProposal with a deposit,
+	// the duration is given as a Go duration string such as "720h"
+	CommunityPoolStreamProposalJSON struct {
+		Title       string         `json:"title" yaml:"title"`
+		Description string         `json:"description" yaml:"description"`
+		Recipient   sdk.AccAddress `json:"recipient" yaml:"recipient"`
+		Amount      sdk.Coins      `json:"amount" yaml:"amount"`
+		Duration    string         `json:"duration" yaml:"duration"`
+		Tranches    uint64         `json:"tranches" yaml:"tranches"`
+		Deposit     sdk.Coins      `json:"deposit" yaml:"deposit"`
+		IsExpedited bool           `json:"is_expedited" yaml:"is_expedited"`
+	}
+
+	// CancelCommunityPoolStreamProposalJSON defines a CancelCommunityPoolStreamProposal with a deposit
+	CancelCommunityPoolStreamProposalJSON struct {
+		Title       string    `json:"title" yaml:"title"`
+		Description string    `json:"description" yaml:"description"`
+		StreamID    uint64    `json:"stream_id" yaml:"stream_id"`
+		Deposit     sdk.Coins `json:"deposit" yaml:"deposit"`
+		IsExpedited bool      `json:"is_expedited" yaml:"is_expedited"`
 	}
 )
 
@@ -33,3 +56,35 @@ func ParseCommunityPoolSpendProposalJSON(cdc *codec.Codec, proposalFile string)
 
 	return proposal, nil
 }
+
+// ParseCommunityPoolStreamProposalJSON reads and parses a CommunityPoolStreamProposalJSON from a file.
+func ParseCommunityPoolStreamProposalJSON(cdc *codec.Codec, proposalFile string) (CommunityPoolStreamProposalJSON, error) {
+	proposal := CommunityPoolStreamProposalJSON{}
+
+	contents, err := ioutil.ReadFile(proposalFile)
+	if err != nil {
+		return proposal, err
+	}
+
+	if err := cdc.UnmarshalJSON(contents, &proposal); err != nil {
+		return proposal, err
+	}
+
+	return proposal, nil
+}
+
+// ParseCancelCommunityPoolStreamProposalJSON reads and parses a CancelCommunityPoolStreamProposalJSON from a file.
+func ParseCancelCommunityPoolStreamProposalJSON(cdc *codec.Codec, proposalFile string) (CancelCommunityPoolStreamProposalJSON, error) {
+	proposal := CancelCommunityPoolStreamProposalJSON{}
+
+	contents, err := ioutil.ReadFile(proposalFile)
+	if err != nil {
+		return proposal, err
+	}
+
+	if err := cdc.UnmarshalJSON(contents, &proposal); err != nil {
+		return proposal, err
+	}
+
+	return proposal, nil
+}
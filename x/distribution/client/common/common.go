@@ -35,11 +35,33 @@ func QueryParams(cliCtx context.CLIContext, queryRoute string) (PrettyParams, er
 		return PrettyParams{}, err
 	}
 
+	route = fmt.Sprintf("custom/%s/params/%s", queryRoute, types.ParamAutoCompoundInterval)
+	retAutoCompoundInterval, _, err := cliCtx.QueryWithData(route, []byte{})
+	if err != nil {
+		return PrettyParams{}, err
+	}
+
+	route = fmt.Sprintf("custom/%s/params/%s", queryRoute, types.ParamAutoCompoundBatchSize)
+	retAutoCompoundBatchSize, _, err := cliCtx.QueryWithData(route, []byte{})
+	if err != nil {
+		return PrettyParams{}, err
+	}
+
 	return NewPrettyParams(
 		retCommunityTax, retBaseProposerReward, retBonusProposerReward, retWithdrawAddrEnabled,
+		retAutoCompoundInterval, retAutoCompoundBatchSize,
 	), nil
 }
 
+// QueryDelegatorAutoCompound queries whether a delegator has opted in to auto-compounding.
+func QueryDelegatorAutoCompound(cliCtx context.CLIContext, queryRoute string, delegatorAddr sdk.AccAddress) ([]byte, error) {
+	res, _, err := cliCtx.QueryWithData(
+		fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryAutoCompound),
+		cliCtx.Codec.MustMarshalJSON(types.NewQueryDelegatorParams(delegatorAddr)),
+	)
+	return res, err
+}
+
 // QueryDelegatorTotalRewards queries delegator total rewards.
 func QueryDelegatorTotalRewards(cliCtx context.CLIContext, queryRoute, delAddr string) ([]byte, error) {
 	delegatorAddr, err := sdk.AccAddressFromBech32(delAddr)
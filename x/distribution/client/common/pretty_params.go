@@ -11,15 +11,21 @@ type PrettyParams struct {
 	BaseProposerReward  json.RawMessage `json:"base_proposer_reward"`
 	BonusProposerReward json.RawMessage `json:"bonus_proposer_reward"`
 	WithdrawAddrEnabled json.RawMessage `json:"withdraw_addr_enabled"`
+
+	AutoCompoundInterval  json.RawMessage `json:"auto_compound_interval"`
+	AutoCompoundBatchSize json.RawMessage `json:"auto_compound_batch_size"`
 }
 
 // Construct a new PrettyParams
-func NewPrettyParams(communityTax json.RawMessage, baseProposerReward json.RawMessage, bonusProposerReward json.RawMessage, withdrawAddrEnabled json.RawMessage) PrettyParams {
+func NewPrettyParams(communityTax json.RawMessage, baseProposerReward json.RawMessage, bonusProposerReward json.RawMessage, withdrawAddrEnabled json.RawMessage,
+	autoCompoundInterval json.RawMessage, autoCompoundBatchSize json.RawMessage) PrettyParams {
 	return PrettyParams{
-		CommunityTax:        communityTax,
-		BaseProposerReward:  baseProposerReward,
-		BonusProposerReward: bonusProposerReward,
-		WithdrawAddrEnabled: withdrawAddrEnabled,
+		CommunityTax:          communityTax,
+		BaseProposerReward:    baseProposerReward,
+		BonusProposerReward:   bonusProposerReward,
+		WithdrawAddrEnabled:   withdrawAddrEnabled,
+		AutoCompoundInterval:  autoCompoundInterval,
+		AutoCompoundBatchSize: autoCompoundBatchSize,
 	}
 }
 
@@ -28,7 +34,10 @@ func (pp PrettyParams) String() string {
   Community Tax:          %s
   Base Proposer Reward:   %s
   Bonus Proposer Reward:  %s
-  Withdraw Addr Enabled:  %s`, pp.CommunityTax,
-		pp.BaseProposerReward, pp.BonusProposerReward, pp.WithdrawAddrEnabled)
+  Withdraw Addr Enabled:  %s
+  Auto-Compound Interval: %s
+  Auto-Compound Batch:    %s`, pp.CommunityTax,
+		pp.BaseProposerReward, pp.BonusProposerReward, pp.WithdrawAddrEnabled,
+		pp.AutoCompoundInterval, pp.AutoCompoundBatchSize)
 
 }
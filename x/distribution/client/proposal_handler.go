@@ -6,7 +6,9 @@ import (
 	govclient "github.com/shinecloudfoundation/shinecloudnet/x/gov/client"
 )
 
-// param change proposal handler
+// community pool proposal handlers
 var (
-	ProposalHandler = govclient.NewProposalHandler(cli.GetCmdSubmitProposal, rest.ProposalRESTHandler)
+	ProposalHandler             = govclient.NewProposalHandler(cli.GetCmdSubmitProposal, rest.ProposalRESTHandler)
+	StreamProposalHandler       = govclient.NewProposalHandler(cli.GetCmdSubmitStreamProposal, rest.StreamProposalRESTHandler)
+	CancelStreamProposalHandler = govclient.NewProposalHandler(cli.GetCmdSubmitCancelStreamProposal, rest.CancelStreamProposalRESTHandler)
 )
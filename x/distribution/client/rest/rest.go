@@ -2,6 +2,7 @@ package rest
 
 import (
 	"net/http"
+	"time"
 
 	"github.com/gorilla/mux"
 
@@ -28,6 +29,22 @@ func ProposalRESTHandler(cliCtx context.CLIContext) govrest.ProposalRESTHandler
 	}
 }
 
+// StreamProposalRESTHandler returns a ProposalRESTHandler that exposes the community pool stream REST handler with a given sub-route.
+func StreamProposalRESTHandler(cliCtx context.CLIContext) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "community_pool_stream",
+		Handler:  postStreamProposalHandlerFn(cliCtx),
+	}
+}
+
+// CancelStreamProposalRESTHandler returns a ProposalRESTHandler that exposes the cancel community pool stream REST handler with a given sub-route.
+func CancelStreamProposalRESTHandler(cliCtx context.CLIContext) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "cancel_community_pool_stream",
+		Handler:  postCancelStreamProposalHandlerFn(cliCtx),
+	}
+}
+
 func postProposalHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		var req CommunityPoolSpendProposalReq
@@ -42,7 +59,7 @@ func postProposalHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 
 		content := types.NewCommunityPoolSpendProposal(req.Title, req.Description, req.Recipient, req.Amount)
 
-		msg := gov.NewMsgSubmitProposal(content, req.Deposit, req.Proposer)
+		msg := gov.NewMsgSubmitProposal(content, req.Deposit, req.Proposer, req.IsExpedited)
 		if err := msg.ValidateBasic(); err != nil {
 			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
 			return
@@ -71,3 +88,82 @@ func postProposalHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 		utils.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
 	}
 }
+
+func postStreamProposalHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req CommunityPoolStreamProposalReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		duration, err := time.ParseDuration(req.Duration)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		content := types.NewCommunityPoolStreamProposal(req.Title, req.Description, req.Recipient, req.Amount, duration, req.Tranches)
+
+		msg := gov.NewMsgSubmitProposal(content, req.Deposit, req.Proposer, req.IsExpedited)
+		if err := msg.ValidateBasic(); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		writeProposalTxResponse(w, cliCtx, req.BaseReq, msg)
+	}
+}
+
+func postCancelStreamProposalHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req CancelCommunityPoolStreamProposalReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		content := types.NewCancelCommunityPoolStreamProposal(req.Title, req.Description, req.StreamID)
+
+		msg := gov.NewMsgSubmitProposal(content, req.Deposit, req.Proposer, req.IsExpedited)
+		if err := msg.ValidateBasic(); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		writeProposalTxResponse(w, cliCtx, req.BaseReq, msg)
+	}
+}
+
+// writeProposalTxResponse derives the from account address and name from the
+// Keybase and writes the proposal submission tx
+func writeProposalTxResponse(w http.ResponseWriter, cliCtx context.CLIContext, baseReq rest.BaseReq, msg sdk.Msg) {
+	var fromAddress sdk.AccAddress
+	var fromName string
+	var err error
+	if baseReq.GenerateOnly {
+		fromAddress, err = sdk.AccAddressFromBech32(baseReq.From)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		fromName = ""
+	} else {
+		fromAddress, fromName, err = context.GetFromFieldsFromAddr(baseReq.From)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+	}
+
+	cliCtx = cliCtx.WithFromName(fromName).WithFromAddress(fromAddress).WithBroadcastMode(baseReq.BroadcastMode)
+	utils.WriteGenerateStdTxResponse(w, cliCtx, baseReq, []sdk.Msg{msg})
+}
@@ -16,5 +16,34 @@ type (
 		Amount      sdk.Coins      `json:"amount" yaml:"amount"`
 		Proposer    sdk.AccAddress `json:"proposer" yaml:"proposer"`
 		Deposit     sdk.Coins      `json:"deposit" yaml:"deposit"`
+		IsExpedited bool           `json:"is_expedited" yaml:"is_expedited"`
+	}
+
+	// CommunityPoolStreamProposalReq defines a community pool stream proposal request body,
+	// the duration is given as a Go duration string such as "720h".
+	CommunityPoolStreamProposalReq struct {
+		BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+
+		Title       string         `json:"title" yaml:"title"`
+		Description string         `json:"description" yaml:"description"`
+		Recipient   sdk.AccAddress `json:"recipient" yaml:"recipient"`
+		Amount      sdk.Coins      `json:"amount" yaml:"amount"`
+		Duration    string         `json:"duration" yaml:"duration"`
+		Tranches    uint64         `json:"tranches" yaml:"tranches"`
+		Proposer    sdk.AccAddress `json:"proposer" yaml:"proposer"`
+		Deposit     sdk.Coins      `json:"deposit" yaml:"deposit"`
+		IsExpedited bool           `json:"is_expedited" yaml:"is_expedited"`
+	}
+
+	// CancelCommunityPoolStreamProposalReq defines a cancel community pool stream proposal request body.
+	CancelCommunityPoolStreamProposalReq struct {
+		BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+
+		Title       string         `json:"title" yaml:"title"`
+		Description string         `json:"description" yaml:"description"`
+		StreamID    uint64         `json:"stream_id" yaml:"stream_id"`
+		Proposer    sdk.AccAddress `json:"proposer" yaml:"proposer"`
+		Deposit     sdk.Coins      `json:"deposit" yaml:"deposit"`
+		IsExpedited bool           `json:"is_expedited" yaml:"is_expedited"`
 	}
 )
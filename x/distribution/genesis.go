@@ -17,6 +17,16 @@ func InitGenesis(ctx sdk.Context, keeper Keeper, supplyKeeper types.SupplyKeeper
 	keeper.SetBonusProposerReward(ctx, data.BonusProposerReward)
 	keeper.SetWithdrawAddrEnabled(ctx, data.WithdrawAddrEnabled)
 
+	// genesis files exported before auto-compounding existed carry no batch
+	// size, in which case the default auto-compounding params are kept
+	if data.AutoCompoundBatchSize != 0 {
+		keeper.SetAutoCompoundInterval(ctx, data.AutoCompoundInterval)
+		keeper.SetAutoCompoundBatchSize(ctx, data.AutoCompoundBatchSize)
+	}
+	for _, del := range data.AutoCompoundDelegators {
+		keeper.SetAutoCompoundDelegator(ctx, del)
+	}
+
 	for _, dwi := range data.DelegatorWithdrawInfos {
 		keeper.SetDelegatorWithdrawAddr(ctx, dwi.DelegatorAddress, dwi.WithdrawAddress)
 	}
@@ -40,6 +50,17 @@ func InitGenesis(ctx sdk.Context, keeper Keeper, supplyKeeper types.SupplyKeeper
 	for _, evt := range data.ValidatorSlashEvents {
 		keeper.SetValidatorSlashEvent(ctx, evt.ValidatorAddress, evt.Height, evt.Period, evt.Event)
 	}
+	nextStreamID := data.NextCommunityPoolStreamID
+	for _, stream := range data.CommunityPoolStreams {
+		keeper.SetCommunityPoolStream(ctx, stream)
+		moduleHoldings = moduleHoldings.Add(sdk.NewDecCoins(stream.Remaining()))
+		if stream.ID >= nextStreamID {
+			nextStreamID = stream.ID + 1
+		}
+	}
+	if nextStreamID != 0 {
+		keeper.SetNextCommunityPoolStreamID(ctx, nextStreamID)
+	}
 
 	moduleHoldings = moduleHoldings.Add(data.FeePool.CommunityPool)
 	moduleHoldingsInt, _ := moduleHoldings.TruncateDecimal()
@@ -138,6 +159,13 @@ func ExportGenesis(ctx sdk.Context, keeper Keeper) types.GenesisState {
 			return false
 		},
 	)
+	autoCompoundDels := make([]sdk.AccAddress, 0)
+	keeper.IterateAutoCompoundDelegators(ctx, func(del sdk.AccAddress) (stop bool) {
+		autoCompoundDels = append(autoCompoundDels, del)
+		return false
+	})
 	return types.NewGenesisState(feePool, communityTax, baseProposerRewards, bonusProposerRewards, withdrawAddrEnabled,
-		dwi, pp, outstanding, acc, his, cur, dels, slashes)
+		dwi, pp, outstanding, acc, his, cur, dels, slashes, keeper.GetAutoCompoundInterval(ctx),
+		keeper.GetAutoCompoundBatchSize(ctx), autoCompoundDels, keeper.GetCommunityPoolStreams(ctx),
+		keeper.GetNextCommunityPoolStreamID(ctx))
 }
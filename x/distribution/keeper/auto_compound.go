@@ -0,0 +1,146 @@
+package keeper
+
+import (
+	"fmt"
+	"strconv"
+
+	sdk "github.com/shinecloudfoundation/shinecloudnet/types"
+	"github.com/shinecloudfoundation/shinecloudnet/x/distribution/types"
+	stakingexported "github.com/shinecloudfoundation/shinecloudnet/x/staking/exported"
+)
+
+// SetAutoCompound opts a delegator in to or out of auto-compounding of its
+// delegation rewards
+func (k Keeper) SetAutoCompound(ctx sdk.Context, delAddr sdk.AccAddress, enabled bool) {
+	if enabled {
+		k.SetAutoCompoundDelegator(ctx, delAddr)
+	} else {
+		k.DeleteAutoCompoundDelegator(ctx, delAddr)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSetAutoCompound,
+			sdk.NewAttribute(types.AttributeKeyDelegator, delAddr.String()),
+			sdk.NewAttribute(types.AttributeKeyEnabled, strconv.FormatBool(enabled)),
+		),
+	)
+}
+
+// AutoCompound starts a new auto-compounding round every AutoCompoundInterval
+// blocks and compounds the next batch of opted-in delegators of the round in
+// progress. A round spans as many blocks as needed to go through every
+// delegator AutoCompoundBatchSize at a time.
+func (k Keeper) AutoCompound(ctx sdk.Context) {
+	interval := k.GetAutoCompoundInterval(ctx)
+	if interval <= 0 {
+		k.DeleteAutoCompoundCursor(ctx)
+		return
+	}
+
+	cursor := k.GetAutoCompoundCursor(ctx)
+	if cursor == nil {
+		if ctx.BlockHeight()%interval != 0 {
+			return
+		}
+		cursor = AutoCompoundDelegatorPrefix
+	}
+
+	batchSize := k.GetAutoCompoundBatchSize(ctx)
+	if batchSize == 0 {
+		k.SetAutoCompoundCursor(ctx, cursor)
+		return
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	iter := store.Iterator(cursor, sdk.PrefixEndBytes(AutoCompoundDelegatorPrefix))
+	delegators := make([]sdk.AccAddress, 0, batchSize)
+	for ; iter.Valid() && uint32(len(delegators)) < batchSize; iter.Next() {
+		delegators = append(delegators, GetAutoCompoundDelegatorAddress(iter.Key()))
+	}
+
+	var next []byte
+	if iter.Valid() {
+		next = append([]byte{}, iter.Key()...)
+	}
+	iter.Close()
+
+	if next != nil {
+		k.SetAutoCompoundCursor(ctx, next)
+	} else {
+		k.DeleteAutoCompoundCursor(ctx)
+	}
+
+	for _, delAddr := range delegators {
+		k.compoundDelegatorRewards(ctx, delAddr)
+	}
+}
+
+// compoundDelegatorRewards withdraws the rewards of every delegation of a
+// delegator and delegates the bond denom portion back to the same validator.
+// Delegators that withdraw to another address are skipped as the rewards
+// would not be theirs to delegate.
+func (k Keeper) compoundDelegatorRewards(ctx sdk.Context, delAddr sdk.AccAddress) {
+	if !k.GetDelegatorWithdrawAddr(ctx, delAddr).Equals(delAddr) {
+		return
+	}
+
+	var valAddrs []sdk.ValAddress
+	k.stakingKeeper.IterateDelegations(ctx, delAddr,
+		func(_ int64, del stakingexported.DelegationI) (stop bool) {
+			valAddrs = append(valAddrs, del.GetValidatorAddr())
+			return false
+		},
+	)
+
+	bondDenom := k.stakingKeeper.BondDenom(ctx)
+	for _, valAddr := range valAddrs {
+		// a failed delegation must not leave the rewards withdrawn
+		cacheCtx, writeCache := ctx.CacheContext()
+		cacheCtx = cacheCtx.WithEventManager(sdk.NewEventManager())
+
+		err := k.compoundDelegationRewards(cacheCtx, delAddr, valAddr, bondDenom)
+		if err != nil {
+			k.Logger(ctx).Info(fmt.Sprintf("failed to auto-compound rewards of %s from %s: %s",
+				delAddr, valAddr, err.Error()))
+			continue
+		}
+
+		writeCache()
+		ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+	}
+}
+
+func (k Keeper) compoundDelegationRewards(ctx sdk.Context, delAddr sdk.AccAddress,
+	valAddr sdk.ValAddress, bondDenom string) sdk.Error {
+
+	rewards, err := k.WithdrawDelegationRewards(ctx, delAddr, valAddr)
+	if err != nil {
+		return err
+	}
+
+	amount := rewards.AmountOf(bondDenom)
+	if !amount.IsPositive() {
+		return nil
+	}
+
+	validator, found := k.stakingKeeper.GetValidator(ctx, valAddr)
+	if !found {
+		return types.ErrNoValidatorDistInfo(k.codespace)
+	}
+
+	_, err = k.stakingKeeper.Delegate(ctx, delAddr, amount, sdk.Unbonded, validator, true)
+	if err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeAutoCompound,
+			sdk.NewAttribute(types.AttributeKeyDelegator, delAddr.String()),
+			sdk.NewAttribute(types.AttributeKeyValidator, valAddr.String()),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, sdk.NewCoin(bondDenom, amount).String()),
+		),
+	)
+	return nil
+}
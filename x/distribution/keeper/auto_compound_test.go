@@ -0,0 +1,100 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/shinecloudfoundation/shinecloudnet/types"
+	"github.com/shinecloudfoundation/shinecloudnet/x/staking"
+)
+
+func setupAutoCompound(t *testing.T) (sdk.Context, Keeper, staking.Keeper) {
+	balanceTokens := sdk.TokensFromConsensusPower(1000)
+	ctx, _, k, sk, _ := CreateTestInputDefault(t, false, 1000)
+	sh := staking.NewHandler(sk)
+
+	// set module account coins
+	distrAcc := k.GetDistributionAccount(ctx)
+	distrAcc.SetCoins(sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, balanceTokens)))
+	k.supplyKeeper.SetModuleAccount(ctx, distrAcc)
+
+	// create validator with 50% commission and a second delegator of the same power
+	valTokens := sdk.TokensFromConsensusPower(100)
+	commission := staking.NewCommissionRates(sdk.NewDecWithPrec(5, 1), sdk.NewDecWithPrec(5, 1), sdk.NewDec(0))
+	msg := staking.NewMsgCreateValidator(
+		valOpAddr1, valConsPk1,
+		sdk.NewCoin(sdk.DefaultBondDenom, valTokens),
+		staking.Description{}, commission, sdk.OneInt(),
+	)
+	require.True(t, sh(ctx, msg).IsOK())
+	require.True(t, sh(ctx, staking.NewMsgDelegate(delAddr1, valOpAddr1, sdk.NewCoin(sdk.DefaultBondDenom, valTokens))).IsOK())
+
+	// end block to bond validator
+	staking.EndBlocker(ctx, sk)
+
+	// allocate some rewards
+	val := sk.Validator(ctx, valOpAddr1)
+	tokens := sdk.DecCoins{sdk.NewDecCoin(sdk.DefaultBondDenom, sdk.TokensFromConsensusPower(10))}
+	k.AllocateTokensToValidator(ctx, val, tokens)
+
+	k.SetAutoCompoundInterval(ctx, 10)
+	k.SetAutoCompoundBatchSize(ctx, 1)
+	return ctx.WithBlockHeight(9), k, sk
+}
+
+func TestAutoCompound(t *testing.T) {
+	ctx, k, sk := setupAutoCompound(t)
+	selfDel := sdk.AccAddress(valOpAddr1)
+
+	k.SetAutoCompound(ctx, selfDel, true)
+	k.SetAutoCompound(ctx, delAddr1, true)
+	require.True(t, k.HasAutoCompoundDelegator(ctx, delAddr1))
+
+	selfShares := sk.Delegation(ctx, selfDel, valOpAddr1).GetShares()
+	delShares := sk.Delegation(ctx, delAddr1, valOpAddr1).GetShares()
+
+	// nothing happens outside of the interval
+	k.AutoCompound(ctx)
+	require.Nil(t, k.GetAutoCompoundCursor(ctx))
+	require.Equal(t, selfShares, sk.Delegation(ctx, selfDel, valOpAddr1).GetShares())
+
+	// the round starts at the interval and handles one delegator per block
+	ctx = ctx.WithBlockHeight(10)
+	k.AutoCompound(ctx)
+	require.NotNil(t, k.GetAutoCompoundCursor(ctx))
+
+	ctx = ctx.WithBlockHeight(11)
+	k.AutoCompound(ctx)
+	require.Nil(t, k.GetAutoCompoundCursor(ctx))
+
+	// each delegator earned a quarter of the rewards, which were delegated back
+	reward := sdk.TokensFromConsensusPower(10).QuoRaw(4)
+	require.True(t, sk.Delegation(ctx, selfDel, valOpAddr1).GetShares().Equal(selfShares.Add(reward.ToDec())))
+	require.True(t, sk.Delegation(ctx, delAddr1, valOpAddr1).GetShares().Equal(delShares.Add(reward.ToDec())))
+	require.True(t, k.GetValidatorOutstandingRewards(ctx, valOpAddr1).AmountOf(sdk.DefaultBondDenom).
+		Equal(sdk.TokensFromConsensusPower(10).QuoRaw(2).ToDec()))
+
+	// opting out removes the delegator from the next rounds
+	k.SetAutoCompound(ctx, delAddr1, false)
+	require.False(t, k.HasAutoCompoundDelegator(ctx, delAddr1))
+}
+
+func TestAutoCompoundSkipsExternalWithdrawAddr(t *testing.T) {
+	ctx, k, sk := setupAutoCompound(t)
+	k.SetAutoCompound(ctx, delAddr1, true)
+	k.SetDelegatorWithdrawAddr(ctx, delAddr1, delAddr2)
+
+	delShares := sk.Delegation(ctx, delAddr1, valOpAddr1).GetShares()
+
+	ctx = ctx.WithBlockHeight(10)
+	k.AutoCompound(ctx)
+	require.Nil(t, k.GetAutoCompoundCursor(ctx))
+	require.Equal(t, delShares, sk.Delegation(ctx, delAddr1, valOpAddr1).GetShares())
+
+	// rewards are left untouched
+	val := sk.Validator(ctx, valOpAddr1)
+	endingPeriod := k.incrementValidatorPeriod(ctx, val)
+	rewards := k.calculateDelegationRewards(ctx, val, sk.Delegation(ctx, delAddr1, valOpAddr1), endingPeriod)
+	require.False(t, rewards.IsZero())
+}
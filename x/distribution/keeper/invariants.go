@@ -18,6 +18,8 @@ func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
 		ReferenceCountInvariant(k))
 	ir.RegisterRoute(types.ModuleName, "module-account",
 		ModuleAccountInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "community-pool-streams",
+		CommunityPoolStreamsInvariant(k))
 }
 
 // AllInvariants runs all invariants of the distribution module
@@ -35,6 +37,10 @@ func AllInvariants(k Keeper) sdk.Invariant {
 		if stop {
 			return res, stop
 		}
+		res, stop = CommunityPoolStreamsInvariant(k)(ctx)
+		if stop {
+			return res, stop
+		}
 		return ModuleAccountInvariant(k)(ctx)
 	}
 }
@@ -133,8 +139,30 @@ func ReferenceCountInvariant(k Keeper) sdk.Invariant {
 	}
 }
 
+// CommunityPoolStreamsInvariant checks that no community pool stream has paid
+// more than the amount committed to it
+func CommunityPoolStreamsInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var msg string
+		var count int
+
+		k.IterateCommunityPoolStreams(ctx, func(stream types.CommunityPoolStream) (stop bool) {
+			if !stream.Amount.IsAllGTE(stream.Paid) {
+				count++
+				msg += fmt.Sprintf("\tstream %d paid %s out of %s\n", stream.ID, stream.Paid, stream.Amount)
+			}
+			return false
+		})
+		broken := count != 0
+
+		return sdk.FormatInvariant(types.ModuleName, "community pool streams",
+			fmt.Sprintf("found %d overpaid community pool streams\n%s", count, msg)), broken
+	}
+}
+
 // ModuleAccountInvariant checks that the coins held by the distr ModuleAccount
-// is consistent with the sum of validator outstanding rewards
+// is consistent with the sum of validator outstanding rewards, the community
+// pool and the amounts committed to community pool streams
 func ModuleAccountInvariant(k Keeper) sdk.Invariant {
 	return func(ctx sdk.Context) (string, bool) {
 
@@ -146,6 +174,7 @@ func ModuleAccountInvariant(k Keeper) sdk.Invariant {
 
 		communityPool := k.GetFeePoolCommunityCoins(ctx)
 		expectedInt, _ := expectedCoins.Add(communityPool).TruncateDecimal()
+		expectedInt = expectedInt.Add(k.GetCommunityPoolStreamsRemaining(ctx))
 
 		macc := k.GetDistributionAccount(ctx)
 
@@ -32,6 +32,14 @@ const (
 // - 0x07<valAddr_Bytes>: ValidatorCurrentRewards
 //
 // - 0x08<valAddr_Bytes><height>: ValidatorSlashEvent
+//
+// - 0x09<accAddr_Bytes>: AutoCompound opt-in
+//
+// - 0x0A: AutoCompound batch cursor
+//
+// - 0x0B<streamID_Bytes>: CommunityPoolStream
+//
+// - 0x0C: next CommunityPoolStream ID
 var (
 	FeePoolKey                        = []byte{0x00} // key for global distribution state
 	ProposerKey                       = []byte{0x01} // key for the proposer operator address
@@ -43,11 +51,18 @@ var (
 	ValidatorCurrentRewardsPrefix        = []byte{0x06} // key for current validator rewards
 	ValidatorAccumulatedCommissionPrefix = []byte{0x07} // key for accumulated validator commission
 	ValidatorSlashEventPrefix            = []byte{0x08} // key for validator slash fraction
+	AutoCompoundDelegatorPrefix          = []byte{0x09} // key for delegators opted in to auto-compounding
+	AutoCompoundCursorKey                = []byte{0x0A} // key for the next delegator to auto-compound in the current round
+	CommunityPoolStreamPrefix            = []byte{0x0B} // key for community pool streams
+	NextCommunityPoolStreamIDKey         = []byte{0x0C} // key for the next community pool stream ID
 
 	ParamStoreKeyCommunityTax        = []byte("communitytax")
 	ParamStoreKeyBaseProposerReward  = []byte("baseproposerreward")
 	ParamStoreKeyBonusProposerReward = []byte("bonusproposerreward")
 	ParamStoreKeyWithdrawAddrEnabled = []byte("withdrawaddrenabled")
+
+	ParamStoreKeyAutoCompoundInterval  = []byte("autocompoundinterval")
+	ParamStoreKeyAutoCompoundBatchSize = []byte("autocompoundbatchsize")
 )
 
 // gets an address from a validator's outstanding rewards key
@@ -68,6 +83,15 @@ func GetDelegatorWithdrawInfoAddress(key []byte) (delAddr sdk.AccAddress) {
 	return sdk.AccAddress(addr)
 }
 
+// gets an address from a delegator's auto-compound key
+func GetAutoCompoundDelegatorAddress(key []byte) (delAddr sdk.AccAddress) {
+	addr := key[1:]
+	if len(addr) != sdk.AddrLen {
+		panic("unexpected key length")
+	}
+	return sdk.AccAddress(addr)
+}
+
 // gets the addresses from a delegator starting info key
 func GetDelegatorStartingInfoAddresses(key []byte) (valAddr sdk.ValAddress, delAddr sdk.AccAddress) {
 	addr := key[1 : 1+sdk.AddrLen]
@@ -139,6 +163,18 @@ func GetDelegatorWithdrawAddrKey(delAddr sdk.AccAddress) []byte {
 	return append(DelegatorWithdrawAddrPrefix, delAddr.Bytes()...)
 }
 
+// gets the key for a delegator's auto-compound opt-in
+func GetAutoCompoundDelegatorKey(delAddr sdk.AccAddress) []byte {
+	return append(AutoCompoundDelegatorPrefix, delAddr.Bytes()...)
+}
+
+// gets the key for a community pool stream
+func GetCommunityPoolStreamKey(streamID uint64) []byte {
+	b := make([]byte, 8)
+	binary.BigEndian.PutUint64(b, streamID)
+	return append(CommunityPoolStreamPrefix, b...)
+}
+
 // gets the key for a delegator's starting info
 func GetDelegatorStartingInfoKey(v sdk.ValAddress, d sdk.AccAddress) []byte {
 	return append(append(DelegatorStartingInfoPrefix, v.Bytes()...), d.Bytes()...)
@@ -2,6 +2,7 @@ package keeper
 
 import (
 	sdk "github.com/shinecloudfoundation/shinecloudnet/types"
+	"github.com/shinecloudfoundation/shinecloudnet/x/distribution/types"
 	"github.com/shinecloudfoundation/shinecloudnet/x/params"
 )
 
@@ -12,6 +13,8 @@ func ParamKeyTable() params.KeyTable {
 		ParamStoreKeyBaseProposerReward, sdk.Dec{},
 		ParamStoreKeyBonusProposerReward, sdk.Dec{},
 		ParamStoreKeyWithdrawAddrEnabled, false,
+		ParamStoreKeyAutoCompoundInterval, int64(0),
+		ParamStoreKeyAutoCompoundBatchSize, uint32(0),
 	)
 }
 
@@ -66,3 +69,30 @@ func (k Keeper) GetWithdrawAddrEnabled(ctx sdk.Context) bool {
 func (k Keeper) SetWithdrawAddrEnabled(ctx sdk.Context, enabled bool) {
 	k.paramSpace.Set(ctx, ParamStoreKeyWithdrawAddrEnabled, &enabled)
 }
+
+// returns the number of blocks between auto-compounding rounds, a value
+// of zero disables auto-compounding
+// nolint: errcheck
+func (k Keeper) GetAutoCompoundInterval(ctx sdk.Context) int64 {
+	interval := types.DefaultAutoCompoundInterval
+	k.paramSpace.GetIfExists(ctx, ParamStoreKeyAutoCompoundInterval, &interval)
+	return interval
+}
+
+// nolint: errcheck
+func (k Keeper) SetAutoCompoundInterval(ctx sdk.Context, interval int64) {
+	k.paramSpace.Set(ctx, ParamStoreKeyAutoCompoundInterval, &interval)
+}
+
+// returns the maximum number of delegators auto-compounded in a single block
+// nolint: errcheck
+func (k Keeper) GetAutoCompoundBatchSize(ctx sdk.Context) uint32 {
+	batchSize := types.DefaultAutoCompoundBatchSize
+	k.paramSpace.GetIfExists(ctx, ParamStoreKeyAutoCompoundBatchSize, &batchSize)
+	return batchSize
+}
+
+// nolint: errcheck
+func (k Keeper) SetAutoCompoundBatchSize(ctx sdk.Context, batchSize uint32) {
+	k.paramSpace.Set(ctx, ParamStoreKeyAutoCompoundBatchSize, &batchSize)
+}
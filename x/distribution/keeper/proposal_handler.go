@@ -22,3 +22,31 @@ func HandleCommunityPoolSpendProposal(ctx sdk.Context, k Keeper, p types.Communi
 	logger.Info(fmt.Sprintf("transferred %s from the community pool to recipient %s", p.Amount, p.Recipient))
 	return nil
 }
+
+// HandleCommunityPoolStreamProposal is a handler for executing a passed community pool stream proposal
+func HandleCommunityPoolStreamProposal(ctx sdk.Context, k Keeper, p types.CommunityPoolStreamProposal) sdk.Error {
+	if k.blacklistedAddrs[p.Recipient.String()] {
+		return sdk.ErrUnauthorized(fmt.Sprintf("%s is blacklisted from receiving external funds", p.Recipient))
+	}
+
+	streamID, err := k.CreateCommunityPoolStream(ctx, p.Recipient, p.Amount, p.Duration, p.Tranches)
+	if err != nil {
+		return err
+	}
+
+	logger := k.Logger(ctx)
+	logger.Info(fmt.Sprintf("committed %s from the community pool to stream %d for recipient %s", p.Amount, streamID, p.Recipient))
+	return nil
+}
+
+// HandleCancelCommunityPoolStreamProposal is a handler for executing a passed cancel community pool stream proposal
+func HandleCancelCommunityPoolStreamProposal(ctx sdk.Context, k Keeper, p types.CancelCommunityPoolStreamProposal) sdk.Error {
+	err := k.CancelCommunityPoolStream(ctx, p.StreamID)
+	if err != nil {
+		return err
+	}
+
+	logger := k.Logger(ctx)
+	logger.Info(fmt.Sprintf("cancelled community pool stream %d", p.StreamID))
+	return nil
+}
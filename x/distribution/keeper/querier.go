@@ -42,6 +42,15 @@ func NewQuerier(k Keeper) sdk.Querier {
 		case types.QueryCommunityPool:
 			return queryCommunityPool(ctx, path[1:], req, k)
 
+		case types.QueryAutoCompound:
+			return queryDelegatorAutoCompound(ctx, path[1:], req, k)
+
+		case types.QueryCommunityPoolStreams:
+			return queryCommunityPoolStreams(ctx, path[1:], req, k)
+
+		case types.QueryCommunityPoolStream:
+			return queryCommunityPoolStream(ctx, path[1:], req, k)
+
 		default:
 			return nil, sdk.ErrUnknownRequest("unknown distr query endpoint")
 		}
@@ -74,6 +83,18 @@ func queryParams(ctx sdk.Context, path []string, req abci.RequestQuery, k Keeper
 			return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
 		}
 		return bz, nil
+	case types.ParamAutoCompoundInterval:
+		bz, err := codec.MarshalJSONIndent(k.cdc, k.GetAutoCompoundInterval(ctx))
+		if err != nil {
+			return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+		}
+		return bz, nil
+	case types.ParamAutoCompoundBatchSize:
+		bz, err := codec.MarshalJSONIndent(k.cdc, k.GetAutoCompoundBatchSize(ctx))
+		if err != nil {
+			return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+		}
+		return bz, nil
 	default:
 		return nil, sdk.ErrUnknownRequest(fmt.Sprintf("%s is not a valid query request path", req.Path))
 	}
@@ -241,6 +262,21 @@ func queryDelegatorWithdrawAddress(ctx sdk.Context, _ []string, req abci.Request
 	return bz, nil
 }
 
+func queryDelegatorAutoCompound(ctx sdk.Context, _ []string, req abci.RequestQuery, k Keeper) ([]byte, sdk.Error) {
+	var params types.QueryDelegatorParams
+	err := k.cdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdk.ErrUnknownRequest(sdk.AppendMsgToErr("incorrectly formatted request data", err.Error()))
+	}
+
+	bz, err := codec.MarshalJSONIndent(k.cdc, k.HasAutoCompoundDelegator(ctx, params.DelegatorAddress))
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+
+	return bz, nil
+}
+
 func queryCommunityPool(ctx sdk.Context, _ []string, req abci.RequestQuery, k Keeper) ([]byte, sdk.Error) {
 	bz, err := k.cdc.MarshalJSON(k.GetFeePoolCommunityCoins(ctx))
 	if err != nil {
@@ -248,3 +284,30 @@ func queryCommunityPool(ctx sdk.Context, _ []string, req abci.RequestQuery, k Ke
 	}
 	return bz, nil
 }
+
+func queryCommunityPoolStreams(ctx sdk.Context, _ []string, req abci.RequestQuery, k Keeper) ([]byte, sdk.Error) {
+	bz, err := codec.MarshalJSONIndent(k.cdc, k.GetCommunityPoolStreams(ctx))
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
+
+func queryCommunityPoolStream(ctx sdk.Context, _ []string, req abci.RequestQuery, k Keeper) ([]byte, sdk.Error) {
+	var params types.QueryCommunityPoolStreamParams
+	err := k.cdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdk.ErrUnknownRequest(sdk.AppendMsgToErr("incorrectly formatted request data", err.Error()))
+	}
+
+	stream, found := k.GetCommunityPoolStream(ctx, params.StreamID)
+	if !found {
+		return nil, types.ErrUnknownCommunityPoolStream(k.codespace, params.StreamID)
+	}
+
+	bz, err := codec.MarshalJSONIndent(k.cdc, stream)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
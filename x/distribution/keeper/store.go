@@ -377,3 +377,52 @@ func (k Keeper) DeleteAllValidatorSlashEvents(ctx sdk.Context) {
 		store.Delete(iter.Key())
 	}
 }
+
+// check whether a delegator has opted in to auto-compounding
+func (k Keeper) HasAutoCompoundDelegator(ctx sdk.Context, delAddr sdk.AccAddress) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(GetAutoCompoundDelegatorKey(delAddr))
+}
+
+// opt a delegator in to auto-compounding
+func (k Keeper) SetAutoCompoundDelegator(ctx sdk.Context, delAddr sdk.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(GetAutoCompoundDelegatorKey(delAddr), []byte{0x01})
+}
+
+// opt a delegator out of auto-compounding
+func (k Keeper) DeleteAutoCompoundDelegator(ctx sdk.Context, delAddr sdk.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(GetAutoCompoundDelegatorKey(delAddr))
+}
+
+// iterate over delegators opted in to auto-compounding
+func (k Keeper) IterateAutoCompoundDelegators(ctx sdk.Context, handler func(del sdk.AccAddress) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, AutoCompoundDelegatorPrefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		if handler(GetAutoCompoundDelegatorAddress(iter.Key())) {
+			break
+		}
+	}
+}
+
+// get the store key the current auto-compounding round resumes from, nil
+// when no round is in progress
+func (k Keeper) GetAutoCompoundCursor(ctx sdk.Context) []byte {
+	store := ctx.KVStore(k.storeKey)
+	return store.Get(AutoCompoundCursorKey)
+}
+
+// set the store key the current auto-compounding round resumes from
+func (k Keeper) SetAutoCompoundCursor(ctx sdk.Context, cursor []byte) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(AutoCompoundCursorKey, cursor)
+}
+
+// end the current auto-compounding round
+func (k Keeper) DeleteAutoCompoundCursor(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(AutoCompoundCursorKey)
+}
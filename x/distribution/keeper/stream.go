@@ -0,0 +1,180 @@
+package keeper
+
+import (
+	"encoding/binary"
+	"fmt"
+	"time"
+
+	sdk "github.com/shinecloudfoundation/shinecloudnet/types"
+	"github.com/shinecloudfoundation/shinecloudnet/x/distribution/types"
+)
+
+// get a community pool stream
+func (k Keeper) GetCommunityPoolStream(ctx sdk.Context, streamID uint64) (stream types.CommunityPoolStream, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	b := store.Get(GetCommunityPoolStreamKey(streamID))
+	if b == nil {
+		return stream, false
+	}
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(b, &stream)
+	return stream, true
+}
+
+// set a community pool stream
+func (k Keeper) SetCommunityPoolStream(ctx sdk.Context, stream types.CommunityPoolStream) {
+	store := ctx.KVStore(k.storeKey)
+	b := k.cdc.MustMarshalBinaryLengthPrefixed(stream)
+	store.Set(GetCommunityPoolStreamKey(stream.ID), b)
+}
+
+// delete a community pool stream
+func (k Keeper) DeleteCommunityPoolStream(ctx sdk.Context, streamID uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(GetCommunityPoolStreamKey(streamID))
+}
+
+// iterate over community pool streams in ID order
+func (k Keeper) IterateCommunityPoolStreams(ctx sdk.Context, handler func(stream types.CommunityPoolStream) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, CommunityPoolStreamPrefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var stream types.CommunityPoolStream
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iter.Value(), &stream)
+		if handler(stream) {
+			break
+		}
+	}
+}
+
+// get all community pool streams
+func (k Keeper) GetCommunityPoolStreams(ctx sdk.Context) types.CommunityPoolStreams {
+	streams := types.CommunityPoolStreams{}
+	k.IterateCommunityPoolStreams(ctx, func(stream types.CommunityPoolStream) (stop bool) {
+		streams = append(streams, stream)
+		return false
+	})
+	return streams
+}
+
+// get the ID of the next community pool stream
+func (k Keeper) GetNextCommunityPoolStreamID(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	b := store.Get(NextCommunityPoolStreamIDKey)
+	if b == nil {
+		return 1
+	}
+	return binary.BigEndian.Uint64(b)
+}
+
+// set the ID of the next community pool stream
+func (k Keeper) SetNextCommunityPoolStreamID(ctx sdk.Context, streamID uint64) {
+	store := ctx.KVStore(k.storeKey)
+	b := make([]byte, 8)
+	binary.BigEndian.PutUint64(b, streamID)
+	store.Set(NextCommunityPoolStreamIDKey, b)
+}
+
+// GetCommunityPoolStreamsRemaining returns the amount committed to community
+// pool streams that is yet to be paid
+func (k Keeper) GetCommunityPoolStreamsRemaining(ctx sdk.Context) sdk.Coins {
+	remaining := sdk.NewCoins()
+	k.IterateCommunityPoolStreams(ctx, func(stream types.CommunityPoolStream) (stop bool) {
+		remaining = remaining.Add(stream.Remaining())
+		return false
+	})
+	return remaining
+}
+
+// CreateCommunityPoolStream commits an amount of the community pool to a new
+// stream starting at the current block time
+func (k Keeper) CreateCommunityPoolStream(ctx sdk.Context, recipient sdk.AccAddress, amount sdk.Coins,
+	duration time.Duration, tranches uint64) (uint64, sdk.Error) {
+
+	if duration <= 0 {
+		return 0, types.ErrInvalidStreamDuration(k.codespace)
+	}
+
+	// the committed coins stay in the distribution module account until paid
+	feePool := k.GetFeePool(ctx)
+	newPool, negative := feePool.CommunityPool.SafeSub(sdk.NewDecCoins(amount))
+	if negative {
+		return 0, types.ErrBadDistribution(k.codespace)
+	}
+	feePool.CommunityPool = newPool
+	k.SetFeePool(ctx, feePool)
+
+	streamID := k.GetNextCommunityPoolStreamID(ctx)
+	stream := types.NewCommunityPoolStream(streamID, recipient, amount, ctx.BlockHeader().Time, duration, tranches)
+	k.SetCommunityPoolStream(ctx, stream)
+	k.SetNextCommunityPoolStreamID(ctx, streamID+1)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCommunityPoolStream,
+			sdk.NewAttribute(types.AttributeKeyStreamID, fmt.Sprintf("%d", streamID)),
+			sdk.NewAttribute(types.AttributeKeyRecipient, recipient.String()),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, amount.String()),
+		),
+	)
+
+	return streamID, nil
+}
+
+// CancelCommunityPoolStream removes a stream and returns its unpaid amount to
+// the community pool
+func (k Keeper) CancelCommunityPoolStream(ctx sdk.Context, streamID uint64) sdk.Error {
+	stream, found := k.GetCommunityPoolStream(ctx, streamID)
+	if !found {
+		return types.ErrUnknownCommunityPoolStream(k.codespace, streamID)
+	}
+
+	remaining := stream.Remaining()
+	feePool := k.GetFeePool(ctx)
+	feePool.CommunityPool = feePool.CommunityPool.Add(sdk.NewDecCoins(remaining))
+	k.SetFeePool(ctx, feePool)
+	k.DeleteCommunityPoolStream(ctx, streamID)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCancelCommunityPoolStream,
+			sdk.NewAttribute(types.AttributeKeyStreamID, fmt.Sprintf("%d", streamID)),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, remaining.String()),
+		),
+	)
+	return nil
+}
+
+// PayCommunityPoolStreams pays every stream the amount vested since its last
+// payment and removes the streams that are fully paid
+func (k Keeper) PayCommunityPoolStreams(ctx sdk.Context) {
+	blockTime := ctx.BlockHeader().Time
+	for _, stream := range k.GetCommunityPoolStreams(ctx) {
+		due := stream.VestedAmount(blockTime).Sub(stream.Paid)
+		if due.IsZero() {
+			continue
+		}
+
+		// the distribution module account holds the unpaid amount of every stream
+		err := k.supplyKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, stream.Recipient, due)
+		if err != nil {
+			panic(err)
+		}
+		stream.Paid = stream.Paid.Add(due)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeCommunityPoolStreamPay,
+				sdk.NewAttribute(types.AttributeKeyStreamID, fmt.Sprintf("%d", stream.ID)),
+				sdk.NewAttribute(types.AttributeKeyRecipient, stream.Recipient.String()),
+				sdk.NewAttribute(sdk.AttributeKeyAmount, due.String()),
+			),
+		)
+
+		if stream.IsComplete() {
+			k.DeleteCommunityPoolStream(ctx, stream.ID)
+		} else {
+			k.SetCommunityPoolStream(ctx, stream)
+		}
+	}
+}
@@ -2,7 +2,9 @@ package distribution
 
 import (
 	"testing"
+	"time"
 
+	abci "github.com/tendermint/tendermint/abci/types"
 	"github.com/tendermint/tendermint/crypto/ed25519"
 
 	sdk "github.com/shinecloudfoundation/shinecloudnet/types"
@@ -64,3 +66,93 @@ func TestProposalHandlerFailed(t *testing.T) {
 	require.Error(t, hdlr(ctx, tp))
 	require.True(t, accountKeeper.GetAccount(ctx, recipient).GetCoins().IsZero())
 }
+
+func TestStreamProposalHandler(t *testing.T) {
+	ctx, accountKeeper, keeper, _, supplyKeeper := CreateTestInputDefault(t, false, 10)
+	recipient := delAddr1
+	streamed := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100)))
+	ctx = ctx.WithBlockHeader(abci.Header{Time: time.Unix(0, 0).UTC()})
+
+	// add coins to the module account and the community pool
+	macc := keeper.GetDistributionAccount(ctx)
+	err := macc.SetCoins(macc.GetCoins().Add(streamed))
+	require.NoError(t, err)
+	supplyKeeper.SetModuleAccount(ctx, macc)
+
+	feePool := keeper.GetFeePool(ctx)
+	feePool.CommunityPool = sdk.NewDecCoins(streamed)
+	keeper.SetFeePool(ctx, feePool)
+
+	hdlr := NewCommunityPoolSpendProposalHandler(keeper)
+	tp := types.NewCommunityPoolStreamProposal("Test", "description", recipient, streamed, 100*time.Second, 0)
+	require.NoError(t, hdlr(ctx, tp))
+	require.True(t, keeper.GetFeePoolCommunityCoins(ctx).IsZero())
+
+	stream, found := keeper.GetCommunityPoolStream(ctx, 1)
+	require.True(t, found)
+	require.Equal(t, streamed, stream.Amount)
+
+	// a quarter of the period pays a quarter of the amount
+	ctx = ctx.WithBlockHeader(abci.Header{Time: time.Unix(25, 0).UTC()})
+	EndBlocker(ctx, keeper)
+	require.Equal(t, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(25))),
+		accountKeeper.GetAccount(ctx, recipient).GetCoins())
+	_, broken := AllInvariants(keeper)(ctx)
+	require.False(t, broken)
+
+	// cancelling returns the remainder to the community pool
+	require.NoError(t, hdlr(ctx, types.NewCancelCommunityPoolStreamProposal("Test", "description", 1)))
+	require.Equal(t, sdk.NewDecCoins(sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(75)))),
+		keeper.GetFeePoolCommunityCoins(ctx))
+	_, found = keeper.GetCommunityPoolStream(ctx, 1)
+	require.False(t, found)
+	_, broken = AllInvariants(keeper)(ctx)
+	require.False(t, broken)
+
+	// a cancelled stream cannot be cancelled again
+	require.Error(t, hdlr(ctx, types.NewCancelCommunityPoolStreamProposal("Test", "description", 1)))
+}
+
+func TestStreamProposalHandlerTranches(t *testing.T) {
+	ctx, accountKeeper, keeper, _, supplyKeeper := CreateTestInputDefault(t, false, 10)
+	recipient := delAddr1
+	streamed := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(90)))
+	ctx = ctx.WithBlockHeader(abci.Header{Time: time.Unix(0, 0).UTC()})
+
+	macc := keeper.GetDistributionAccount(ctx)
+	err := macc.SetCoins(macc.GetCoins().Add(streamed))
+	require.NoError(t, err)
+	supplyKeeper.SetModuleAccount(ctx, macc)
+
+	feePool := keeper.GetFeePool(ctx)
+	feePool.CommunityPool = sdk.NewDecCoins(streamed)
+	keeper.SetFeePool(ctx, feePool)
+
+	accountKeeper.SetAccount(ctx, accountKeeper.NewAccountWithAddress(ctx, recipient))
+	hdlr := NewCommunityPoolSpendProposalHandler(keeper)
+
+	// the community pool cannot commit more than it holds
+	tp := types.NewCommunityPoolStreamProposal("Test", "description", recipient, streamed.Add(amount), 90*time.Second, 3)
+	require.Error(t, hdlr(ctx, tp))
+
+	tp = types.NewCommunityPoolStreamProposal("Test", "description", recipient, streamed, 90*time.Second, 3)
+	require.NoError(t, hdlr(ctx, tp))
+
+	// nothing is paid before the first tranche
+	ctx = ctx.WithBlockHeader(abci.Header{Time: time.Unix(29, 0).UTC()})
+	EndBlocker(ctx, keeper)
+	require.True(t, accountKeeper.GetAccount(ctx, recipient).GetCoins().IsZero())
+
+	ctx = ctx.WithBlockHeader(abci.Header{Time: time.Unix(65, 0).UTC()})
+	EndBlocker(ctx, keeper)
+	require.Equal(t, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(60))),
+		accountKeeper.GetAccount(ctx, recipient).GetCoins())
+
+	// the stream is removed once fully paid
+	ctx = ctx.WithBlockHeader(abci.Header{Time: time.Unix(90, 0).UTC()})
+	EndBlocker(ctx, keeper)
+	require.Equal(t, streamed, accountKeeper.GetAccount(ctx, recipient).GetCoins())
+	require.Empty(t, keeper.GetCommunityPoolStreams(ctx))
+	_, broken := AllInvariants(keeper)(ctx)
+	require.False(t, broken)
+}
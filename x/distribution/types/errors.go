@@ -2,6 +2,8 @@
 package types
 
 import (
+	"fmt"
+
 	sdk "github.com/shinecloudfoundation/shinecloudnet/types"
 )
 
@@ -13,6 +15,7 @@ const (
 	CodeNoDistributionInfo      CodeType          = 104
 	CodeNoValidatorCommission   CodeType          = 105
 	CodeSetWithdrawAddrDisabled CodeType          = 106
+	CodeUnknownStream           CodeType          = 107
 )
 
 func ErrNilDelegatorAddr(codespace sdk.CodespaceType) sdk.Error {
@@ -45,3 +48,9 @@ func ErrInvalidProposalAmount(codespace sdk.CodespaceType) sdk.Error {
 func ErrEmptyProposalRecipient(codespace sdk.CodespaceType) sdk.Error {
 	return sdk.NewError(codespace, CodeInvalidInput, "invalid community pool spend proposal recipient")
 }
+func ErrInvalidStreamDuration(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidInput, "invalid community pool stream duration")
+}
+func ErrUnknownCommunityPoolStream(codespace sdk.CodespaceType, streamID uint64) sdk.Error {
+	return sdk.NewError(codespace, CodeUnknownStream, fmt.Sprintf("unknown community pool stream %d", streamID))
+}
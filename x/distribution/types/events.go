@@ -8,9 +8,19 @@ var (
 	EventTypeWithdrawRewards    = "withdraw_rewards"
 	EventTypeWithdrawCommission = "withdraw_commission"
 	EventTypeProposerReward     = "proposer_reward"
+	EventTypeSetAutoCompound    = "set_auto_compound"
+	EventTypeAutoCompound       = "auto_compound"
+
+	EventTypeCommunityPoolStream       = "community_pool_stream"
+	EventTypeCommunityPoolStreamPay    = "community_pool_stream_payment"
+	EventTypeCancelCommunityPoolStream = "cancel_community_pool_stream"
 
 	AttributeKeyWithdrawAddress = "withdraw_address"
 	AttributeKeyValidator       = "validator"
+	AttributeKeyDelegator       = "delegator"
+	AttributeKeyEnabled         = "enabled"
+	AttributeKeyStreamID        = "stream_id"
+	AttributeKeyRecipient       = "recipient"
 
 	AttributeValueCategory = ModuleName
 )
@@ -6,6 +6,12 @@ import (
 	sdk "github.com/shinecloudfoundation/shinecloudnet/types"
 )
 
+// default auto-compounding parameters
+const (
+	DefaultAutoCompoundInterval  int64  = 14400 // roughly one day of blocks
+	DefaultAutoCompoundBatchSize uint32 = 100
+)
+
 // the address for where distributions rewards are withdrawn to by default
 // this struct is only used at genesis to feed in default withdraw addresses
 type DelegatorWithdrawInfo struct {
@@ -68,13 +74,19 @@ type GenesisState struct {
 	ValidatorCurrentRewards         []ValidatorCurrentRewardsRecord        `json:"validator_current_rewards" yaml:"validator_current_rewards"`
 	DelegatorStartingInfos          []DelegatorStartingInfoRecord          `json:"delegator_starting_infos" yaml:"delegator_starting_infos"`
 	ValidatorSlashEvents            []ValidatorSlashEventRecord            `json:"validator_slash_events" yaml:"validator_slash_events"`
+	AutoCompoundInterval            int64                                  `json:"auto_compound_interval" yaml:"auto_compound_interval"`
+	AutoCompoundBatchSize           uint32                                 `json:"auto_compound_batch_size" yaml:"auto_compound_batch_size"`
+	AutoCompoundDelegators          []sdk.AccAddress                       `json:"auto_compound_delegators" yaml:"auto_compound_delegators"`
+	CommunityPoolStreams            []CommunityPoolStream                  `json:"community_pool_streams" yaml:"community_pool_streams"`
+	NextCommunityPoolStreamID       uint64                                 `json:"next_community_pool_stream_id" yaml:"next_community_pool_stream_id"`
 }
 
 func NewGenesisState(feePool FeePool, communityTax, baseProposerReward, bonusProposerReward sdk.Dec,
 	withdrawAddrEnabled bool, dwis []DelegatorWithdrawInfo, pp sdk.ConsAddress, r []ValidatorOutstandingRewardsRecord,
 	acc []ValidatorAccumulatedCommissionRecord, historical []ValidatorHistoricalRewardsRecord,
 	cur []ValidatorCurrentRewardsRecord, dels []DelegatorStartingInfoRecord,
-	slashes []ValidatorSlashEventRecord) GenesisState {
+	slashes []ValidatorSlashEventRecord, autoCompoundInterval int64, autoCompoundBatchSize uint32,
+	autoCompoundDels []sdk.AccAddress, streams []CommunityPoolStream, nextStreamID uint64) GenesisState {
 
 	return GenesisState{
 		FeePool:                         feePool,
@@ -90,6 +102,11 @@ func NewGenesisState(feePool FeePool, communityTax, baseProposerReward, bonusPro
 		ValidatorCurrentRewards:         cur,
 		DelegatorStartingInfos:          dels,
 		ValidatorSlashEvents:            slashes,
+		AutoCompoundInterval:            autoCompoundInterval,
+		AutoCompoundBatchSize:           autoCompoundBatchSize,
+		AutoCompoundDelegators:          autoCompoundDels,
+		CommunityPoolStreams:            streams,
+		NextCommunityPoolStreamID:       nextStreamID,
 	}
 }
 
@@ -109,6 +126,11 @@ func DefaultGenesisState() GenesisState {
 		ValidatorCurrentRewards:         []ValidatorCurrentRewardsRecord{},
 		DelegatorStartingInfos:          []DelegatorStartingInfoRecord{},
 		ValidatorSlashEvents:            []ValidatorSlashEventRecord{},
+		AutoCompoundInterval:            DefaultAutoCompoundInterval,
+		AutoCompoundBatchSize:           DefaultAutoCompoundBatchSize,
+		AutoCompoundDelegators:          []sdk.AccAddress{},
+		CommunityPoolStreams:            []CommunityPoolStream{},
+		NextCommunityPoolStreamID:       1,
 	}
 }
 
@@ -132,5 +154,18 @@ func ValidateGenesis(data GenesisState) error {
 			"BonusProposerReward cannot add to be greater than one, "+
 			"adds to %s", data.BaseProposerReward.Add(data.BonusProposerReward).String())
 	}
+	if data.AutoCompoundInterval < 0 {
+		return fmt.Errorf("distribution parameter AutoCompoundInterval should be non-negative, is %d",
+			data.AutoCompoundInterval)
+	}
+	for _, stream := range data.CommunityPoolStreams {
+		if err := stream.Validate(); err != nil {
+			return err
+		}
+		if data.NextCommunityPoolStreamID != 0 && stream.ID >= data.NextCommunityPoolStreamID {
+			return fmt.Errorf("community pool stream %d is not below the next stream ID %d",
+				stream.ID, data.NextCommunityPoolStreamID)
+		}
+	}
 	return data.FeePool.ValidateGenesis()
 }
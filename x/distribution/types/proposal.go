@@ -3,6 +3,7 @@ package types
 import (
 	"fmt"
 	"strings"
+	"time"
 
 	sdk "github.com/shinecloudfoundation/shinecloudnet/types"
 	govtypes "github.com/shinecloudfoundation/shinecloudnet/x/gov/types"
@@ -11,14 +12,26 @@ import (
 const (
 	// ProposalTypeCommunityPoolSpend defines the type for a CommunityPoolSpendProposal
 	ProposalTypeCommunityPoolSpend = "CommunityPoolSpend"
+	// ProposalTypeCommunityPoolStream defines the type for a CommunityPoolStreamProposal
+	ProposalTypeCommunityPoolStream = "CommunityPoolStream"
+	// ProposalTypeCancelCommunityPoolStream defines the type for a CancelCommunityPoolStreamProposal
+	ProposalTypeCancelCommunityPoolStream = "CancelCommunityPoolStream"
 )
 
-// Assert CommunityPoolSpendProposal implements govtypes.Content at compile-time
-var _ govtypes.Content = CommunityPoolSpendProposal{}
+// Assert the community pool proposals implement govtypes.Content at compile-time
+var (
+	_ govtypes.Content = CommunityPoolSpendProposal{}
+	_ govtypes.Content = CommunityPoolStreamProposal{}
+	_ govtypes.Content = CancelCommunityPoolStreamProposal{}
+)
 
 func init() {
 	govtypes.RegisterProposalType(ProposalTypeCommunityPoolSpend)
 	govtypes.RegisterProposalTypeCodec(CommunityPoolSpendProposal{}, "cosmos-sdk/CommunityPoolSpendProposal")
+	govtypes.RegisterProposalType(ProposalTypeCommunityPoolStream)
+	govtypes.RegisterProposalTypeCodec(CommunityPoolStreamProposal{}, "cosmos-sdk/CommunityPoolStreamProposal")
+	govtypes.RegisterProposalType(ProposalTypeCancelCommunityPoolStream)
+	govtypes.RegisterProposalTypeCodec(CancelCommunityPoolStreamProposal{}, "cosmos-sdk/CancelCommunityPoolStreamProposal")
 }
 
 // CommunityPoolSpendProposal spends from the community pool
@@ -72,3 +85,107 @@ func (csp CommunityPoolSpendProposal) String() string {
 `, csp.Title, csp.Description, csp.Recipient, csp.Amount))
 	return b.String()
 }
+
+// CommunityPoolStreamProposal commits an amount of the community pool to be
+// paid to a recipient over a period, linearly or in a number of equal tranches
+type CommunityPoolStreamProposal struct {
+	Title       string         `json:"title" yaml:"title"`
+	Description string         `json:"description" yaml:"description"`
+	Recipient   sdk.AccAddress `json:"recipient" yaml:"recipient"`
+	Amount      sdk.Coins      `json:"amount" yaml:"amount"`
+	Duration    time.Duration  `json:"duration" yaml:"duration"`
+	Tranches    uint64         `json:"tranches" yaml:"tranches"` // zero for a linear stream
+}
+
+// NewCommunityPoolStreamProposal creates a new community pool stream proposal.
+func NewCommunityPoolStreamProposal(title, description string, recipient sdk.AccAddress, amount sdk.Coins,
+	duration time.Duration, tranches uint64) CommunityPoolStreamProposal {
+	return CommunityPoolStreamProposal{title, description, recipient, amount, duration, tranches}
+}
+
+// GetTitle returns the title of a community pool stream proposal.
+func (csp CommunityPoolStreamProposal) GetTitle() string { return csp.Title }
+
+// GetDescription returns the description of a community pool stream proposal.
+func (csp CommunityPoolStreamProposal) GetDescription() string { return csp.Description }
+
+// ProposalRoute returns the routing key of a community pool stream proposal.
+func (csp CommunityPoolStreamProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of a community pool stream proposal.
+func (csp CommunityPoolStreamProposal) ProposalType() string { return ProposalTypeCommunityPoolStream }
+
+// ValidateBasic runs basic stateless validity checks
+func (csp CommunityPoolStreamProposal) ValidateBasic() sdk.Error {
+	err := govtypes.ValidateAbstract(DefaultCodespace, csp)
+	if err != nil {
+		return err
+	}
+	if !csp.Amount.IsValid() || csp.Amount.IsZero() {
+		return ErrInvalidProposalAmount(DefaultCodespace)
+	}
+	if csp.Recipient.Empty() {
+		return ErrEmptyProposalRecipient(DefaultCodespace)
+	}
+	if csp.Duration <= 0 {
+		return ErrInvalidStreamDuration(DefaultCodespace)
+	}
+	return nil
+}
+
+// String implements the Stringer interface.
+func (csp CommunityPoolStreamProposal) String() string {
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf(`Community Pool Stream Proposal:
+  Title:       %s
+  Description: %s
+  Recipient:   %s
+  Amount:      %s
+  Duration:    %s
+  Tranches:    %d
+`, csp.Title, csp.Description, csp.Recipient, csp.Amount, csp.Duration, csp.Tranches))
+	return b.String()
+}
+
+// CancelCommunityPoolStreamProposal cancels an active community pool stream
+// and returns its unpaid amount to the community pool
+type CancelCommunityPoolStreamProposal struct {
+	Title       string `json:"title" yaml:"title"`
+	Description string `json:"description" yaml:"description"`
+	StreamID    uint64 `json:"stream_id" yaml:"stream_id"`
+}
+
+// NewCancelCommunityPoolStreamProposal creates a new cancel community pool stream proposal.
+func NewCancelCommunityPoolStreamProposal(title, description string, streamID uint64) CancelCommunityPoolStreamProposal {
+	return CancelCommunityPoolStreamProposal{title, description, streamID}
+}
+
+// GetTitle returns the title of a cancel community pool stream proposal.
+func (ccsp CancelCommunityPoolStreamProposal) GetTitle() string { return ccsp.Title }
+
+// GetDescription returns the description of a cancel community pool stream proposal.
+func (ccsp CancelCommunityPoolStreamProposal) GetDescription() string { return ccsp.Description }
+
+// ProposalRoute returns the routing key of a cancel community pool stream proposal.
+func (ccsp CancelCommunityPoolStreamProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of a cancel community pool stream proposal.
+func (ccsp CancelCommunityPoolStreamProposal) ProposalType() string {
+	return ProposalTypeCancelCommunityPoolStream
+}
+
+// ValidateBasic runs basic stateless validity checks
+func (ccsp CancelCommunityPoolStreamProposal) ValidateBasic() sdk.Error {
+	return govtypes.ValidateAbstract(DefaultCodespace, ccsp)
+}
+
+// String implements the Stringer interface.
+func (ccsp CancelCommunityPoolStreamProposal) String() string {
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf(`Cancel Community Pool Stream Proposal:
+  Title:       %s
+  Description: %s
+  Stream ID:   %d
+`, ccsp.Title, ccsp.Description, ccsp.StreamID))
+	return b.String()
+}
@@ -13,11 +13,17 @@ const (
 	QueryDelegatorValidators         = "delegator_validators"
 	QueryWithdrawAddr                = "withdraw_addr"
 	QueryCommunityPool               = "community_pool"
+	QueryAutoCompound                = "auto_compound"
+	QueryCommunityPoolStreams        = "community_pool_streams"
+	QueryCommunityPoolStream         = "community_pool_stream"
 
 	ParamCommunityTax        = "community_tax"
 	ParamBaseProposerReward  = "base_proposer_reward"
 	ParamBonusProposerReward = "bonus_proposer_reward"
 	ParamWithdrawAddrEnabled = "withdraw_addr_enabled"
+
+	ParamAutoCompoundInterval  = "auto_compound_interval"
+	ParamAutoCompoundBatchSize = "auto_compound_batch_size"
 )
 
 // params for query 'custom/distr/validator_outstanding_rewards'
@@ -74,7 +80,8 @@ func NewQueryDelegationRewardsParams(delegatorAddr sdk.AccAddress, validatorAddr
 	}
 }
 
-// params for query 'custom/distr/delegator_total_rewards' and 'custom/distr/delegator_validators'
+// params for query 'custom/distr/delegator_total_rewards', 'custom/distr/delegator_validators'
+// and 'custom/distr/auto_compound'
 type QueryDelegatorParams struct {
 	DelegatorAddress sdk.AccAddress `json:"delegator_address" yaml:"delegator_address"`
 }
@@ -95,3 +102,13 @@ type QueryDelegatorWithdrawAddrParams struct {
 func NewQueryDelegatorWithdrawAddrParams(delegatorAddr sdk.AccAddress) QueryDelegatorWithdrawAddrParams {
 	return QueryDelegatorWithdrawAddrParams{DelegatorAddress: delegatorAddr}
 }
+
+// params for query 'custom/distr/community_pool_stream'
+type QueryCommunityPoolStreamParams struct {
+	StreamID uint64 `json:"stream_id" yaml:"stream_id"`
+}
+
+// NewQueryCommunityPoolStreamParams creates a new instance of QueryCommunityPoolStreamParams.
+func NewQueryCommunityPoolStreamParams(streamID uint64) QueryCommunityPoolStreamParams {
+	return QueryCommunityPoolStreamParams{StreamID: streamID}
+}
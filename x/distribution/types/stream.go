@@ -0,0 +1,119 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	sdk "github.com/shinecloudfoundation/shinecloudnet/types"
+)
+
+// CommunityPoolStream pays an amount committed from the community pool to a
+// recipient over a period, either linearly or in equal tranches. The unpaid
+// amount is held by the distribution module account but no longer belongs to
+// the community pool.
+type CommunityPoolStream struct {
+	ID        uint64         `json:"id" yaml:"id"`
+	Recipient sdk.AccAddress `json:"recipient" yaml:"recipient"`
+	Amount    sdk.Coins      `json:"amount" yaml:"amount"` // total amount committed to the stream
+	Paid      sdk.Coins      `json:"paid" yaml:"paid"`     // amount paid to the recipient so far
+	StartTime time.Time      `json:"start_time" yaml:"start_time"`
+	EndTime   time.Time      `json:"end_time" yaml:"end_time"`
+	Tranches  uint64         `json:"tranches" yaml:"tranches"` // zero for a linear stream
+}
+
+// NewCommunityPoolStream creates a new community pool stream
+func NewCommunityPoolStream(id uint64, recipient sdk.AccAddress, amount sdk.Coins,
+	startTime time.Time, duration time.Duration, tranches uint64) CommunityPoolStream {
+
+	return CommunityPoolStream{
+		ID:        id,
+		Recipient: recipient,
+		Amount:    amount,
+		Paid:      sdk.NewCoins(),
+		StartTime: startTime,
+		EndTime:   startTime.Add(duration),
+		Tranches:  tranches,
+	}
+}
+
+// Remaining returns the committed amount that is yet to be paid
+func (s CommunityPoolStream) Remaining() sdk.Coins {
+	return s.Amount.Sub(s.Paid)
+}
+
+// IsComplete returns whether the whole amount has been paid
+func (s CommunityPoolStream) IsComplete() bool {
+	return s.Remaining().IsZero()
+}
+
+// VestedAmount returns the amount the recipient is entitled to at a given
+// time, amounts are rounded down until the stream ends
+func (s CommunityPoolStream) VestedAmount(blockTime time.Time) sdk.Coins {
+	if !blockTime.Before(s.EndTime) {
+		return s.Amount
+	}
+	if !blockTime.After(s.StartTime) {
+		return sdk.NewCoins()
+	}
+
+	duration := sdk.NewInt(s.EndTime.Sub(s.StartTime).Nanoseconds())
+	elapsed := sdk.NewInt(blockTime.Sub(s.StartTime).Nanoseconds())
+
+	// a tranched stream only vests whole tranches
+	numerator, denominator := elapsed, duration
+	if s.Tranches > 0 {
+		tranches := sdk.NewInt(int64(s.Tranches))
+		numerator, denominator = elapsed.Mul(tranches).Quo(duration), tranches
+	}
+
+	vested := sdk.NewCoins()
+	for _, coin := range s.Amount {
+		amount := coin.Amount.Mul(numerator).Quo(denominator)
+		if amount.IsPositive() {
+			vested = vested.Add(sdk.NewCoins(sdk.NewCoin(coin.Denom, amount)))
+		}
+	}
+	return vested
+}
+
+// Validate performs a stateless validation of the stream
+func (s CommunityPoolStream) Validate() error {
+	if s.Recipient.Empty() {
+		return fmt.Errorf("community pool stream %d has no recipient", s.ID)
+	}
+	if !s.Amount.IsValid() || !s.Paid.IsValid() {
+		return fmt.Errorf("community pool stream %d has invalid amounts", s.ID)
+	}
+	if !s.Amount.IsAllGTE(s.Paid) {
+		return fmt.Errorf("community pool stream %d paid %s out of %s", s.ID, s.Paid, s.Amount)
+	}
+	if !s.EndTime.After(s.StartTime) {
+		return fmt.Errorf("community pool stream %d ends before it starts", s.ID)
+	}
+	return nil
+}
+
+func (s CommunityPoolStream) String() string {
+	return fmt.Sprintf(`Community Pool Stream %d:
+  Recipient:  %s
+  Amount:     %s
+  Paid:       %s
+  Start Time: %s
+  End Time:   %s
+  Tranches:   %d`, s.ID, s.Recipient, s.Amount, s.Paid, s.StartTime, s.EndTime, s.Tranches)
+}
+
+// CommunityPoolStreams is a collection of community pool streams
+type CommunityPoolStreams []CommunityPoolStream
+
+func (ss CommunityPoolStreams) String() string {
+	if len(ss) == 0 {
+		return "[]"
+	}
+	out := make([]string, 0, len(ss))
+	for _, s := range ss {
+		out = append(out, s.String())
+	}
+	return strings.Join(out, "\n")
+}
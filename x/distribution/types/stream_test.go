@@ -0,0 +1,46 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/shinecloudfoundation/shinecloudnet/types"
+)
+
+func TestCommunityPoolStreamVestedAmount(t *testing.T) {
+	start := time.Unix(1000, 0).UTC()
+	amount := sdk.NewCoins(sdk.NewInt64Coin("atom", 10), sdk.NewInt64Coin("stake", 1000))
+
+	linear := NewCommunityPoolStream(1, delAddr1, amount, start, 100*time.Second, 0)
+	require.True(t, linear.VestedAmount(start).IsZero())
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("atom", 1), sdk.NewInt64Coin("stake", 150)),
+		linear.VestedAmount(start.Add(15*time.Second)))
+	require.Equal(t, amount, linear.VestedAmount(start.Add(100*time.Second)))
+
+	tranched := NewCommunityPoolStream(1, delAddr1, amount, start, 100*time.Second, 4)
+	require.True(t, tranched.VestedAmount(start.Add(24*time.Second)).IsZero())
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("atom", 5), sdk.NewInt64Coin("stake", 500)),
+		tranched.VestedAmount(start.Add(60*time.Second)))
+	require.Equal(t, amount, tranched.VestedAmount(start.Add(time.Hour)))
+}
+
+func TestCommunityPoolStreamValidate(t *testing.T) {
+	start := time.Unix(1000, 0).UTC()
+	amount := sdk.NewCoins(sdk.NewInt64Coin("stake", 1000))
+
+	stream := NewCommunityPoolStream(1, delAddr1, amount, start, time.Minute, 0)
+	require.NoError(t, stream.Validate())
+
+	overpaid := stream
+	overpaid.Paid = amount.Add(amount)
+	require.Error(t, overpaid.Validate())
+
+	noRecipient := stream
+	noRecipient.Recipient = emptyDelAddr
+	require.Error(t, noRecipient.Validate())
+
+	zeroDuration := NewCommunityPoolStream(1, delAddr1, amount, start, 0, 0)
+	require.Error(t, zeroDuration.Validate())
+}
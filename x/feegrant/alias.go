@@ -0,0 +1,73 @@
+// nolint
+// autogenerated code using github.com/rigelrozanski/multitool
+// aliases generated for the following subdirectories:
+// ALIASGEN: github.com/shinecloudfoundation/shinecloudnet/x/feegrant/internal/keeper
+// ALIASGEN: github.com/shinecloudfoundation/shinecloudnet/x/feegrant/internal/types
+package feegrant
+
+import (
+	"github.com/shinecloudfoundation/shinecloudnet/x/feegrant/internal/keeper"
+	"github.com/shinecloudfoundation/shinecloudnet/x/feegrant/internal/types"
+)
+
+const (
+	DefaultCodespace          = types.DefaultCodespace
+	CodeFeeLimitExceeded      = types.CodeFeeLimitExceeded
+	CodeFeeLimitExpired       = types.CodeFeeLimitExpired
+	CodeInvalidDuration       = types.CodeInvalidDuration
+	CodeNoAllowance           = types.CodeNoAllowance
+	CodeInvalidAllowance      = types.CodeInvalidAllowance
+	EventTypeSetFeeGrant      = types.EventTypeSetFeeGrant
+	EventTypeRevokeFeeGrant   = types.EventTypeRevokeFeeGrant
+	EventTypeUseFeeGrant      = types.EventTypeUseFeeGrant
+	AttributeKeyGranter       = types.AttributeKeyGranter
+	AttributeKeyGrantee       = types.AttributeKeyGrantee
+	AttributeKeyFee           = types.AttributeKeyFee
+	AttributeValueCategory    = types.AttributeValueCategory
+	ModuleName                = types.ModuleName
+	StoreKey                  = types.StoreKey
+	RouterKey                 = types.RouterKey
+	QuerierRoute              = types.QuerierRoute
+	TypeMsgGrantFeeAllowance  = types.TypeMsgGrantFeeAllowance
+	TypeMsgRevokeFeeAllowance = types.TypeMsgRevokeFeeAllowance
+	QueryFeeAllowance         = types.QueryFeeAllowance
+	QueryFeeAllowances        = types.QueryFeeAllowances
+)
+
+var (
+	// functions aliases
+	NewKeeper                    = keeper.NewKeeper
+	NewQuerier                   = keeper.NewQuerier
+	NewBasicFeeAllowance         = types.NewBasicFeeAllowance
+	NewPeriodicFeeAllowance      = types.NewPeriodicFeeAllowance
+	RegisterCodec                = types.RegisterCodec
+	ErrFeeLimitExceeded          = types.ErrFeeLimitExceeded
+	ErrFeeLimitExpired           = types.ErrFeeLimitExpired
+	ErrInvalidDuration           = types.ErrInvalidDuration
+	ErrNoAllowance               = types.ErrNoAllowance
+	ErrInvalidAllowance          = types.ErrInvalidAllowance
+	NewFeeAllowanceGrant         = types.NewFeeAllowanceGrant
+	GetFeeAllowanceKey           = types.GetFeeAllowanceKey
+	GetFeeAllowancesByGranteeKey = types.GetFeeAllowancesByGranteeKey
+	NewMsgGrantFeeAllowance      = types.NewMsgGrantFeeAllowance
+	NewMsgRevokeFeeAllowance     = types.NewMsgRevokeFeeAllowance
+	NewQueryFeeAllowanceParams   = types.NewQueryFeeAllowanceParams
+	NewQueryFeeAllowancesParams  = types.NewQueryFeeAllowancesParams
+
+	// variable aliases
+	ModuleCdc             = types.ModuleCdc
+	FeeAllowanceKeyPrefix = types.FeeAllowanceKeyPrefix
+)
+
+type (
+	Keeper                   = keeper.Keeper
+	FeeAllowance             = types.FeeAllowance
+	BasicFeeAllowance        = types.BasicFeeAllowance
+	PeriodicFeeAllowance     = types.PeriodicFeeAllowance
+	FeeAllowanceGrant        = types.FeeAllowanceGrant
+	FeeAllowanceGrants       = types.FeeAllowanceGrants
+	MsgGrantFeeAllowance     = types.MsgGrantFeeAllowance
+	MsgRevokeFeeAllowance    = types.MsgRevokeFeeAllowance
+	QueryFeeAllowanceParams  = types.QueryFeeAllowanceParams
+	QueryFeeAllowancesParams = types.QueryFeeAllowancesParams
+)
@@ -0,0 +1,136 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/shinecloudfoundation/shinecloudnet/client"
+	"github.com/shinecloudfoundation/shinecloudnet/client/context"
+	"github.com/shinecloudfoundation/shinecloudnet/codec"
+	sdk "github.com/shinecloudfoundation/shinecloudnet/types"
+	"github.com/shinecloudfoundation/shinecloudnet/version"
+	"github.com/shinecloudfoundation/shinecloudnet/x/auth"
+	"github.com/shinecloudfoundation/shinecloudnet/x/auth/client/utils"
+	"github.com/shinecloudfoundation/shinecloudnet/x/feegrant/internal/types"
+)
+
+const (
+	flagSpendLimit  = "spend-limit"
+	flagExpiration  = "expiration"
+	flagPeriod      = "period"
+	flagPeriodLimit = "period-limit"
+)
+
+// GetTxCmd returns the transaction commands for this module
+func GetTxCmd(cdc *codec.Codec) *cobra.Command {
+	feegrantTxCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Fee grant transaction subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	feegrantTxCmd.AddCommand(client.PostCommands(
+		GetCmdGrantFeeAllowance(cdc),
+		GetCmdRevokeFeeAllowance(cdc),
+	)...)
+
+	return feegrantTxCmd
+}
+
+// GetCmdGrantFeeAllowance implements the command to grant a fee allowance
+func GetCmdGrantFeeAllowance(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "grant [grantee]",
+		Short: "Grant a fee allowance to an address",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Grant an allowance to pay transaction fees from the granter's account
+to the grantee. An existing allowance to the grantee is replaced. Without a
+spend limit the allowance is unlimited, with a period it is limited per period.
+
+Example:
+$ %s tx %s grant scloud1skjw... --spend-limit=100000uscds --expiration=2022-01-30T15:04:05Z --from=mykey
+$ %s tx %s grant scloud1skjw... --period=24h --period-limit=1000uscds --from=mykey
+`,
+				version.ClientName, types.ModuleName, version.ClientName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			grantee, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			spendLimit, err := sdk.ParseCoins(viper.GetString(flagSpendLimit))
+			if err != nil {
+				return err
+			}
+
+			var expiration time.Time
+			if exp := viper.GetString(flagExpiration); exp != "" {
+				expiration, err = time.Parse(time.RFC3339, exp)
+				if err != nil {
+					return err
+				}
+			}
+
+			basic := types.NewBasicFeeAllowance(spendLimit, expiration)
+			var allowance types.FeeAllowance = basic
+
+			if period := viper.GetDuration(flagPeriod); period != 0 {
+				periodLimit, err := sdk.ParseCoins(viper.GetString(flagPeriodLimit))
+				if err != nil {
+					return err
+				}
+				allowance = types.NewPeriodicFeeAllowance(*basic, period, periodLimit)
+			}
+
+			msg := types.NewMsgGrantFeeAllowance(cliCtx.GetFromAddress(), grantee, allowance)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.Flags().String(flagSpendLimit, "", "Total fees the grantee can spend, unlimited if empty")
+	cmd.Flags().String(flagExpiration, "", "Time the allowance expires at in RFC3339 format, never if empty")
+	cmd.Flags().Duration(flagPeriod, 0, "Length of the periods of a periodic allowance, e.g. 24h")
+	cmd.Flags().String(flagPeriodLimit, "", "Fees the grantee can spend per period of a periodic allowance")
+	return cmd
+}
+
+// GetCmdRevokeFeeAllowance implements the command to revoke a fee allowance
+func GetCmdRevokeFeeAllowance(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "revoke [grantee]",
+		Short: "Revoke the fee allowance granted to an address",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			grantee, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRevokeFeeAllowance(cliCtx.GetFromAddress(), grantee)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
@@ -0,0 +1,17 @@
+package rest
+
+import (
+	"github.com/gorilla/mux"
+
+	"github.com/shinecloudfoundation/shinecloudnet/client/context"
+	"github.com/shinecloudfoundation/shinecloudnet/x/feegrant/internal/types"
+)
+
+// RegisterRoutes - Central function to define routes that get registered by the main application
+func RegisterRoutes(cliCtx context.CLIContext, r *mux.Router) {
+	r.HandleFunc("/feegrant/grant", grantRequestHandlerFn(cliCtx)).Methods("POST")
+	r.HandleFunc("/feegrant/revoke", revokeRequestHandlerFn(cliCtx)).Methods("POST")
+
+	r.HandleFunc("/feegrant/allowance/{granter}/{grantee}", allowanceHandlerFn(cliCtx, types.QuerierRoute)).Methods("GET")
+	r.HandleFunc("/feegrant/allowances/{grantee}", allowancesHandlerFn(cliCtx, types.QuerierRoute)).Methods("GET")
+}
@@ -0,0 +1,110 @@
+package rest
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/shinecloudfoundation/shinecloudnet/client/context"
+	sdk "github.com/shinecloudfoundation/shinecloudnet/types"
+	"github.com/shinecloudfoundation/shinecloudnet/types/rest"
+	"github.com/shinecloudfoundation/shinecloudnet/x/auth/client/utils"
+	"github.com/shinecloudfoundation/shinecloudnet/x/feegrant/internal/types"
+)
+
+// GrantReq defines the properties of a grant fee allowance request's body.
+// A non-zero Period grants a periodic allowance.
+type GrantReq struct {
+	BaseReq     rest.BaseReq   `json:"base_req" yaml:"base_req"`
+	Grantee     sdk.AccAddress `json:"grantee" yaml:"grantee"`
+	SpendLimit  sdk.Coins      `json:"spend_limit" yaml:"spend_limit"`
+	Expiration  time.Time      `json:"expiration" yaml:"expiration"`
+	Period      time.Duration  `json:"period" yaml:"period"`
+	PeriodLimit sdk.Coins      `json:"period_limit" yaml:"period_limit"`
+}
+
+// RevokeReq defines the properties of a revoke fee allowance request's body.
+type RevokeReq struct {
+	BaseReq rest.BaseReq   `json:"base_req" yaml:"base_req"`
+	Grantee sdk.AccAddress `json:"grantee" yaml:"grantee"`
+}
+
+// grantRequestHandlerFn - http request handler to grant a fee allowance
+func grantRequestHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req GrantReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		cliCtx, granter, ok := withFromFields(w, cliCtx, req.BaseReq)
+		if !ok {
+			return
+		}
+
+		basic := types.NewBasicFeeAllowance(req.SpendLimit, req.Expiration)
+		var allowance types.FeeAllowance = basic
+		if req.Period != 0 {
+			allowance = types.NewPeriodicFeeAllowance(*basic, req.Period, req.PeriodLimit)
+		}
+
+		msg := types.NewMsgGrantFeeAllowance(granter, req.Grantee, allowance)
+		if err := msg.ValidateBasic(); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		utils.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
+
+// revokeRequestHandlerFn - http request handler to revoke a fee allowance
+func revokeRequestHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req RevokeReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		cliCtx, granter, ok := withFromFields(w, cliCtx, req.BaseReq)
+		if !ok {
+			return
+		}
+
+		msg := types.NewMsgRevokeFeeAllowance(granter, req.Grantee)
+		if err := msg.ValidateBasic(); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		utils.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
+
+// withFromFields sets the from fields of the context from the request's base_req
+func withFromFields(w http.ResponseWriter, cliCtx context.CLIContext, br rest.BaseReq) (context.CLIContext, sdk.AccAddress, bool) {
+	var fromAddress sdk.AccAddress
+	var fromName string
+	var err error
+	if br.GenerateOnly {
+		fromAddress, err = sdk.AccAddressFromBech32(br.From)
+	} else {
+		fromAddress, fromName, err = context.GetFromFieldsFromAddr(br.From)
+	}
+	if err != nil {
+		rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+		return cliCtx, nil, false
+	}
+
+	cliCtx = cliCtx.WithFromName(fromName).WithFromAddress(fromAddress).WithBroadcastMode(br.BroadcastMode)
+	return cliCtx, fromAddress, true
+}
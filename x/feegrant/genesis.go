@@ -0,0 +1,49 @@
+package feegrant
+
+import (
+	sdk "github.com/shinecloudfoundation/shinecloudnet/types"
+)
+
+// GenesisState contains a set of fee allowances, persisted from the store
+type GenesisState struct {
+	FeeAllowances []FeeAllowanceGrant `json:"fee_allowances" yaml:"fee_allowances"`
+}
+
+// NewGenesisState creates a new GenesisState object
+func NewGenesisState(grants []FeeAllowanceGrant) GenesisState {
+	return GenesisState{
+		FeeAllowances: grants,
+	}
+}
+
+// DefaultGenesisState returns a default genesis state
+func DefaultGenesisState() GenesisState {
+	return NewGenesisState([]FeeAllowanceGrant{})
+}
+
+// InitGenesis stores the fee allowances of the genesis state
+func InitGenesis(ctx sdk.Context, k Keeper, data GenesisState) {
+	for _, grant := range data.FeeAllowances {
+		k.GrantFeeAllowance(ctx, grant)
+	}
+}
+
+// ExportGenesis returns a GenesisState with all the fee allowances in the store
+func ExportGenesis(ctx sdk.Context, k Keeper) GenesisState {
+	grants := []FeeAllowanceGrant{}
+	k.IterateAllFeeAllowances(ctx, func(grant FeeAllowanceGrant) bool {
+		grants = append(grants, grant)
+		return false
+	})
+	return NewGenesisState(grants)
+}
+
+// ValidateGenesis performs basic validation of the fee allowances
+func ValidateGenesis(data GenesisState) error {
+	for _, grant := range data.FeeAllowances {
+		if err := grant.ValidateBasic(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,71 @@
+package feegrant
+
+import (
+	"fmt"
+
+	sdk "github.com/shinecloudfoundation/shinecloudnet/types"
+)
+
+// NewHandler returns a handler for "feegrant" type messages.
+func NewHandler(k Keeper) sdk.Handler {
+	return func(ctx sdk.Context, msg sdk.Msg) sdk.Result {
+		ctx = ctx.WithEventManager(sdk.NewEventManager())
+
+		switch msg := msg.(type) {
+		case MsgGrantFeeAllowance:
+			return handleGrantFee(ctx, k, msg)
+
+		case MsgRevokeFeeAllowance:
+			return handleRevokeFee(ctx, k, msg)
+
+		default:
+			errMsg := fmt.Sprintf("unrecognized feegrant message type: %T", msg)
+			return sdk.ErrUnknownRequest(errMsg).Result()
+		}
+	}
+}
+
+func handleGrantFee(ctx sdk.Context, k Keeper, msg MsgGrantFeeAllowance) sdk.Result {
+	// the first period of a periodic allowance starts when it is granted
+	if periodic, ok := msg.Allowance.(*PeriodicFeeAllowance); ok {
+		periodic.StartPeriod(ctx.BlockHeader().Time)
+	}
+
+	k.GrantFeeAllowance(ctx, NewFeeAllowanceGrant(msg.Granter, msg.Grantee, msg.Allowance))
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			EventTypeSetFeeGrant,
+			sdk.NewAttribute(AttributeKeyGranter, msg.Granter.String()),
+			sdk.NewAttribute(AttributeKeyGrantee, msg.Grantee.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Granter.String()),
+		),
+	})
+
+	return sdk.Result{Events: ctx.EventManager().Events()}
+}
+
+func handleRevokeFee(ctx sdk.Context, k Keeper, msg MsgRevokeFeeAllowance) sdk.Result {
+	if err := k.RevokeFeeAllowance(ctx, msg.Granter, msg.Grantee); err != nil {
+		return err.Result()
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			EventTypeRevokeFeeGrant,
+			sdk.NewAttribute(AttributeKeyGranter, msg.Granter.String()),
+			sdk.NewAttribute(AttributeKeyGrantee, msg.Grantee.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Granter.String()),
+		),
+	})
+
+	return sdk.Result{Events: ctx.EventManager().Events()}
+}
@@ -0,0 +1,136 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/shinecloudfoundation/shinecloudnet/codec"
+	sdk "github.com/shinecloudfoundation/shinecloudnet/types"
+	"github.com/shinecloudfoundation/shinecloudnet/x/feegrant/internal/types"
+)
+
+// Keeper manages the fee allowances granted between accounts
+type Keeper struct {
+	cdc       *codec.Codec
+	storeKey  sdk.StoreKey
+	codespace sdk.CodespaceType
+}
+
+// NewKeeper creates a new fee grant Keeper instance
+func NewKeeper(cdc *codec.Codec, key sdk.StoreKey, codespace sdk.CodespaceType) Keeper {
+	return Keeper{
+		cdc:       cdc,
+		storeKey:  key,
+		codespace: codespace,
+	}
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
+}
+
+// GrantFeeAllowance creates a new grant or replaces the existing one
+func (k Keeper) GrantFeeAllowance(ctx sdk.Context, grant types.FeeAllowanceGrant) {
+	store := ctx.KVStore(k.storeKey)
+	key := types.GetFeeAllowanceKey(grant.Granter, grant.Grantee)
+	store.Set(key, k.cdc.MustMarshalBinaryLengthPrefixed(grant))
+}
+
+// RevokeFeeAllowance removes an existing grant
+func (k Keeper) RevokeFeeAllowance(ctx sdk.Context, granter, grantee sdk.AccAddress) sdk.Error {
+	store := ctx.KVStore(k.storeKey)
+	key := types.GetFeeAllowanceKey(granter, grantee)
+	if !store.Has(key) {
+		return types.ErrNoAllowance(k.codespace)
+	}
+
+	store.Delete(key)
+	return nil
+}
+
+// GetFeeAllowance returns the allowance granted by granter to grantee, or nil
+// if there is none
+func (k Keeper) GetFeeAllowance(ctx sdk.Context, granter, grantee sdk.AccAddress) types.FeeAllowance {
+	grant, found := k.GetFeeGrant(ctx, granter, grantee)
+	if !found {
+		return nil
+	}
+	return grant.Allowance
+}
+
+// GetFeeGrant returns the grant from granter to grantee
+func (k Keeper) GetFeeGrant(ctx sdk.Context, granter, grantee sdk.AccAddress) (grant types.FeeAllowanceGrant, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetFeeAllowanceKey(granter, grantee))
+	if bz == nil {
+		return grant, false
+	}
+
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &grant)
+	return grant, true
+}
+
+// IterateAllGranteeFeeAllowances iterates over all the grants given to a grantee
+func (k Keeper) IterateAllGranteeFeeAllowances(ctx sdk.Context, grantee sdk.AccAddress,
+	cb func(grant types.FeeAllowanceGrant) (stop bool)) {
+
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.GetFeeAllowancesByGranteeKey(grantee))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var grant types.FeeAllowanceGrant
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &grant)
+		if cb(grant) {
+			break
+		}
+	}
+}
+
+// IterateAllFeeAllowances iterates over all the grants in the store
+func (k Keeper) IterateAllFeeAllowances(ctx sdk.Context, cb func(grant types.FeeAllowanceGrant) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.FeeAllowanceKeyPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var grant types.FeeAllowanceGrant
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &grant)
+		if cb(grant) {
+			break
+		}
+	}
+}
+
+// UseGrantedFees uses up the fee from the allowance granted by granter to
+// grantee. Used up allowances are deleted.
+func (k Keeper) UseGrantedFees(ctx sdk.Context, granter, grantee sdk.AccAddress, fee sdk.Coins) sdk.Error {
+	grant, found := k.GetFeeGrant(ctx, granter, grantee)
+	if !found || grant.Allowance == nil {
+		return types.ErrNoAllowance(k.codespace)
+	}
+
+	remove, err := grant.Allowance.Accept(fee, ctx.BlockHeader().Time)
+	if err != nil {
+		return err
+	}
+
+	if remove {
+		store := ctx.KVStore(k.storeKey)
+		store.Delete(types.GetFeeAllowanceKey(granter, grantee))
+	} else {
+		k.GrantFeeAllowance(ctx, grant)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeUseFeeGrant,
+			sdk.NewAttribute(types.AttributeKeyGranter, granter.String()),
+			sdk.NewAttribute(types.AttributeKeyGrantee, grantee.String()),
+			sdk.NewAttribute(types.AttributeKeyFee, fee.String()),
+		),
+	)
+	return nil
+}
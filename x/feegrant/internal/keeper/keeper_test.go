@@ -0,0 +1,91 @@
+package keeper
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto"
+
+	sdk "github.com/shinecloudfoundation/shinecloudnet/types"
+	"github.com/shinecloudfoundation/shinecloudnet/x/feegrant/internal/types"
+)
+
+var (
+	addr1 = sdk.AccAddress(crypto.AddressHash([]byte("addr1")))
+	addr2 = sdk.AccAddress(crypto.AddressHash([]byte("addr2")))
+	addr3 = sdk.AccAddress(crypto.AddressHash([]byte("addr3")))
+)
+
+func atoms(amt int64) sdk.Coins {
+	return sdk.NewCoins(sdk.NewInt64Coin("atom", amt))
+}
+
+func TestKeeperGrantRevoke(t *testing.T) {
+	_, ctx, keeper := SetupTestInput()
+
+	require.Nil(t, keeper.GetFeeAllowance(ctx, addr1, addr2))
+
+	basic := types.NewBasicFeeAllowance(atoms(100), time.Time{})
+	keeper.GrantFeeAllowance(ctx, types.NewFeeAllowanceGrant(addr1, addr2, basic))
+	keeper.GrantFeeAllowance(ctx, types.NewFeeAllowanceGrant(addr3, addr2, basic))
+	keeper.GrantFeeAllowance(ctx, types.NewFeeAllowanceGrant(addr2, addr3, basic))
+	require.Equal(t, basic, keeper.GetFeeAllowance(ctx, addr1, addr2))
+	require.Nil(t, keeper.GetFeeAllowance(ctx, addr2, addr1))
+
+	var grants []types.FeeAllowanceGrant
+	keeper.IterateAllGranteeFeeAllowances(ctx, addr2, func(grant types.FeeAllowanceGrant) bool {
+		grants = append(grants, grant)
+		return false
+	})
+	require.Len(t, grants, 2)
+	for _, grant := range grants {
+		require.Equal(t, addr2, grant.Grantee)
+	}
+
+	grants = nil
+	keeper.IterateAllFeeAllowances(ctx, func(grant types.FeeAllowanceGrant) bool {
+		grants = append(grants, grant)
+		return false
+	})
+	require.Len(t, grants, 3)
+
+	require.NoError(t, keeper.RevokeFeeAllowance(ctx, addr1, addr2))
+	require.Nil(t, keeper.GetFeeAllowance(ctx, addr1, addr2))
+	err := keeper.RevokeFeeAllowance(ctx, addr1, addr2)
+	require.Error(t, err)
+	require.Equal(t, types.CodeNoAllowance, err.Code())
+}
+
+func TestKeeperUseGrantedFees(t *testing.T) {
+	_, ctx, keeper := SetupTestInput()
+	now := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx = ctx.WithBlockHeader(abci.Header{Time: now})
+
+	err := keeper.UseGrantedFees(ctx, addr1, addr2, atoms(10))
+	require.Error(t, err)
+	require.Equal(t, types.CodeNoAllowance, err.Code())
+
+	basic := types.NewBasicFeeAllowance(atoms(100), now.Add(time.Hour))
+	keeper.GrantFeeAllowance(ctx, types.NewFeeAllowanceGrant(addr1, addr2, basic))
+
+	// the allowance is used up by the fees
+	require.NoError(t, keeper.UseGrantedFees(ctx, addr1, addr2, atoms(40)))
+	require.Equal(t, types.NewBasicFeeAllowance(atoms(60), now.Add(time.Hour)), keeper.GetFeeAllowance(ctx, addr1, addr2))
+
+	err = keeper.UseGrantedFees(ctx, addr1, addr2, atoms(70))
+	require.Error(t, err)
+	require.Equal(t, types.CodeFeeLimitExceeded, err.Code())
+
+	// a used up allowance is removed
+	require.NoError(t, keeper.UseGrantedFees(ctx, addr1, addr2, atoms(60)))
+	require.Nil(t, keeper.GetFeeAllowance(ctx, addr1, addr2))
+
+	// an expired allowance can't be used
+	keeper.GrantFeeAllowance(ctx, types.NewFeeAllowanceGrant(addr1, addr2, basic))
+	ctx = ctx.WithBlockHeader(abci.Header{Time: now.Add(time.Hour)})
+	err = keeper.UseGrantedFees(ctx, addr1, addr2, atoms(10))
+	require.Error(t, err)
+	require.Equal(t, types.CodeFeeLimitExpired, err.Code())
+}
@@ -0,0 +1,32 @@
+package keeper
+
+import (
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/shinecloudfoundation/shinecloudnet/codec"
+	"github.com/shinecloudfoundation/shinecloudnet/store"
+	sdk "github.com/shinecloudfoundation/shinecloudnet/types"
+	"github.com/shinecloudfoundation/shinecloudnet/x/feegrant/internal/types"
+)
+
+// SetupTestInput returns a context and a fee grant keeper on a fresh store
+func SetupTestInput() (*codec.Codec, sdk.Context, Keeper) {
+	db := dbm.NewMemDB()
+
+	cdc := codec.New()
+	types.RegisterCodec(cdc)
+	sdk.RegisterCodec(cdc)
+	codec.RegisterCrypto(cdc)
+
+	feeGrantKey := sdk.NewKVStoreKey(types.StoreKey)
+
+	ms := store.NewCommitMultiStore(db)
+	ms.MountStoreWithDB(feeGrantKey, sdk.StoreTypeIAVL, db)
+	_ = ms.LoadLatestVersion()
+
+	ctx := sdk.NewContext(ms, abci.Header{ChainID: "test-chain-id"}, false, log.NewNopLogger())
+	keeper := NewKeeper(cdc, feeGrantKey, types.DefaultCodespace)
+	return cdc, ctx, keeper
+}
@@ -0,0 +1,173 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/shinecloudfoundation/shinecloudnet/types"
+)
+
+// FeeAllowance is implemented by the allowances a granter can give to a grantee
+type FeeAllowance interface {
+	// Accept checks whether the fee can be paid from the allowance at
+	// blockTime and uses it up. It returns remove true if the allowance is
+	// used up and should be deleted.
+	Accept(fee sdk.Coins, blockTime time.Time) (remove bool, err sdk.Error)
+
+	// ValidateBasic performs a stateless validation of the allowance
+	ValidateBasic() sdk.Error
+}
+
+var (
+	_ FeeAllowance = (*BasicFeeAllowance)(nil)
+	_ FeeAllowance = (*PeriodicFeeAllowance)(nil)
+)
+
+// BasicFeeAllowance allows the grantee to spend up to SpendLimit in fees until
+// Expiration. An empty SpendLimit is unlimited and a zero Expiration never
+// expires.
+type BasicFeeAllowance struct {
+	SpendLimit sdk.Coins `json:"spend_limit" yaml:"spend_limit"`
+	Expiration time.Time `json:"expiration" yaml:"expiration"`
+}
+
+// NewBasicFeeAllowance creates a new BasicFeeAllowance object
+func NewBasicFeeAllowance(spendLimit sdk.Coins, expiration time.Time) *BasicFeeAllowance {
+	return &BasicFeeAllowance{
+		SpendLimit: spendLimit,
+		Expiration: expiration,
+	}
+}
+
+// Accept implements FeeAllowance
+func (a *BasicFeeAllowance) Accept(fee sdk.Coins, blockTime time.Time) (bool, sdk.Error) {
+	if a.isExpired(blockTime) {
+		return true, ErrFeeLimitExpired(DefaultCodespace)
+	}
+	if a.SpendLimit.Empty() {
+		return false, nil
+	}
+
+	left, hasNeg := a.SpendLimit.SafeSub(fee)
+	if hasNeg {
+		return false, ErrFeeLimitExceeded(DefaultCodespace)
+	}
+	a.SpendLimit = left
+	return left.IsZero(), nil
+}
+
+func (a BasicFeeAllowance) isExpired(blockTime time.Time) bool {
+	return !a.Expiration.IsZero() && !blockTime.Before(a.Expiration)
+}
+
+// ValidateBasic implements FeeAllowance
+func (a BasicFeeAllowance) ValidateBasic() sdk.Error {
+	if !a.SpendLimit.IsValid() {
+		return ErrInvalidAllowance(DefaultCodespace, fmt.Sprintf("invalid spend limit %s", a.SpendLimit))
+	}
+	return nil
+}
+
+func (a BasicFeeAllowance) String() string {
+	return fmt.Sprintf(`Basic Fee Allowance:
+  Spend Limit: %s
+  Expiration:  %s`, a.SpendLimit, a.Expiration)
+}
+
+// PeriodicFeeAllowance extends BasicFeeAllowance with a limit on the fees
+// spent per period. PeriodCanSpend is reset to PeriodSpendLimit at
+// PeriodReset, which then moves on by Period.
+type PeriodicFeeAllowance struct {
+	Basic            BasicFeeAllowance `json:"basic" yaml:"basic"`
+	Period           time.Duration     `json:"period" yaml:"period"`
+	PeriodSpendLimit sdk.Coins         `json:"period_spend_limit" yaml:"period_spend_limit"`
+	PeriodCanSpend   sdk.Coins         `json:"period_can_spend" yaml:"period_can_spend"`
+	PeriodReset      time.Time         `json:"period_reset" yaml:"period_reset"`
+}
+
+// NewPeriodicFeeAllowance creates a new PeriodicFeeAllowance object, the first
+// period starts when the allowance is granted
+func NewPeriodicFeeAllowance(basic BasicFeeAllowance, period time.Duration, periodSpendLimit sdk.Coins) *PeriodicFeeAllowance {
+	return &PeriodicFeeAllowance{
+		Basic:            basic,
+		Period:           period,
+		PeriodSpendLimit: periodSpendLimit,
+	}
+}
+
+// Accept implements FeeAllowance
+func (a *PeriodicFeeAllowance) Accept(fee sdk.Coins, blockTime time.Time) (bool, sdk.Error) {
+	if a.Basic.isExpired(blockTime) {
+		return true, ErrFeeLimitExpired(DefaultCodespace)
+	}
+
+	a.tryResetPeriod(blockTime)
+
+	periodLeft, hasNeg := a.PeriodCanSpend.SafeSub(fee)
+	if hasNeg {
+		return false, ErrFeeLimitExceeded(DefaultCodespace)
+	}
+
+	if a.Basic.SpendLimit.Empty() {
+		a.PeriodCanSpend = periodLeft
+		return false, nil
+	}
+	left, hasNeg := a.Basic.SpendLimit.SafeSub(fee)
+	if hasNeg {
+		return false, ErrFeeLimitExceeded(DefaultCodespace)
+	}
+	a.PeriodCanSpend = periodLeft
+	a.Basic.SpendLimit = left
+	return left.IsZero(), nil
+}
+
+// tryResetPeriod starts a new period with the full period spend limit once the
+// current period is over. A period skipped entirely is not carried over.
+func (a *PeriodicFeeAllowance) tryResetPeriod(blockTime time.Time) {
+	if blockTime.Before(a.PeriodReset) {
+		return
+	}
+
+	a.PeriodCanSpend = a.PeriodSpendLimit
+	a.PeriodReset = a.PeriodReset.Add(a.Period)
+	if !blockTime.Before(a.PeriodReset) {
+		a.PeriodReset = blockTime.Add(a.Period)
+	}
+}
+
+// StartPeriod starts the first period at blockTime if it has not started yet
+func (a *PeriodicFeeAllowance) StartPeriod(blockTime time.Time) {
+	if !a.PeriodReset.IsZero() {
+		return
+	}
+	a.PeriodCanSpend = a.PeriodSpendLimit
+	a.PeriodReset = blockTime.Add(a.Period)
+}
+
+// ValidateBasic implements FeeAllowance
+func (a PeriodicFeeAllowance) ValidateBasic() sdk.Error {
+	if err := a.Basic.ValidateBasic(); err != nil {
+		return err
+	}
+	if a.Period <= 0 {
+		return ErrInvalidDuration(DefaultCodespace, fmt.Sprintf("period should be positive, is %s", a.Period))
+	}
+	if !a.PeriodSpendLimit.IsValid() || a.PeriodSpendLimit.Empty() {
+		return ErrInvalidAllowance(DefaultCodespace, fmt.Sprintf("invalid period spend limit %s", a.PeriodSpendLimit))
+	}
+	if !a.PeriodCanSpend.IsValid() {
+		return ErrInvalidAllowance(DefaultCodespace, fmt.Sprintf("invalid period can spend %s", a.PeriodCanSpend))
+	}
+	return nil
+}
+
+func (a PeriodicFeeAllowance) String() string {
+	return fmt.Sprintf(`Periodic Fee Allowance:
+  Spend Limit:        %s
+  Expiration:         %s
+  Period:             %s
+  Period Spend Limit: %s
+  Period Can Spend:   %s
+  Period Reset:       %s`, a.Basic.SpendLimit, a.Basic.Expiration, a.Period,
+		a.PeriodSpendLimit, a.PeriodCanSpend, a.PeriodReset)
+}
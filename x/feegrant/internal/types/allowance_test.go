@@ -0,0 +1,95 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/shinecloudfoundation/shinecloudnet/types"
+)
+
+func TestBasicFeeAllowanceAccept(t *testing.T) {
+	now := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	atom := func(amt int64) sdk.Coins { return sdk.NewCoins(sdk.NewInt64Coin("atom", amt)) }
+
+	// unlimited allowance without expiration
+	allowance := NewBasicFeeAllowance(nil, time.Time{})
+	remove, err := allowance.Accept(atom(1000), now)
+	require.NoError(t, err)
+	require.False(t, remove)
+
+	// spend limit is used up
+	allowance = NewBasicFeeAllowance(atom(100), now.Add(time.Hour))
+	remove, err = allowance.Accept(atom(40), now)
+	require.NoError(t, err)
+	require.False(t, remove)
+	require.Equal(t, atom(60), allowance.SpendLimit)
+
+	_, err = allowance.Accept(atom(61), now)
+	require.Error(t, err)
+	require.Equal(t, CodeFeeLimitExceeded, err.Code())
+
+	remove, err = allowance.Accept(atom(60), now)
+	require.NoError(t, err)
+	require.True(t, remove)
+
+	// expired allowance
+	allowance = NewBasicFeeAllowance(atom(100), now)
+	remove, err = allowance.Accept(atom(1), now)
+	require.Error(t, err)
+	require.Equal(t, CodeFeeLimitExpired, err.Code())
+	require.True(t, remove)
+}
+
+func TestPeriodicFeeAllowanceAccept(t *testing.T) {
+	now := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	atom := func(amt int64) sdk.Coins { return sdk.NewCoins(sdk.NewInt64Coin("atom", amt)) }
+
+	allowance := NewPeriodicFeeAllowance(*NewBasicFeeAllowance(atom(250), time.Time{}), time.Hour, atom(100))
+	require.NoError(t, allowance.ValidateBasic())
+	allowance.StartPeriod(now)
+	require.Equal(t, atom(100), allowance.PeriodCanSpend)
+	require.Equal(t, now.Add(time.Hour), allowance.PeriodReset)
+
+	// period limit is enforced
+	remove, err := allowance.Accept(atom(70), now)
+	require.NoError(t, err)
+	require.False(t, remove)
+	_, err = allowance.Accept(atom(31), now.Add(time.Minute))
+	require.Error(t, err)
+	require.Equal(t, CodeFeeLimitExceeded, err.Code())
+
+	// next period resets the period limit
+	remove, err = allowance.Accept(atom(100), now.Add(time.Hour))
+	require.NoError(t, err)
+	require.False(t, remove)
+	require.Equal(t, now.Add(2*time.Hour), allowance.PeriodReset)
+	require.Equal(t, atom(80), allowance.Basic.SpendLimit)
+
+	// skipped periods are not carried over
+	_, err = allowance.Accept(atom(101), now.Add(5*time.Hour))
+	require.Error(t, err)
+	require.Equal(t, CodeFeeLimitExceeded, err.Code())
+	require.Equal(t, now.Add(6*time.Hour), allowance.PeriodReset)
+
+	// total spend limit is enforced
+	_, err = allowance.Accept(atom(90), now.Add(5*time.Hour))
+	require.Error(t, err)
+	require.Equal(t, CodeFeeLimitExceeded, err.Code())
+	require.Equal(t, atom(100), allowance.PeriodCanSpend)
+
+	// total spend limit is used up
+	remove, err = allowance.Accept(atom(80), now.Add(5*time.Hour))
+	require.NoError(t, err)
+	require.True(t, remove)
+}
+
+func TestPeriodicFeeAllowanceValidateBasic(t *testing.T) {
+	atom := sdk.NewCoins(sdk.NewInt64Coin("atom", 10))
+	basic := *NewBasicFeeAllowance(nil, time.Time{})
+
+	require.NoError(t, NewPeriodicFeeAllowance(basic, time.Hour, atom).ValidateBasic())
+	require.Error(t, NewPeriodicFeeAllowance(basic, 0, atom).ValidateBasic())
+	require.Error(t, NewPeriodicFeeAllowance(basic, time.Hour, nil).ValidateBasic())
+}
@@ -0,0 +1,45 @@
+//nolint
+package types
+
+import (
+	sdk "github.com/shinecloudfoundation/shinecloudnet/types"
+)
+
+// Local code type
+type CodeType = sdk.CodeType
+
+const (
+	// Default fee grant codespace
+	DefaultCodespace sdk.CodespaceType = ModuleName
+
+	CodeFeeLimitExceeded CodeType = 101
+	CodeFeeLimitExpired  CodeType = 102
+	CodeInvalidDuration  CodeType = 103
+	CodeNoAllowance      CodeType = 104
+	CodeInvalidAllowance CodeType = 105
+)
+
+// ErrFeeLimitExceeded is returned if the fee is larger than the allowance
+func ErrFeeLimitExceeded(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeFeeLimitExceeded, "fee limit exceeded")
+}
+
+// ErrFeeLimitExpired is returned if the allowance has expired
+func ErrFeeLimitExpired(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeFeeLimitExpired, "fee limit expired")
+}
+
+// ErrInvalidDuration is returned if the period of an allowance is invalid
+func ErrInvalidDuration(codespace sdk.CodespaceType, msg string) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidDuration, msg)
+}
+
+// ErrNoAllowance is returned if the granter gave no allowance to the grantee
+func ErrNoAllowance(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeNoAllowance, "no fee allowance")
+}
+
+// ErrInvalidAllowance is returned if an allowance is malformed
+func ErrInvalidAllowance(codespace sdk.CodespaceType, msg string) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidAllowance, msg)
+}
@@ -0,0 +1,14 @@
+package types
+
+// fee grant module event types
+const (
+	EventTypeSetFeeGrant    = "set_feegrant"
+	EventTypeRevokeFeeGrant = "revoke_feegrant"
+	EventTypeUseFeeGrant    = "use_feegrant"
+
+	AttributeKeyGranter = "granter"
+	AttributeKeyGrantee = "grantee"
+	AttributeKeyFee     = "fee"
+
+	AttributeValueCategory = ModuleName
+)
@@ -0,0 +1,59 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/shinecloudfoundation/shinecloudnet/types"
+)
+
+// FeeAllowanceGrant is a fee allowance given by a granter to a grantee
+type FeeAllowanceGrant struct {
+	Granter   sdk.AccAddress `json:"granter" yaml:"granter"`
+	Grantee   sdk.AccAddress `json:"grantee" yaml:"grantee"`
+	Allowance FeeAllowance   `json:"allowance" yaml:"allowance"`
+}
+
+// NewFeeAllowanceGrant creates a new FeeAllowanceGrant object
+func NewFeeAllowanceGrant(granter, grantee sdk.AccAddress, allowance FeeAllowance) FeeAllowanceGrant {
+	return FeeAllowanceGrant{
+		Granter:   granter,
+		Grantee:   grantee,
+		Allowance: allowance,
+	}
+}
+
+// ValidateBasic performs a stateless validation of the grant
+func (g FeeAllowanceGrant) ValidateBasic() sdk.Error {
+	if g.Granter.Empty() {
+		return sdk.ErrInvalidAddress("missing granter address")
+	}
+	if g.Grantee.Empty() {
+		return sdk.ErrInvalidAddress("missing grantee address")
+	}
+	if g.Granter.Equals(g.Grantee) {
+		return sdk.ErrInvalidAddress("cannot self-grant fee allowances")
+	}
+	if g.Allowance == nil {
+		return ErrInvalidAllowance(DefaultCodespace, "missing allowance")
+	}
+	return g.Allowance.ValidateBasic()
+}
+
+func (g FeeAllowanceGrant) String() string {
+	return fmt.Sprintf(`Fee Allowance Grant:
+  Granter: %s
+  Grantee: %s
+  %s`, g.Granter, g.Grantee, g.Allowance)
+}
+
+// FeeAllowanceGrants is a list of fee allowance grants
+type FeeAllowanceGrants []FeeAllowanceGrant
+
+func (gs FeeAllowanceGrants) String() string {
+	out := make([]string, len(gs))
+	for i, g := range gs {
+		out[i] = g.String()
+	}
+	return strings.Join(out, "\n")
+}
@@ -0,0 +1,36 @@
+package types
+
+import (
+	sdk "github.com/shinecloudfoundation/shinecloudnet/types"
+)
+
+const (
+	// ModuleName is the module name constant used in many places
+	ModuleName = "feegrant"
+
+	// StoreKey is the store key string for the fee grant module
+	StoreKey = ModuleName
+
+	// RouterKey is the message route for the fee grant module
+	RouterKey = ModuleName
+
+	// QuerierRoute is the querier route for the fee grant module
+	QuerierRoute = ModuleName
+)
+
+var (
+	// FeeAllowanceKeyPrefix is the set of the kvstore for fee allowance data
+	FeeAllowanceKeyPrefix = []byte{0x00}
+)
+
+// GetFeeAllowanceKey is the key used to store a fee allowance. The grantee
+// comes first so that all the allowances of a grantee can be iterated.
+func GetFeeAllowanceKey(granter, grantee sdk.AccAddress) []byte {
+	return append(GetFeeAllowancesByGranteeKey(grantee), granter...)
+}
+
+// GetFeeAllowancesByGranteeKey is the prefix of the keys of all the fee
+// allowances of a grantee
+func GetFeeAllowancesByGranteeKey(grantee sdk.AccAddress) []byte {
+	return append(FeeAllowanceKeyPrefix, grantee...)
+}
@@ -0,0 +1,39 @@
+package types
+
+import (
+	sdk "github.com/shinecloudfoundation/shinecloudnet/types"
+)
+
+// querier keys
+const (
+	QueryFeeAllowance  = "allowance"
+	QueryFeeAllowances = "allowances"
+)
+
+// QueryFeeAllowanceParams defines the params for querying the fee allowance
+// of a granter to a grantee
+type QueryFeeAllowanceParams struct {
+	Granter sdk.AccAddress `json:"granter" yaml:"granter"`
+	Grantee sdk.AccAddress `json:"grantee" yaml:"grantee"`
+}
+
+// NewQueryFeeAllowanceParams creates a new QueryFeeAllowanceParams object
+func NewQueryFeeAllowanceParams(granter, grantee sdk.AccAddress) QueryFeeAllowanceParams {
+	return QueryFeeAllowanceParams{
+		Granter: granter,
+		Grantee: grantee,
+	}
+}
+
+// QueryFeeAllowancesParams defines the params for querying all the fee
+// allowances of a grantee
+type QueryFeeAllowancesParams struct {
+	Grantee sdk.AccAddress `json:"grantee" yaml:"grantee"`
+}
+
+// NewQueryFeeAllowancesParams creates a new QueryFeeAllowancesParams object
+func NewQueryFeeAllowancesParams(grantee sdk.AccAddress) QueryFeeAllowancesParams {
+	return QueryFeeAllowancesParams{
+		Grantee: grantee,
+	}
+}
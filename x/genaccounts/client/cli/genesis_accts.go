@@ -1,7 +1,10 @@
 package cli
 
 import (
+	"encoding/json"
+	"errors"
 	"fmt"
+	"io/ioutil"
 
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
@@ -11,6 +14,7 @@ import (
 	"github.com/shinecloudfoundation/shinecloudnet/client/keys"
 	"github.com/shinecloudfoundation/shinecloudnet/codec"
 	sdk "github.com/shinecloudfoundation/shinecloudnet/types"
+	"github.com/shinecloudfoundation/shinecloudnet/x/auth"
 	"github.com/shinecloudfoundation/shinecloudnet/x/genaccounts"
 	"github.com/shinecloudfoundation/shinecloudnet/x/genutil"
 )
@@ -20,8 +24,53 @@ const (
 	flagVestingStart = "vesting-start-time"
 	flagVestingEnd   = "vesting-end-time"
 	flagVestingAmt   = "vesting-amount"
+	flagVestingSched = "vesting-schedule"
 )
 
+// vestingSchedule is the JSON file format of a periodic vesting schedule, e.g.
+//
+//	{
+//	  "start_time": 1577836800,
+//	  "periods": [
+//	    {"length": 2592000, "amount": "1000uscds"},
+//	    {"length": 2592000, "amount": "1000uscds"}
+//	  ]
+//	}
+type vestingSchedule struct {
+	StartTime int64 `json:"start_time"`
+	Periods   []struct {
+		Length int64  `json:"length"`
+		Amount string `json:"amount"`
+	} `json:"periods"`
+}
+
+// readVestingSchedule parses the periodic vesting schedule stored in file
+func readVestingSchedule(file string) (int64, auth.Periods, error) {
+	bz, err := ioutil.ReadFile(file)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	var sched vestingSchedule
+	if err := json.Unmarshal(bz, &sched); err != nil {
+		return 0, nil, fmt.Errorf("failed to parse vesting schedule %s: %v", file, err)
+	}
+	if len(sched.Periods) == 0 {
+		return 0, nil, fmt.Errorf("vesting schedule %s has no periods", file)
+	}
+
+	periods := make(auth.Periods, len(sched.Periods))
+	for i, p := range sched.Periods {
+		amount, err := sdk.ParseCoins(p.Amount)
+		if err != nil {
+			return 0, nil, err
+		}
+		periods[i] = auth.NewPeriod(p.Length, amount)
+	}
+
+	return sched.StartTime, periods, periods.Validate()
+}
+
 // AddGenesisAccountCmd returns add-genesis-account cobra Command.
 func AddGenesisAccountCmd(ctx *config.ServerContext, cdc *codec.Codec,
 	defaultNodeHome, defaultClientHome string) *cobra.Command {
@@ -60,7 +109,22 @@ func AddGenesisAccountCmd(ctx *config.ServerContext, cdc *codec.Codec,
 				return err
 			}
 
+			var periods auth.Periods
+			if schedFile := viper.GetString(flagVestingSched); schedFile != "" {
+				if !vestingAmt.IsZero() || vestingStart != 0 || vestingEnd != 0 {
+					return errors.New("--vesting-schedule can't be combined with the other vesting flags")
+				}
+
+				vestingStart, periods, err = readVestingSchedule(schedFile)
+				if err != nil {
+					return err
+				}
+				vestingAmt = periods.TotalAmount()
+				vestingEnd = vestingStart + periods.TotalLength()
+			}
+
 			genAcc := genaccounts.NewGenesisAccountRaw(addr, coins, vestingAmt, vestingStart, vestingEnd, "", "")
+			genAcc.VestingPeriods = periods
 			if err := genAcc.Validate(); err != nil {
 				return err
 			}
@@ -103,5 +167,6 @@ func AddGenesisAccountCmd(ctx *config.ServerContext, cdc *codec.Codec,
 	cmd.Flags().String(flagVestingAmt, "", "amount of coins for vesting accounts")
 	cmd.Flags().Uint64(flagVestingStart, 0, "schedule start time (unix epoch) for vesting accounts")
 	cmd.Flags().Uint64(flagVestingEnd, 0, "schedule end time (unix epoch) for vesting accounts")
+	cmd.Flags().String(flagVestingSched, "", "JSON file with the start time and periods of a periodic vesting schedule")
 	return cmd
 }
@@ -51,6 +51,45 @@ func TestGenesisAccountValidate(t *testing.T) {
 				sdk.NewCoins(sdk.NewInt64Coin("stake", 50)), 1654668078, 1554668078, "", ""),
 			errors.New("vesting start-time cannot be before end-time"),
 		},
+		{
+			"valid vesting periods",
+			GenesisAccount{
+				Address:         addr,
+				Coins:           sdk.NewCoins(sdk.NewInt64Coin("stake", 50)),
+				OriginalVesting: sdk.NewCoins(sdk.NewInt64Coin("stake", 50)),
+				StartTime:       1554668078,
+				EndTime:         1554668078 + 200,
+				VestingPeriods: auth.Periods{
+					auth.NewPeriod(100, sdk.NewCoins(sdk.NewInt64Coin("stake", 20))),
+					auth.NewPeriod(100, sdk.NewCoins(sdk.NewInt64Coin("stake", 30))),
+				},
+			},
+			nil,
+		},
+		{
+			"invalid vesting periods amount",
+			GenesisAccount{
+				Address:         addr,
+				Coins:           sdk.NewCoins(sdk.NewInt64Coin("stake", 50)),
+				OriginalVesting: sdk.NewCoins(sdk.NewInt64Coin("stake", 50)),
+				StartTime:       1554668078,
+				EndTime:         1554668078 + 100,
+				VestingPeriods:  auth.Periods{auth.NewPeriod(100, sdk.NewCoins(sdk.NewInt64Coin("stake", 20)))},
+			},
+			errors.New("vesting periods total amount must equal the vesting amount"),
+		},
+		{
+			"invalid vesting periods length",
+			GenesisAccount{
+				Address:         addr,
+				Coins:           sdk.NewCoins(sdk.NewInt64Coin("stake", 50)),
+				OriginalVesting: sdk.NewCoins(sdk.NewInt64Coin("stake", 50)),
+				StartTime:       1554668078,
+				EndTime:         1554668078 + 200,
+				VestingPeriods:  auth.Periods{auth.NewPeriod(100, sdk.NewCoins(sdk.NewInt64Coin("stake", 50)))},
+			},
+			errors.New("vesting periods total length must equal vesting end-time minus start-time"),
+		},
 		{
 			"invalid module account name",
 			NewGenesisAccountRaw(addr, sdk.NewCoins(), sdk.NewCoins(), 0, 0, " ", ""),
@@ -87,6 +126,19 @@ func TestToAccount(t *testing.T) {
 	require.IsType(t, &auth.ContinuousVestingAccount{}, acc)
 	require.Equal(t, vacc, acc.(*auth.ContinuousVestingAccount))
 
+	// periodic vesting account
+	pvacc := auth.NewPeriodicVestingAccount(
+		&authAcc, time.Now().Unix(), auth.Periods{
+			auth.NewPeriod(3600, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 50))),
+			auth.NewPeriod(3600, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100))),
+		},
+	)
+	genAcc, err = NewGenesisAccountI(pvacc)
+	require.NoError(t, err)
+	acc = genAcc.ToAccount()
+	require.IsType(t, &auth.PeriodicVestingAccount{}, acc)
+	require.Equal(t, pvacc, acc.(*auth.PeriodicVestingAccount))
+
 	// module account
 	macc := supply.NewEmptyModuleAccount("mint", supply.Minter)
 	genAcc, err = NewGenesisAccountI(macc)
@@ -95,3 +147,20 @@ func TestToAccount(t *testing.T) {
 	require.IsType(t, &supply.ModuleAccount{}, acc)
 	require.Equal(t, macc, acc.(*supply.ModuleAccount))
 }
+
+// accounts migrated from the legacy genesis formats carry no vesting periods
+// and must still decode to the vesting account types they were exported from
+func TestLegacyGenesisAccountWithoutPeriods(t *testing.T) {
+	addr := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	legacy := NewGenesisAccountRaw(addr, sdk.NewCoins(sdk.NewInt64Coin("stake", 50)),
+		sdk.NewCoins(sdk.NewInt64Coin("stake", 50)), 1554668078, 1654668078, "", "")
+
+	bz := ModuleCdc.MustMarshalJSON(legacy)
+	require.NotContains(t, string(bz), "vesting_periods")
+
+	var genAcc GenesisAccount
+	ModuleCdc.MustUnmarshalJSON(bz, &genAcc)
+	require.NoError(t, genAcc.Validate())
+	require.Empty(t, genAcc.VestingPeriods)
+	require.IsType(t, &auth.ContinuousVestingAccount{}, genAcc.ToAccount())
+}
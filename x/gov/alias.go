@@ -23,6 +23,8 @@ const (
 	CodeInvalidGenesis           = types.CodeInvalidGenesis
 	CodeInvalidProposalStatus    = types.CodeInvalidProposalStatus
 	CodeProposalHandlerNotExists = types.CodeProposalHandlerNotExists
+	CodeInvalidWeightedVote      = types.CodeInvalidWeightedVote
+	CodeInvalidProposer          = types.CodeInvalidProposer
 	ModuleName                   = types.ModuleName
 	StoreKey                     = types.StoreKey
 	RouterKey                    = types.RouterKey
@@ -30,7 +32,9 @@ const (
 	DefaultParamspace            = types.DefaultParamspace
 	TypeMsgDeposit               = types.TypeMsgDeposit
 	TypeMsgVote                  = types.TypeMsgVote
+	TypeMsgVoteWeighted          = types.TypeMsgVoteWeighted
 	TypeMsgSubmitProposal        = types.TypeMsgSubmitProposal
+	TypeMsgCancelProposal        = types.TypeMsgCancelProposal
 	StatusNil                    = types.StatusNil
 	StatusDepositPeriod          = types.StatusDepositPeriod
 	StatusVotingPeriod           = types.StatusVotingPeriod
@@ -50,11 +54,16 @@ const (
 	ParamDeposit                 = types.ParamDeposit
 	ParamVoting                  = types.ParamVoting
 	ParamTallying                = types.ParamTallying
+	ParamExpedited               = types.ParamExpedited
+	ParamBurn                    = types.ParamBurn
 	OptionEmpty                  = types.OptionEmpty
 	OptionYes                    = types.OptionYes
 	OptionAbstain                = types.OptionAbstain
 	OptionNo                     = types.OptionNo
 	OptionNoWithVeto             = types.OptionNoWithVeto
+
+	ExpeditedMinDepositMultiplier = types.ExpeditedMinDepositMultiplier
+	ExpeditedVotingPeriodDivisor  = types.ExpeditedVotingPeriodDivisor
 )
 
 var (
@@ -71,6 +80,8 @@ var (
 	ErrInvalidProposalContent     = types.ErrInvalidProposalContent
 	ErrInvalidProposalType        = types.ErrInvalidProposalType
 	ErrInvalidVote                = types.ErrInvalidVote
+	ErrInvalidWeightedVote        = types.ErrInvalidWeightedVote
+	ErrInvalidProposer            = types.ErrInvalidProposer
 	ErrInvalidGenesis             = types.ErrInvalidGenesis
 	ErrNoProposalHandlerExists    = types.ErrNoProposalHandlerExists
 	ProposalKey                   = types.ProposalKey
@@ -90,10 +101,16 @@ var (
 	NewMsgSubmitProposal          = types.NewMsgSubmitProposal
 	NewMsgDeposit                 = types.NewMsgDeposit
 	NewMsgVote                    = types.NewMsgVote
+	NewMsgVoteWeighted            = types.NewMsgVoteWeighted
+	NewMsgCancelProposal          = types.NewMsgCancelProposal
 	ParamKeyTable                 = types.ParamKeyTable
 	NewDepositParams              = types.NewDepositParams
 	NewTallyParams                = types.NewTallyParams
 	NewVotingParams               = types.NewVotingParams
+	NewExpeditedParams            = types.NewExpeditedParams
+	NewBurnParams                 = types.NewBurnParams
+	DeriveExpeditedParams         = types.DeriveExpeditedParams
+	DefaultBurnParams             = types.DefaultBurnParams
 	NewParams                     = types.NewParams
 	NewProposal                   = types.NewProposal
 	ProposalStatusFromString      = types.ProposalStatusFromString
@@ -112,8 +129,12 @@ var (
 	NewQueryVoteParams            = types.NewQueryVoteParams
 	NewQueryProposalsParams       = types.NewQueryProposalsParams
 	NewVote                       = types.NewVote
+	NewWeightedVote               = types.NewWeightedVote
+	NewWeightedVoteOption         = types.NewWeightedVoteOption
+	NewNonSplitVoteOption         = types.NewNonSplitVoteOption
 	VoteOptionFromString          = types.VoteOptionFromString
 	ValidVoteOption               = types.ValidVoteOption
+	ValidWeightedVoteOptions      = types.ValidWeightedVoteOptions
 
 	// variable aliases
 	ModuleCdc                   = types.ModuleCdc
@@ -126,6 +147,10 @@ var (
 	ParamStoreKeyDepositParams  = types.ParamStoreKeyDepositParams
 	ParamStoreKeyVotingParams   = types.ParamStoreKeyVotingParams
 	ParamStoreKeyTallyParams    = types.ParamStoreKeyTallyParams
+
+	ParamStoreKeyExpeditedParams = types.ParamStoreKeyExpeditedParams
+	ParamStoreKeyBurnParams      = types.ParamStoreKeyBurnParams
+	DefaultExpeditedThreshold    = types.DefaultExpeditedThreshold
 )
 
 type (
@@ -136,9 +161,13 @@ type (
 	MsgSubmitProposal       = types.MsgSubmitProposal
 	MsgDeposit              = types.MsgDeposit
 	MsgVote                 = types.MsgVote
+	MsgVoteWeighted         = types.MsgVoteWeighted
+	MsgCancelProposal       = types.MsgCancelProposal
 	DepositParams           = types.DepositParams
 	TallyParams             = types.TallyParams
 	VotingParams            = types.VotingParams
+	ExpeditedParams         = types.ExpeditedParams
+	BurnParams              = types.BurnParams
 	Params                  = types.Params
 	Proposal                = types.Proposal
 	Proposals               = types.Proposals
@@ -154,4 +183,6 @@ type (
 	Vote                    = types.Vote
 	Votes                   = types.Votes
 	VoteOption              = types.VoteOption
+	WeightedVoteOption      = types.WeightedVoteOption
+	WeightedVoteOptions     = types.WeightedVoteOptions
 )
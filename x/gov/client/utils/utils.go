@@ -1,6 +1,12 @@
 package utils
 
-import "github.com/shinecloudfoundation/shinecloudnet/x/gov/types"
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/shinecloudfoundation/shinecloudnet/types"
+	"github.com/shinecloudfoundation/shinecloudnet/x/gov/types"
+)
 
 // NormalizeVoteOption - normalize user specified vote option
 func NormalizeVoteOption(option string) string {
@@ -22,6 +28,35 @@ func NormalizeVoteOption(option string) string {
 	}
 }
 
+// ParseWeightedVoteOptions - parse user specified weighted vote options of the
+// form "yes=0.6,no=0.4". A single option without a weight, e.g. "yes", is given
+// all the voting power.
+func ParseWeightedVoteOptions(str string) (types.WeightedVoteOptions, error) {
+	var options types.WeightedVoteOptions
+	for _, part := range strings.Split(str, ",") {
+		fields := strings.Split(strings.TrimSpace(part), "=")
+		if len(fields) > 2 {
+			return nil, fmt.Errorf("invalid weighted vote option %q, expected option=weight", part)
+		}
+
+		option, err := types.VoteOptionFromString(NormalizeVoteOption(strings.TrimSpace(fields[0])))
+		if err != nil {
+			return nil, fmt.Errorf("'%s' is not a valid vote option", fields[0])
+		}
+
+		weight := sdk.OneDec()
+		if len(fields) == 2 {
+			weight, err = sdk.NewDecFromStr(strings.TrimSpace(fields[1]))
+			if err != nil {
+				return nil, fmt.Errorf("invalid weight %q of vote option %s: %s", fields[1], option, err)
+			}
+		}
+
+		options = append(options, types.NewWeightedVoteOption(option, weight))
+	}
+	return options, nil
+}
+
 //NormalizeProposalType - normalize user specified proposal type
 func NormalizeProposalType(proposalType string) string {
 	switch proposalType {
@@ -51,7 +51,7 @@ func (keeper Keeper) AddDeposit(ctx sdk.Context, proposalID uint64, depositorAdd
 
 	// Check if deposit has provided sufficient total funds to transition the proposal into the voting period
 	activatedVotingPeriod := false
-	if proposal.Status == StatusDepositPeriod && proposal.TotalDeposit.IsAllGTE(keeper.GetDepositParams(ctx).MinDeposit) {
+	if proposal.Status == StatusDepositPeriod && proposal.TotalDeposit.IsAllGTE(keeper.getMinDeposit(ctx, proposal)) {
 		keeper.activateVotingPeriod(ctx, proposal)
 		activatedVotingPeriod = true
 	}
@@ -129,3 +129,33 @@ func (keeper Keeper) DeleteDeposits(ctx sdk.Context, proposalID uint64) {
 		return false
 	})
 }
+
+// BurnAndRefundDeposits burns the burnRatio fraction of each deposit on a
+// specific proposal and refunds the rest to its depositor
+func (keeper Keeper) BurnAndRefundDeposits(ctx sdk.Context, proposalID uint64, burnRatio sdk.Dec) {
+	store := ctx.KVStore(keeper.storeKey)
+
+	keeper.IterateDeposits(ctx, proposalID, func(deposit types.Deposit) bool {
+		burnAmount := sdk.NewCoins()
+		for _, coin := range deposit.Amount {
+			burnAmount = burnAmount.Add(sdk.NewCoins(sdk.NewCoin(coin.Denom, coin.Amount.ToDec().Mul(burnRatio).TruncateInt())))
+		}
+		refundAmount := deposit.Amount.Sub(burnAmount)
+
+		if !burnAmount.IsZero() {
+			err := keeper.supplyKeeper.BurnCoins(ctx, types.ModuleName, burnAmount)
+			if err != nil {
+				panic(err)
+			}
+		}
+		if !refundAmount.IsZero() {
+			err := keeper.supplyKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, deposit.Depositor, refundAmount)
+			if err != nil {
+				panic(err)
+			}
+		}
+
+		store.Delete(DepositKey(proposalID, deposit.Depositor))
+		return false
+	})
+}
@@ -11,10 +11,14 @@ import (
 func EndBlocker(ctx sdk.Context, keeper Keeper) {
 	logger := keeper.Logger(ctx)
 
-	// delete inactive proposal from store and its deposits
+	// delete inactive proposal from store and burn or refund its deposits
 	keeper.IterateInactiveProposalsQueue(ctx, ctx.BlockHeader().Time, func(proposal Proposal) bool {
 		keeper.DeleteProposal(ctx, proposal.ProposalID)
-		keeper.DeleteDeposits(ctx, proposal.ProposalID)
+		if keeper.GetBurnParams(ctx).BurnProposalDepositPrevote {
+			keeper.DeleteDeposits(ctx, proposal.ProposalID)
+		} else {
+			keeper.RefundDeposits(ctx, proposal.ProposalID)
+		}
 
 		ctx.EventManager().EmitEvent(
 			sdk.NewEvent(
@@ -28,19 +32,53 @@ func EndBlocker(ctx sdk.Context, keeper Keeper) {
 			fmt.Sprintf("proposal %d (%s) didn't meet minimum deposit of %s (had only %s); deleted",
 				proposal.ProposalID,
 				proposal.GetTitle(),
-				keeper.GetDepositParams(ctx).MinDeposit,
+				keeper.getMinDeposit(ctx, proposal),
 				proposal.TotalDeposit,
 			),
 		)
 		return false
 	})
 
+	// expedited proposals that didn't pass are converted to normal proposals
+	// and re-queued once the iteration is done
+	var convertedProposals []Proposal
+
 	// fetch active proposals whose voting periods have ended (are passed the block time)
 	keeper.IterateActiveProposalsQueue(ctx, ctx.BlockHeader().Time, func(proposal Proposal) bool {
 		var tagValue, logMsg string
 
 		passes, burnDeposits, tallyResults := tally(ctx, keeper, proposal)
 
+		// An expedited proposal that didn't pass keeps its deposits and votes
+		// and continues to be voted on until the end of the normal voting
+		// period, where it is tallied with the normal threshold.
+		if proposal.IsExpedited && !passes {
+			keeper.RemoveFromActiveProposalQueue(ctx, proposal.ProposalID, proposal.VotingEndTime)
+
+			proposal.IsExpedited = false
+			proposal.VotingEndTime = proposal.VotingStartTime.Add(keeper.GetVotingParams(ctx).VotingPeriod)
+			keeper.SetProposal(ctx, proposal)
+			convertedProposals = append(convertedProposals, proposal)
+
+			logger.Info(
+				fmt.Sprintf(
+					"expedited proposal %d (%s) didn't pass; converted to a normal proposal ending at %s",
+					proposal.ProposalID, proposal.GetTitle(), proposal.VotingEndTime,
+				),
+			)
+
+			ctx.EventManager().EmitEvent(
+				sdk.NewEvent(
+					types.EventTypeActiveProposal,
+					sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", proposal.ProposalID)),
+					sdk.NewAttribute(types.AttributeKeyProposalResult, types.AttributeValueExpeditedProposalConverted),
+				),
+			)
+			return false
+		}
+
+		keeper.deleteVotes(ctx, proposal.ProposalID)
+
 		if burnDeposits {
 			keeper.DeleteDeposits(ctx, proposal.ProposalID)
 		} else {
@@ -94,4 +132,8 @@ func EndBlocker(ctx sdk.Context, keeper Keeper) {
 		)
 		return false
 	})
+
+	for _, proposal := range convertedProposals {
+		keeper.InsertActiveProposalQueue(ctx, proposal.ProposalID, proposal.VotingEndTime)
+	}
 }
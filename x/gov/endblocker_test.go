@@ -29,6 +29,7 @@ func TestTickExpiredDepositPeriod(t *testing.T) {
 		ContentFromProposalType("test", "test", ProposalTypeText),
 		sdk.Coins{sdk.NewInt64Coin(sdk.DefaultBondDenom, 5)},
 		input.addrs[0],
+		false,
 	)
 
 	res := govHandler(ctx, newProposalMsg)
@@ -78,6 +79,7 @@ func TestTickMultipleExpiredDepositPeriod(t *testing.T) {
 		ContentFromProposalType("test", "test", ProposalTypeText),
 		sdk.Coins{sdk.NewInt64Coin(sdk.DefaultBondDenom, 5)},
 		input.addrs[0],
+		false,
 	)
 
 	res := govHandler(ctx, newProposalMsg)
@@ -99,6 +101,7 @@ func TestTickMultipleExpiredDepositPeriod(t *testing.T) {
 		ContentFromProposalType("test2", "test2", ProposalTypeText),
 		sdk.Coins{sdk.NewInt64Coin(sdk.DefaultBondDenom, 5)},
 		input.addrs[0],
+		false,
 	)
 
 	res = govHandler(ctx, newProposalMsg2)
@@ -149,6 +152,7 @@ func TestTickPassedDepositPeriod(t *testing.T) {
 		ContentFromProposalType("test2", "test2", ProposalTypeText),
 		sdk.Coins{sdk.NewInt64Coin(sdk.DefaultBondDenom, 5)},
 		input.addrs[0],
+		false,
 	)
 
 	res := govHandler(ctx, newProposalMsg)
@@ -195,7 +199,7 @@ func TestTickPassedVotingPeriod(t *testing.T) {
 	activeQueue.Close()
 
 	proposalCoins := sdk.Coins{sdk.NewCoin(sdk.DefaultBondDenom, sdk.TokensFromConsensusPower(5))}
-	newProposalMsg := NewMsgSubmitProposal(testProposal(), proposalCoins, input.addrs[0])
+	newProposalMsg := NewMsgSubmitProposal(testProposal(), proposalCoins, input.addrs[0], false)
 
 	res := govHandler(ctx, newProposalMsg)
 	require.True(t, res.IsOK())
@@ -259,7 +263,7 @@ func TestProposalPassedEndblocker(t *testing.T) {
 	require.NotNil(t, macc)
 	initialModuleAccCoins := macc.GetCoins()
 
-	proposal, err := input.keeper.SubmitProposal(ctx, testProposal())
+	proposal, err := input.keeper.SubmitProposal(ctx, testProposal(), input.addrs[0], false)
 	require.NoError(t, err)
 
 	proposalCoins := sdk.Coins{sdk.NewCoin(sdk.DefaultBondDenom, sdk.TokensFromConsensusPower(10))}
@@ -310,7 +314,7 @@ func TestEndBlockerProposalHandlerFailed(t *testing.T) {
 	// Create a proposal where the handler will pass for the test proposal
 	// because the value of contextKeyBadProposal is true.
 	ctx = ctx.WithValue(contextKeyBadProposal, true)
-	proposal, err := input.keeper.SubmitProposal(ctx, testProposal())
+	proposal, err := input.keeper.SubmitProposal(ctx, testProposal(), input.addrs[0], false)
 	require.NoError(t, err)
 
 	proposalCoins := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.TokensFromConsensusPower(10)))
@@ -332,3 +336,162 @@ func TestEndBlockerProposalHandlerFailed(t *testing.T) {
 	// validate that the proposal fails/has been rejected
 	EndBlocker(ctx, input.keeper)
 }
+
+func TestEndBlockerExpeditedProposalPassed(t *testing.T) {
+	input := getMockApp(t, 1, GenesisState{}, nil)
+	SortAddresses(input.addrs)
+
+	stakingHandler := staking.NewHandler(input.sk)
+
+	header := abci.Header{Height: input.mApp.LastBlockHeight() + 1}
+	input.mApp.BeginBlock(abci.RequestBeginBlock{Header: header})
+	ctx := input.mApp.BaseApp.NewContext(false, abci.Header{})
+
+	valAddr := sdk.ValAddress(input.addrs[0])
+
+	createValidators(t, stakingHandler, ctx, []sdk.ValAddress{valAddr}, []int64{10})
+	staking.EndBlocker(ctx, input.sk)
+
+	expeditedParams := input.keeper.GetExpeditedParams(ctx)
+	expeditedParams.MinDeposit = sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.TokensFromConsensusPower(20)))
+	input.keeper.SetExpeditedParams(ctx, expeditedParams)
+
+	proposal, err := input.keeper.SubmitProposal(ctx, testProposal(), input.addrs[0], true)
+	require.NoError(t, err)
+	require.True(t, proposal.IsExpedited)
+
+	// the normal min deposit isn't enough for an expedited proposal
+	proposalCoins := input.keeper.GetDepositParams(ctx).MinDeposit
+	err, votingStarted := input.keeper.AddDeposit(ctx, proposal.ProposalID, input.addrs[0], proposalCoins)
+	require.NoError(t, err)
+	require.False(t, votingStarted)
+
+	proposalCoins = input.keeper.GetExpeditedParams(ctx).MinDeposit.Sub(proposalCoins)
+	err, votingStarted = input.keeper.AddDeposit(ctx, proposal.ProposalID, input.addrs[0], proposalCoins)
+	require.NoError(t, err)
+	require.True(t, votingStarted)
+
+	proposal, ok := input.keeper.GetProposal(ctx, proposal.ProposalID)
+	require.True(t, ok)
+	require.Equal(t, proposal.VotingStartTime.Add(input.keeper.GetExpeditedParams(ctx).VotingPeriod), proposal.VotingEndTime)
+
+	err = input.keeper.AddVote(ctx, proposal.ProposalID, input.addrs[0], OptionYes)
+	require.NoError(t, err)
+
+	newHeader := ctx.BlockHeader()
+	newHeader.Time = proposal.VotingEndTime
+	ctx = ctx.WithBlockHeader(newHeader)
+
+	EndBlocker(ctx, input.keeper)
+
+	proposal, ok = input.keeper.GetProposal(ctx, proposal.ProposalID)
+	require.True(t, ok)
+	require.Equal(t, StatusPassed, proposal.Status)
+	require.True(t, proposal.IsExpedited)
+}
+
+func TestEndBlockerExpeditedProposalConverted(t *testing.T) {
+	input := getMockApp(t, 1, GenesisState{}, nil)
+	SortAddresses(input.addrs)
+
+	stakingHandler := staking.NewHandler(input.sk)
+
+	header := abci.Header{Height: input.mApp.LastBlockHeight() + 1}
+	input.mApp.BeginBlock(abci.RequestBeginBlock{Header: header})
+	ctx := input.mApp.BaseApp.NewContext(false, abci.Header{})
+
+	valAddr := sdk.ValAddress(input.addrs[0])
+
+	createValidators(t, stakingHandler, ctx, []sdk.ValAddress{valAddr}, []int64{10})
+	staking.EndBlocker(ctx, input.sk)
+
+	expeditedParams := input.keeper.GetExpeditedParams(ctx)
+	expeditedParams.MinDeposit = sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.TokensFromConsensusPower(20)))
+	input.keeper.SetExpeditedParams(ctx, expeditedParams)
+
+	proposal, err := input.keeper.SubmitProposal(ctx, testProposal(), input.addrs[0], true)
+	require.NoError(t, err)
+
+	proposalCoins := input.keeper.GetExpeditedParams(ctx).MinDeposit
+	err, votingStarted := input.keeper.AddDeposit(ctx, proposal.ProposalID, input.addrs[0], proposalCoins)
+	require.NoError(t, err)
+	require.True(t, votingStarted)
+
+	err = input.keeper.AddVote(ctx, proposal.ProposalID, input.addrs[0], OptionNo)
+	require.NoError(t, err)
+
+	proposal, ok := input.keeper.GetProposal(ctx, proposal.ProposalID)
+	require.True(t, ok)
+
+	newHeader := ctx.BlockHeader()
+	newHeader.Time = proposal.VotingEndTime
+	ctx = ctx.WithBlockHeader(newHeader)
+
+	EndBlocker(ctx, input.keeper)
+
+	// the proposal didn't pass as expedited and continues as a normal
+	// proposal, keeping its deposits and votes
+	proposal, ok = input.keeper.GetProposal(ctx, proposal.ProposalID)
+	require.True(t, ok)
+	require.Equal(t, StatusVotingPeriod, proposal.Status)
+	require.False(t, proposal.IsExpedited)
+	require.Equal(t, proposal.VotingStartTime.Add(input.keeper.GetVotingParams(ctx).VotingPeriod), proposal.VotingEndTime)
+	require.True(t, proposal.TotalDeposit.IsEqual(proposalCoins))
+
+	_, found := input.keeper.GetVote(ctx, proposal.ProposalID, input.addrs[0])
+	require.True(t, found)
+
+	activeQueue := input.keeper.ActiveProposalQueueIterator(ctx, proposal.VotingEndTime)
+	require.True(t, activeQueue.Valid())
+	activeQueue.Close()
+
+	// the voter changes their mind before the end of the normal voting period
+	err = input.keeper.AddVote(ctx, proposal.ProposalID, input.addrs[0], OptionYes)
+	require.NoError(t, err)
+
+	newHeader = ctx.BlockHeader()
+	newHeader.Time = proposal.VotingEndTime
+	ctx = ctx.WithBlockHeader(newHeader)
+
+	EndBlocker(ctx, input.keeper)
+
+	proposal, ok = input.keeper.GetProposal(ctx, proposal.ProposalID)
+	require.True(t, ok)
+	require.Equal(t, StatusPassed, proposal.Status)
+
+	_, found = input.keeper.GetVote(ctx, proposal.ProposalID, input.addrs[0])
+	require.False(t, found)
+}
+
+func TestTickExpiredDepositPeriodRefund(t *testing.T) {
+	input := getMockApp(t, 1, GenesisState{}, nil)
+
+	header := abci.Header{Height: input.mApp.LastBlockHeight() + 1}
+	input.mApp.BeginBlock(abci.RequestBeginBlock{Header: header})
+	ctx := input.mApp.BaseApp.NewContext(false, abci.Header{})
+
+	burnParams := input.keeper.GetBurnParams(ctx)
+	burnParams.BurnProposalDepositPrevote = false
+	input.keeper.SetBurnParams(ctx, burnParams)
+
+	initialCoins := input.mApp.AccountKeeper.GetAccount(ctx, input.addrs[0]).GetCoins()
+
+	proposal, err := input.keeper.SubmitProposal(ctx, testProposal(), input.addrs[0], false)
+	require.NoError(t, err)
+
+	proposalCoins := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 5))
+	err, votingStarted := input.keeper.AddDeposit(ctx, proposal.ProposalID, input.addrs[0], proposalCoins)
+	require.Nil(t, err)
+	require.False(t, votingStarted)
+
+	newHeader := ctx.BlockHeader()
+	newHeader.Time = proposal.DepositEndTime
+	ctx = ctx.WithBlockHeader(newHeader)
+
+	EndBlocker(ctx, input.keeper)
+
+	// the proposal is deleted and its deposits are refunded
+	_, ok := input.keeper.GetProposal(ctx, proposal.ProposalID)
+	require.False(t, ok)
+	require.Equal(t, initialCoins, input.mApp.AccountKeeper.GetAccount(ctx, input.addrs[0]).GetCoins())
+}
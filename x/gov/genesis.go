@@ -12,6 +12,9 @@ import (
 const (
 	// Default period for deposits & voting
 	DefaultPeriod time.Duration = 86400 * 2 * time.Second // 2 days
+
+	// Default voting period of expedited proposals
+	DefaultExpeditedPeriod time.Duration = 86400 * time.Second // 1 day
 )
 
 // GenesisState - all staking state that must be provided at genesis
@@ -23,15 +26,37 @@ type GenesisState struct {
 	DepositParams      DepositParams `json:"deposit_params" yaml:"deposit_params"`
 	VotingParams       VotingParams  `json:"voting_params" yaml:"voting_params"`
 	TallyParams        TallyParams   `json:"tally_params" yaml:"tally_params"`
+
+	// ExpeditedParams are optional for compatibility with the genesis files
+	// exported before expedited proposals, they are derived from the params
+	// of normal proposals if missing
+	ExpeditedParams ExpeditedParams `json:"expedited_params" yaml:"expedited_params"`
+	// BurnParams are optional as well, the defaults keep burning the deposits
+	// of proposals that didn't reach quorum or the min deposit
+	BurnParams BurnParams `json:"burn_params" yaml:"burn_params"`
 }
 
 // NewGenesisState creates a new genesis state for the governance module
-func NewGenesisState(startingProposalID uint64, dp DepositParams, vp VotingParams, tp TallyParams) GenesisState {
+func NewGenesisState(startingProposalID uint64, dp DepositParams, vp VotingParams, tp TallyParams,
+	ep ExpeditedParams, bp BurnParams) GenesisState {
+
 	return GenesisState{
 		StartingProposalID: startingProposalID,
 		DepositParams:      dp,
 		VotingParams:       vp,
 		TallyParams:        tp,
+		ExpeditedParams:    ep,
+		BurnParams:         bp,
+	}
+}
+
+// DefaultExpeditedParams returns the default params of expedited proposals
+func DefaultExpeditedParams() ExpeditedParams {
+	minDepositTokens := sdk.TokensFromConsensusPower(50)
+	return ExpeditedParams{
+		MinDeposit:   sdk.Coins{sdk.NewCoin(sdk.DefaultBondDenom, minDepositTokens)},
+		VotingPeriod: DefaultExpeditedPeriod,
+		Threshold:    types.DefaultExpeditedThreshold,
 	}
 }
 
@@ -52,6 +77,8 @@ func DefaultGenesisState() GenesisState {
 			Threshold: sdk.NewDecWithPrec(5, 1),
 			Veto:      sdk.NewDecWithPrec(334, 3),
 		},
+		ExpeditedParams: DefaultExpeditedParams(),
+		BurnParams:      DefaultBurnParams(),
 	}
 }
 
@@ -87,6 +114,20 @@ func ValidateGenesis(data GenesisState) error {
 			data.DepositParams.MinDeposit.String())
 	}
 
+	expeditedParams := data.ExpeditedParams
+	if expeditedParams.IsEmpty() {
+		expeditedParams = types.DeriveExpeditedParams(data.DepositParams, data.VotingParams, data.TallyParams)
+	}
+	if err := expeditedParams.Validate(data.DepositParams, data.VotingParams, data.TallyParams); err != nil {
+		return err
+	}
+
+	if !data.BurnParams.ProposalCancelRatio.IsNil() {
+		if err := data.BurnParams.Validate(); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -97,6 +138,12 @@ func InitGenesis(ctx sdk.Context, k Keeper, supplyKeeper SupplyKeeper, data Gene
 	k.SetDepositParams(ctx, data.DepositParams)
 	k.SetVotingParams(ctx, data.VotingParams)
 	k.SetTallyParams(ctx, data.TallyParams)
+	if !data.ExpeditedParams.IsEmpty() {
+		k.SetExpeditedParams(ctx, data.ExpeditedParams)
+	}
+	if !data.BurnParams.ProposalCancelRatio.IsNil() {
+		k.SetBurnParams(ctx, data.BurnParams)
+	}
 
 	// check if the deposits pool account exists
 	moduleAcc := k.GetGovernanceAccount(ctx)
@@ -160,5 +207,7 @@ func ExportGenesis(ctx sdk.Context, k Keeper) GenesisState {
 		DepositParams:      depositParams,
 		VotingParams:       votingParams,
 		TallyParams:        tallyParams,
+		ExpeditedParams:    k.GetExpeditedParams(ctx),
+		BurnParams:         k.GetBurnParams(ctx),
 	}
 }
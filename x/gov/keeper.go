@@ -105,6 +105,26 @@ func (keeper Keeper) GetTallyParams(ctx sdk.Context) TallyParams {
 	return tallyParams
 }
 
+// Returns the current ExpeditedParams from the global param store, the ones
+// derived from the params of normal proposals if they were never set
+func (keeper Keeper) GetExpeditedParams(ctx sdk.Context) ExpeditedParams {
+	var expeditedParams ExpeditedParams
+	keeper.paramSpace.GetIfExists(ctx, ParamStoreKeyExpeditedParams, &expeditedParams)
+	if expeditedParams.IsEmpty() {
+		return types.DeriveExpeditedParams(keeper.GetDepositParams(ctx), keeper.GetVotingParams(ctx),
+			keeper.GetTallyParams(ctx))
+	}
+	return expeditedParams
+}
+
+// Returns the current BurnParams from the global param store, the default
+// ones if they were never set
+func (keeper Keeper) GetBurnParams(ctx sdk.Context) BurnParams {
+	burnParams := DefaultBurnParams()
+	keeper.paramSpace.GetIfExists(ctx, ParamStoreKeyBurnParams, &burnParams)
+	return burnParams
+}
+
 func (keeper Keeper) SetDepositParams(ctx sdk.Context, depositParams DepositParams) {
 	keeper.paramSpace.Set(ctx, ParamStoreKeyDepositParams, &depositParams)
 }
@@ -117,6 +137,30 @@ func (keeper Keeper) SetTallyParams(ctx sdk.Context, tallyParams TallyParams) {
 	keeper.paramSpace.Set(ctx, ParamStoreKeyTallyParams, &tallyParams)
 }
 
+func (keeper Keeper) SetExpeditedParams(ctx sdk.Context, expeditedParams ExpeditedParams) {
+	keeper.paramSpace.Set(ctx, ParamStoreKeyExpeditedParams, &expeditedParams)
+}
+
+func (keeper Keeper) SetBurnParams(ctx sdk.Context, burnParams BurnParams) {
+	keeper.paramSpace.Set(ctx, ParamStoreKeyBurnParams, &burnParams)
+}
+
+// getMinDeposit returns the deposit a proposal needs to enter voting period
+func (keeper Keeper) getMinDeposit(ctx sdk.Context, proposal Proposal) sdk.Coins {
+	if proposal.IsExpedited {
+		return keeper.GetExpeditedParams(ctx).MinDeposit
+	}
+	return keeper.GetDepositParams(ctx).MinDeposit
+}
+
+// getVotingPeriod returns the length of the voting period of a proposal
+func (keeper Keeper) getVotingPeriod(ctx sdk.Context, proposal Proposal) time.Duration {
+	if proposal.IsExpedited {
+		return keeper.GetExpeditedParams(ctx).VotingPeriod
+	}
+	return keeper.GetVotingParams(ctx).VotingPeriod
+}
+
 // ProposalQueues
 
 // InsertActiveProposalQueue inserts a ProposalID into the active proposal queue at endTime
@@ -11,6 +11,7 @@ import (
 
 	"github.com/shinecloudfoundation/shinecloudnet/codec"
 	sdk "github.com/shinecloudfoundation/shinecloudnet/types"
+	"github.com/shinecloudfoundation/shinecloudnet/x/params"
 )
 
 func TestGetSetProposal(t *testing.T) {
@@ -22,7 +23,7 @@ func TestGetSetProposal(t *testing.T) {
 	ctx := input.mApp.BaseApp.NewContext(false, abci.Header{})
 
 	tp := testProposal()
-	proposal, err := input.keeper.SubmitProposal(ctx, tp)
+	proposal, err := input.keeper.SubmitProposal(ctx, tp, nil, false)
 	require.NoError(t, err)
 	proposalID := proposal.ProposalID
 	input.keeper.SetProposal(ctx, proposal)
@@ -41,12 +42,12 @@ func TestIncrementProposalNumber(t *testing.T) {
 	ctx := input.mApp.BaseApp.NewContext(false, abci.Header{})
 
 	tp := testProposal()
-	input.keeper.SubmitProposal(ctx, tp)
-	input.keeper.SubmitProposal(ctx, tp)
-	input.keeper.SubmitProposal(ctx, tp)
-	input.keeper.SubmitProposal(ctx, tp)
-	input.keeper.SubmitProposal(ctx, tp)
-	proposal6, err := input.keeper.SubmitProposal(ctx, tp)
+	input.keeper.SubmitProposal(ctx, tp, nil, false)
+	input.keeper.SubmitProposal(ctx, tp, nil, false)
+	input.keeper.SubmitProposal(ctx, tp, nil, false)
+	input.keeper.SubmitProposal(ctx, tp, nil, false)
+	input.keeper.SubmitProposal(ctx, tp, nil, false)
+	proposal6, err := input.keeper.SubmitProposal(ctx, tp, nil, false)
 	require.NoError(t, err)
 
 	require.Equal(t, uint64(6), proposal6.ProposalID)
@@ -61,7 +62,7 @@ func TestActivateVotingPeriod(t *testing.T) {
 	ctx := input.mApp.BaseApp.NewContext(false, abci.Header{})
 
 	tp := testProposal()
-	proposal, err := input.keeper.SubmitProposal(ctx, tp)
+	proposal, err := input.keeper.SubmitProposal(ctx, tp, nil, false)
 	require.NoError(t, err)
 
 	require.True(t, proposal.VotingStartTime.Equal(time.Time{}))
@@ -92,7 +93,7 @@ func TestDeposits(t *testing.T) {
 	ctx := input.mApp.BaseApp.NewContext(false, abci.Header{})
 
 	tp := testProposal()
-	proposal, err := input.keeper.SubmitProposal(ctx, tp)
+	proposal, err := input.keeper.SubmitProposal(ctx, tp, input.addrs[0], false)
 	require.NoError(t, err)
 	proposalID := proposal.ProposalID
 
@@ -193,7 +194,7 @@ func TestVotes(t *testing.T) {
 	ctx := input.mApp.BaseApp.NewContext(false, abci.Header{})
 
 	tp := testProposal()
-	proposal, err := input.keeper.SubmitProposal(ctx, tp)
+	proposal, err := input.keeper.SubmitProposal(ctx, tp, input.addrs[0], false)
 	require.NoError(t, err)
 	proposalID := proposal.ProposalID
 
@@ -255,7 +256,7 @@ func TestProposalQueues(t *testing.T) {
 
 	// create test proposals
 	tp := testProposal()
-	proposal, err := input.keeper.SubmitProposal(ctx, tp)
+	proposal, err := input.keeper.SubmitProposal(ctx, tp, nil, false)
 	require.NoError(t, err)
 
 	inactiveIterator := input.keeper.InactiveProposalQueueIterator(ctx, proposal.DepositEndTime)
@@ -350,7 +351,111 @@ func TestSubmitProposal(t *testing.T) {
 	}
 
 	for _, tc := range testCases {
-		_, err := input.keeper.SubmitProposal(ctx, tc.content)
+		_, err := input.keeper.SubmitProposal(ctx, tc.content, nil, false)
 		require.Equal(t, tc.expectedErr, err, "unexpected type of error: %s", err)
 	}
 }
+
+func TestCancelProposal(t *testing.T) {
+	input := getMockApp(t, 2, GenesisState{}, nil)
+
+	header := abci.Header{Height: input.mApp.LastBlockHeight() + 1}
+	input.mApp.BeginBlock(abci.RequestBeginBlock{Header: header})
+
+	ctx := input.mApp.BaseApp.NewContext(false, abci.Header{})
+
+	proposal, err := input.keeper.SubmitProposal(ctx, testProposal(), input.addrs[0], false)
+	require.NoError(t, err)
+	proposalID := proposal.ProposalID
+	require.Equal(t, input.addrs[0], proposal.Proposer)
+
+	fourStake := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.TokensFromConsensusPower(4)))
+	fiveStake := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.TokensFromConsensusPower(5)))
+
+	addr0Initial := input.mApp.AccountKeeper.GetAccount(ctx, input.addrs[0]).GetCoins()
+	addr1Initial := input.mApp.AccountKeeper.GetAccount(ctx, input.addrs[1]).GetCoins()
+	maccInitial := input.keeper.GetGovernanceAccount(ctx).GetCoins()
+
+	err, _ = input.keeper.AddDeposit(ctx, proposalID, input.addrs[0], fourStake)
+	require.Nil(t, err)
+	err, _ = input.keeper.AddDeposit(ctx, proposalID, input.addrs[1], fiveStake)
+	require.Nil(t, err)
+
+	// only the proposer can cancel the proposal
+	err = input.keeper.CancelProposal(ctx, proposalID, input.addrs[1])
+	require.NotNil(t, err)
+	require.Equal(t, CodeInvalidProposer, err.Code())
+
+	err = input.keeper.CancelProposal(ctx, proposalID, input.addrs[0])
+	require.Nil(t, err)
+
+	_, ok := input.keeper.GetProposal(ctx, proposalID)
+	require.False(t, ok)
+	require.Empty(t, input.keeper.GetDeposits(ctx, proposalID))
+
+	// half of the deposits are burned and the rest is refunded
+	twoStake := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.TokensFromConsensusPower(2)))
+	twoAndHalfStake := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(2500000)))
+	require.Equal(t, addr0Initial.Sub(twoStake), input.mApp.AccountKeeper.GetAccount(ctx, input.addrs[0]).GetCoins())
+	require.Equal(t, addr1Initial.Sub(twoAndHalfStake), input.mApp.AccountKeeper.GetAccount(ctx, input.addrs[1]).GetCoins())
+	require.Equal(t, maccInitial, input.keeper.GetGovernanceAccount(ctx).GetCoins())
+
+	err = input.keeper.CancelProposal(ctx, proposalID, input.addrs[0])
+	require.NotNil(t, err)
+	require.Equal(t, CodeUnknownProposal, err.Code())
+}
+
+func TestCancelFinishedProposal(t *testing.T) {
+	input := getMockApp(t, 1, GenesisState{}, nil)
+
+	header := abci.Header{Height: input.mApp.LastBlockHeight() + 1}
+	input.mApp.BeginBlock(abci.RequestBeginBlock{Header: header})
+
+	ctx := input.mApp.BaseApp.NewContext(false, abci.Header{})
+
+	proposal, err := input.keeper.SubmitProposal(ctx, testProposal(), input.addrs[0], false)
+	require.NoError(t, err)
+	proposal.Status = StatusPassed
+	input.keeper.SetProposal(ctx, proposal)
+
+	err = input.keeper.CancelProposal(ctx, proposal.ProposalID, input.addrs[0])
+	require.NotNil(t, err)
+	require.Equal(t, CodeAlreadyFinishedProposal, err.Code())
+}
+
+func TestExpeditedParamsChanges(t *testing.T) {
+	// a genesis exported before expedited proposals
+	genState := DefaultGenesisState()
+	genState.ExpeditedParams = ExpeditedParams{}
+	input := getMockApp(t, 0, genState, nil)
+
+	header := abci.Header{Height: input.mApp.LastBlockHeight() + 1}
+	input.mApp.BeginBlock(abci.RequestBeginBlock{Header: header})
+	ctx := input.mApp.BaseApp.NewContext(false, abci.Header{})
+
+	// the missing expedited params are derived from the normal ones
+	require.Equal(t, DefaultExpeditedParams(), input.keeper.GetExpeditedParams(ctx))
+
+	hdlr := params.NewParamChangeProposalHandler(input.mApp.ParamsKeeper)
+	change := func(key, value string) sdk.Error {
+		return hdlr(ctx, params.NewParameterChangeProposal("Test", "description",
+			[]params.ParamChange{params.NewParamChange(DefaultParamspace, key, value)}))
+	}
+
+	// derived expedited params follow the changes of the normal ones
+	require.NoError(t, change(string(ParamStoreKeyVotingParams), `{"voting_period": "3600000000000"}`))
+	require.Equal(t, 30*time.Minute, input.keeper.GetExpeditedParams(ctx).VotingPeriod)
+
+	// set expedited params must stay stricter than the normal ones
+	require.Error(t, change(string(ParamStoreKeyExpeditedParams),
+		`{"min_deposit": [{"denom": "`+sdk.DefaultBondDenom+`", "amount": "1"}], "voting_period": "60000000000", "threshold": "0.667"}`))
+	require.NoError(t, change(string(ParamStoreKeyExpeditedParams),
+		`{"min_deposit": [{"denom": "`+sdk.DefaultBondDenom+`", "amount": "50000000"}], "voting_period": "60000000000", "threshold": "0.667"}`))
+	require.Equal(t, time.Minute, input.keeper.GetExpeditedParams(ctx).VotingPeriod)
+
+	require.Error(t, change(string(ParamStoreKeyVotingParams), `{"voting_period": "30000000000"}`))
+	require.Error(t, change(string(ParamStoreKeyTallyParams), `{"threshold": "0.7"}`))
+	require.Error(t, change(string(ParamStoreKeyDepositParams),
+		`{"min_deposit": [{"denom": "`+sdk.DefaultBondDenom+`", "amount": "60000000"}]}`))
+	require.Equal(t, time.Hour, input.keeper.GetVotingParams(ctx).VotingPeriod)
+}
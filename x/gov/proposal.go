@@ -7,8 +7,11 @@ import (
 	"github.com/shinecloudfoundation/shinecloudnet/x/gov/types"
 )
 
-// SubmitProposal create new proposal given a content
-func (keeper Keeper) SubmitProposal(ctx sdk.Context, content Content) (Proposal, sdk.Error) {
+// SubmitProposal create new proposal given a content. An expedited proposal
+// needs the expedited min deposit to enter its shorter voting period.
+func (keeper Keeper) SubmitProposal(ctx sdk.Context, content Content, proposer sdk.AccAddress,
+	isExpedited bool) (Proposal, sdk.Error) {
+
 	if !keeper.router.HasRoute(content.ProposalRoute()) {
 		return Proposal{}, ErrNoProposalHandlerExists(keeper.codespace, content)
 	}
@@ -30,7 +33,7 @@ func (keeper Keeper) SubmitProposal(ctx sdk.Context, content Content) (Proposal,
 	submitTime := ctx.BlockHeader().Time
 	depositPeriod := keeper.GetDepositParams(ctx).MaxDepositPeriod
 
-	proposal := NewProposal(content, proposalID, submitTime, submitTime.Add(depositPeriod))
+	proposal := NewProposal(content, proposalID, proposer, submitTime, submitTime.Add(depositPeriod), isExpedited)
 
 	keeper.SetProposal(ctx, proposal)
 	keeper.InsertInactiveProposalQueue(ctx, proposalID, proposal.DepositEndTime)
@@ -64,6 +67,35 @@ func (keeper Keeper) SetProposal(ctx sdk.Context, proposal Proposal) {
 	store.Set(ProposalKey(proposal.ProposalID), bz)
 }
 
+// CancelProposal removes a proposal in deposit or voting period on behalf of
+// its proposer. The cancel ratio of the deposits is burned and the rest is
+// refunded to the depositors.
+func (keeper Keeper) CancelProposal(ctx sdk.Context, proposalID uint64, proposer sdk.AccAddress) sdk.Error {
+	proposal, ok := keeper.GetProposal(ctx, proposalID)
+	if !ok {
+		return ErrUnknownProposal(keeper.codespace, proposalID)
+	}
+	if proposal.Status != StatusDepositPeriod && proposal.Status != StatusVotingPeriod {
+		return ErrAlreadyFinishedProposal(keeper.codespace, proposalID)
+	}
+	if !proposal.Proposer.Equals(proposer) {
+		return ErrInvalidProposer(keeper.codespace, proposalID, proposer)
+	}
+
+	keeper.BurnAndRefundDeposits(ctx, proposalID, keeper.GetBurnParams(ctx).ProposalCancelRatio)
+	keeper.deleteVotes(ctx, proposalID)
+	keeper.DeleteProposal(ctx, proposalID)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCancelProposal,
+			sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", proposalID)),
+		),
+	)
+
+	return nil
+}
+
 // DeleteProposal deletes a proposal from store
 func (keeper Keeper) DeleteProposal(ctx sdk.Context, proposalID uint64) {
 	store := ctx.KVStore(keeper.storeKey)
@@ -152,8 +184,7 @@ func (keeper Keeper) setProposalID(ctx sdk.Context, proposalID uint64) {
 
 func (keeper Keeper) activateVotingPeriod(ctx sdk.Context, proposal Proposal) {
 	proposal.VotingStartTime = ctx.BlockHeader().Time
-	votingPeriod := keeper.GetVotingParams(ctx).VotingPeriod
-	proposal.VotingEndTime = proposal.VotingStartTime.Add(votingPeriod)
+	proposal.VotingEndTime = proposal.VotingStartTime.Add(keeper.getVotingPeriod(ctx, proposal))
 	proposal.Status = StatusVotingPeriod
 	keeper.SetProposal(ctx, proposal)
 
@@ -55,6 +55,18 @@ func queryParams(ctx sdk.Context, path []string, req abci.RequestQuery, keeper K
 			return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
 		}
 		return bz, nil
+	case ParamExpedited:
+		bz, err := codec.MarshalJSONIndent(keeper.cdc, keeper.GetExpeditedParams(ctx))
+		if err != nil {
+			return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+		}
+		return bz, nil
+	case ParamBurn:
+		bz, err := codec.MarshalJSONIndent(keeper.cdc, keeper.GetBurnParams(ctx))
+		if err != nil {
+			return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+		}
+		return bz, nil
 	default:
 		return nil, sdk.ErrUnknownRequest(fmt.Sprintf("%s is not a valid query request path", req.Path))
 	}
@@ -8,15 +8,15 @@ import (
 
 // validatorGovInfo used for tallying
 type validatorGovInfo struct {
-	Address             sdk.ValAddress // address of the validator operator
-	BondedTokens        sdk.Int        // Power of a Validator
-	DelegatorShares     sdk.Dec        // Total outstanding delegator shares
-	DelegatorDeductions sdk.Dec        // Delegator deductions from validator's delegators voting independently
-	Vote                VoteOption     // Vote of the validator
+	Address             sdk.ValAddress      // address of the validator operator
+	BondedTokens        sdk.Int             // Power of a Validator
+	DelegatorShares     sdk.Dec             // Total outstanding delegator shares
+	DelegatorDeductions sdk.Dec             // Delegator deductions from validator's delegators voting independently
+	Vote                WeightedVoteOptions // Vote of the validator
 }
 
 func newValidatorGovInfo(address sdk.ValAddress, bondedTokens sdk.Int, delegatorShares,
-	delegatorDeductions sdk.Dec, vote VoteOption) validatorGovInfo {
+	delegatorDeductions sdk.Dec, vote WeightedVoteOptions) validatorGovInfo {
 
 	return validatorGovInfo{
 		Address:             address,
@@ -45,7 +45,7 @@ func tally(ctx sdk.Context, keeper Keeper, proposal Proposal) (passes bool, burn
 			validator.GetBondedTokens(),
 			validator.GetDelegatorShares(),
 			sdk.ZeroDec(),
-			OptionEmpty,
+			nil,
 		)
 
 		return false
@@ -56,7 +56,7 @@ func tally(ctx sdk.Context, keeper Keeper, proposal Proposal) (passes bool, burn
 		// if delegator tally voting power
 		valAddrStr := sdk.ValAddress(vote.Voter).String()
 		if val, ok := currValidators[valAddrStr]; ok {
-			val.Vote = vote.Option
+			val.Vote = vote.GetOptions()
 			currValidators[valAddrStr] = val
 		} else {
 			// iterate over all delegations from voter, deduct from any delegated-to validators
@@ -70,7 +70,9 @@ func tally(ctx sdk.Context, keeper Keeper, proposal Proposal) (passes bool, burn
 					delegatorShare := delegation.GetShares().Quo(val.DelegatorShares)
 					votingPower := delegatorShare.MulInt(val.BondedTokens)
 
-					results[vote.Option] = results[vote.Option].Add(votingPower)
+					for _, option := range vote.GetOptions() {
+						results[option.Option] = results[option.Option].Add(votingPower.Mul(option.Weight))
+					}
 					totalVotingPower = totalVotingPower.Add(votingPower)
 				}
 
@@ -78,13 +80,12 @@ func tally(ctx sdk.Context, keeper Keeper, proposal Proposal) (passes bool, burn
 			})
 		}
 
-		keeper.deleteVote(ctx, vote.ProposalID, vote.Voter)
 		return false
 	})
 
 	// iterate over the validators again to tally their voting power
 	for _, val := range currValidators {
-		if val.Vote == OptionEmpty {
+		if len(val.Vote) == 0 {
 			continue
 		}
 
@@ -92,11 +93,17 @@ func tally(ctx sdk.Context, keeper Keeper, proposal Proposal) (passes bool, burn
 		fractionAfterDeductions := sharesAfterDeductions.Quo(val.DelegatorShares)
 		votingPower := fractionAfterDeductions.MulInt(val.BondedTokens)
 
-		results[val.Vote] = results[val.Vote].Add(votingPower)
+		for _, option := range val.Vote {
+			results[option.Option] = results[option.Option].Add(votingPower.Mul(option.Weight))
+		}
 		totalVotingPower = totalVotingPower.Add(votingPower)
 	}
 
 	tallyParams := keeper.GetTallyParams(ctx)
+	threshold := tallyParams.Threshold
+	if proposal.IsExpedited {
+		threshold = keeper.GetExpeditedParams(ctx).Threshold
+	}
 	tallyResults = NewTallyResultFromMap(results)
 
 	// TODO: Upgrade the spec to cover all of these cases & remove pseudocode.
@@ -105,10 +112,11 @@ func tally(ctx sdk.Context, keeper Keeper, proposal Proposal) (passes bool, burn
 		return false, false, tallyResults
 	}
 
-	// If there is not enough quorum of votes, the proposal fails
+	// If there is not enough quorum of votes, the proposal fails and its
+	// deposits are burned if the burn params say so
 	percentVoting := totalVotingPower.Quo(keeper.sk.TotalBondedTokens(ctx).ToDec())
 	if percentVoting.LT(tallyParams.Quorum) {
-		return false, true, tallyResults
+		return false, keeper.GetBurnParams(ctx).BurnVoteQuorum, tallyResults
 	}
 
 	// If no one votes (everyone abstains), proposal fails
@@ -121,8 +129,9 @@ func tally(ctx sdk.Context, keeper Keeper, proposal Proposal) (passes bool, burn
 		return false, true, tallyResults
 	}
 
-	// If more than 1/2 of non-abstaining voters vote Yes, proposal passes
-	if results[OptionYes].Quo(totalVotingPower.Sub(results[OptionAbstain])).GT(tallyParams.Threshold) {
+	// If more than the threshold of non-abstaining voters vote Yes, proposal
+	// passes. Expedited proposals need the higher expedited threshold.
+	if results[OptionYes].Quo(totalVotingPower.Sub(results[OptionAbstain])).GT(threshold) {
 		return true, false, tallyResults
 	}
 
@@ -30,7 +30,7 @@ func TestTallyNoOneVotes(t *testing.T) {
 	staking.EndBlocker(ctx, input.sk)
 
 	tp := testProposal()
-	proposal, err := input.keeper.SubmitProposal(ctx, tp)
+	proposal, err := input.keeper.SubmitProposal(ctx, tp, input.addrs[0], false)
 	require.NoError(t, err)
 	proposalID := proposal.ProposalID
 	proposal.Status = StatusVotingPeriod
@@ -63,7 +63,7 @@ func TestTallyNoQuorum(t *testing.T) {
 	staking.EndBlocker(ctx, input.sk)
 
 	tp := testProposal()
-	proposal, err := input.keeper.SubmitProposal(ctx, tp)
+	proposal, err := input.keeper.SubmitProposal(ctx, tp, input.addrs[0], false)
 	require.NoError(t, err)
 	proposalID := proposal.ProposalID
 	proposal.Status = StatusVotingPeriod
@@ -77,6 +77,15 @@ func TestTallyNoQuorum(t *testing.T) {
 	passes, burnDeposits, _ := tally(ctx, input.keeper, proposal)
 	require.False(t, passes)
 	require.True(t, burnDeposits)
+
+	// the deposits are refunded if the burn params say so
+	burnParams := input.keeper.GetBurnParams(ctx)
+	burnParams.BurnVoteQuorum = false
+	input.keeper.SetBurnParams(ctx, burnParams)
+
+	passes, burnDeposits, _ = tally(ctx, input.keeper, proposal)
+	require.False(t, passes)
+	require.False(t, burnDeposits)
 }
 
 func TestTallyOnlyValidatorsAllYes(t *testing.T) {
@@ -97,7 +106,7 @@ func TestTallyOnlyValidatorsAllYes(t *testing.T) {
 	staking.EndBlocker(ctx, input.sk)
 
 	tp := testProposal()
-	proposal, err := input.keeper.SubmitProposal(ctx, tp)
+	proposal, err := input.keeper.SubmitProposal(ctx, tp, input.addrs[0], false)
 	require.NoError(t, err)
 	proposalID := proposal.ProposalID
 	proposal.Status = StatusVotingPeriod
@@ -135,7 +144,7 @@ func TestTallyOnlyValidators51No(t *testing.T) {
 	staking.EndBlocker(ctx, input.sk)
 
 	tp := testProposal()
-	proposal, err := input.keeper.SubmitProposal(ctx, tp)
+	proposal, err := input.keeper.SubmitProposal(ctx, tp, input.addrs[0], false)
 	require.NoError(t, err)
 	proposalID := proposal.ProposalID
 	proposal.Status = StatusVotingPeriod
@@ -172,7 +181,7 @@ func TestTallyOnlyValidators51Yes(t *testing.T) {
 	staking.EndBlocker(ctx, input.sk)
 
 	tp := testProposal()
-	proposal, err := input.keeper.SubmitProposal(ctx, tp)
+	proposal, err := input.keeper.SubmitProposal(ctx, tp, input.addrs[0], false)
 	require.NoError(t, err)
 	proposalID := proposal.ProposalID
 	proposal.Status = StatusVotingPeriod
@@ -212,7 +221,7 @@ func TestTallyOnlyValidatorsVetoed(t *testing.T) {
 	staking.EndBlocker(ctx, input.sk)
 
 	tp := testProposal()
-	proposal, err := input.keeper.SubmitProposal(ctx, tp)
+	proposal, err := input.keeper.SubmitProposal(ctx, tp, input.addrs[0], false)
 	require.NoError(t, err)
 	proposalID := proposal.ProposalID
 	proposal.Status = StatusVotingPeriod
@@ -253,7 +262,7 @@ func TestTallyOnlyValidatorsAbstainPasses(t *testing.T) {
 	staking.EndBlocker(ctx, input.sk)
 
 	tp := testProposal()
-	proposal, err := input.keeper.SubmitProposal(ctx, tp)
+	proposal, err := input.keeper.SubmitProposal(ctx, tp, input.addrs[0], false)
 	require.NoError(t, err)
 	proposalID := proposal.ProposalID
 	proposal.Status = StatusVotingPeriod
@@ -293,7 +302,7 @@ func TestTallyOnlyValidatorsAbstainFails(t *testing.T) {
 	staking.EndBlocker(ctx, input.sk)
 
 	tp := testProposal()
-	proposal, err := input.keeper.SubmitProposal(ctx, tp)
+	proposal, err := input.keeper.SubmitProposal(ctx, tp, input.addrs[0], false)
 	require.NoError(t, err)
 	proposalID := proposal.ProposalID
 	proposal.Status = StatusVotingPeriod
@@ -333,7 +342,7 @@ func TestTallyOnlyValidatorsNonVoter(t *testing.T) {
 	staking.EndBlocker(ctx, input.sk)
 
 	tp := testProposal()
-	proposal, err := input.keeper.SubmitProposal(ctx, tp)
+	proposal, err := input.keeper.SubmitProposal(ctx, tp, input.addrs[0], false)
 	require.NoError(t, err)
 	proposalID := proposal.ProposalID
 	proposal.Status = StatusVotingPeriod
@@ -375,7 +384,7 @@ func TestTallyDelgatorOverride(t *testing.T) {
 	stakingHandler(ctx, delegator1Msg)
 
 	tp := testProposal()
-	proposal, err := input.keeper.SubmitProposal(ctx, tp)
+	proposal, err := input.keeper.SubmitProposal(ctx, tp, input.addrs[0], false)
 	require.NoError(t, err)
 	proposalID := proposal.ProposalID
 	proposal.Status = StatusVotingPeriod
@@ -421,7 +430,7 @@ func TestTallyDelgatorInherit(t *testing.T) {
 	stakingHandler(ctx, delegator1Msg)
 
 	tp := testProposal()
-	proposal, err := input.keeper.SubmitProposal(ctx, tp)
+	proposal, err := input.keeper.SubmitProposal(ctx, tp, input.addrs[0], false)
 	require.NoError(t, err)
 	proposalID := proposal.ProposalID
 	proposal.Status = StatusVotingPeriod
@@ -467,7 +476,7 @@ func TestTallyDelgatorMultipleOverride(t *testing.T) {
 	stakingHandler(ctx, delegator1Msg2)
 
 	tp := testProposal()
-	proposal, err := input.keeper.SubmitProposal(ctx, tp)
+	proposal, err := input.keeper.SubmitProposal(ctx, tp, input.addrs[0], false)
 	require.NoError(t, err)
 	proposalID := proposal.ProposalID
 	proposal.Status = StatusVotingPeriod
@@ -528,7 +537,7 @@ func TestTallyDelgatorMultipleInherit(t *testing.T) {
 	staking.EndBlocker(ctx, input.sk)
 
 	tp := testProposal()
-	proposal, err := input.keeper.SubmitProposal(ctx, tp)
+	proposal, err := input.keeper.SubmitProposal(ctx, tp, input.addrs[0], false)
 	require.NoError(t, err)
 	proposalID := proposal.ProposalID
 	proposal.Status = StatusVotingPeriod
@@ -581,7 +590,7 @@ func TestTallyJailedValidator(t *testing.T) {
 	staking.EndBlocker(ctx, input.sk)
 
 	tp := testProposal()
-	proposal, err := input.keeper.SubmitProposal(ctx, tp)
+	proposal, err := input.keeper.SubmitProposal(ctx, tp, input.addrs[0], false)
 	require.NoError(t, err)
 	proposalID := proposal.ProposalID
 	proposal.Status = StatusVotingPeriod
@@ -602,3 +611,88 @@ func TestTallyJailedValidator(t *testing.T) {
 	require.False(t, burnDeposits)
 	require.False(t, tallyResults.Equals(EmptyTallyResult()))
 }
+
+func TestTallyWeightedVotes(t *testing.T) {
+	input := getMockApp(t, 10, GenesisState{}, nil)
+
+	header := abci.Header{Height: input.mApp.LastBlockHeight() + 1}
+	input.mApp.BeginBlock(abci.RequestBeginBlock{Header: header})
+
+	ctx := input.mApp.BaseApp.NewContext(false, abci.Header{})
+	stakingHandler := staking.NewHandler(input.sk)
+
+	valAddrs := make([]sdk.ValAddress, len(input.addrs[:3]))
+	for i, addr := range input.addrs[:3] {
+		valAddrs[i] = sdk.ValAddress(addr)
+	}
+
+	createValidators(t, stakingHandler, ctx, valAddrs, []int64{5, 6, 7})
+	staking.EndBlocker(ctx, input.sk)
+
+	delTokens := sdk.TokensFromConsensusPower(30)
+	delegator1Msg := staking.NewMsgDelegate(input.addrs[3], sdk.ValAddress(input.addrs[2]), sdk.NewCoin(sdk.DefaultBondDenom, delTokens))
+	stakingHandler(ctx, delegator1Msg)
+
+	tp := testProposal()
+	proposal, err := input.keeper.SubmitProposal(ctx, tp, input.addrs[0], false)
+	require.NoError(t, err)
+	proposalID := proposal.ProposalID
+	proposal.Status = StatusVotingPeriod
+	input.keeper.SetProposal(ctx, proposal)
+
+	// validator 0 splits its 5 powers into 2 Yes and 3 No
+	err = input.keeper.AddWeightedVote(ctx, proposalID, input.addrs[0], WeightedVoteOptions{
+		NewWeightedVoteOption(OptionYes, sdk.NewDecWithPrec(4, 1)),
+		NewWeightedVoteOption(OptionNo, sdk.NewDecWithPrec(6, 1)),
+	})
+	require.Nil(t, err)
+	err = input.keeper.AddVote(ctx, proposalID, input.addrs[1], OptionYes)
+	require.Nil(t, err)
+	// the delegator of validator 2 overrides it and splits its 30 powers evenly
+	err = input.keeper.AddWeightedVote(ctx, proposalID, input.addrs[3], WeightedVoteOptions{
+		NewWeightedVoteOption(OptionYes, sdk.NewDecWithPrec(5, 1)),
+		NewWeightedVoteOption(OptionNoWithVeto, sdk.NewDecWithPrec(5, 1)),
+	})
+	require.Nil(t, err)
+
+	proposal, ok := input.keeper.GetProposal(ctx, proposalID)
+	require.True(t, ok)
+	passes, burnDeposits, tallyResults := tally(ctx, input.keeper, proposal)
+
+	expected := NewTallyResult(
+		sdk.TokensFromConsensusPower(2+6+15),
+		sdk.ZeroInt(),
+		sdk.TokensFromConsensusPower(3),
+		sdk.TokensFromConsensusPower(15),
+	)
+	require.True(t, tallyResults.Equals(expected), tallyResults.String())
+
+	// 15 of the 41 voting powers veto
+	require.False(t, passes)
+	require.True(t, burnDeposits)
+}
+
+func TestAddWeightedVoteInvalid(t *testing.T) {
+	input := getMockApp(t, 10, GenesisState{}, nil)
+
+	header := abci.Header{Height: input.mApp.LastBlockHeight() + 1}
+	input.mApp.BeginBlock(abci.RequestBeginBlock{Header: header})
+
+	ctx := input.mApp.BaseApp.NewContext(false, abci.Header{})
+
+	tp := testProposal()
+	proposal, err := input.keeper.SubmitProposal(ctx, tp, input.addrs[0], false)
+	require.NoError(t, err)
+	proposalID := proposal.ProposalID
+	proposal.Status = StatusVotingPeriod
+	input.keeper.SetProposal(ctx, proposal)
+
+	err = input.keeper.AddWeightedVote(ctx, proposalID, input.addrs[0], WeightedVoteOptions{
+		NewWeightedVoteOption(OptionYes, sdk.NewDecWithPrec(5, 1)),
+		NewWeightedVoteOption(OptionNo, sdk.NewDecWithPrec(4, 1)),
+	})
+	require.NotNil(t, err)
+
+	_, found := input.keeper.GetVote(ctx, proposalID, input.addrs[0])
+	require.False(t, found)
+}
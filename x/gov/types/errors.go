@@ -21,6 +21,8 @@ const (
 	CodeInvalidGenesis           sdk.CodeType = 9
 	CodeInvalidProposalStatus    sdk.CodeType = 10
 	CodeProposalHandlerNotExists sdk.CodeType = 11
+	CodeInvalidWeightedVote      sdk.CodeType = 12
+	CodeInvalidProposer          sdk.CodeType = 13
 )
 
 func ErrUnknownProposal(codespace sdk.CodespaceType, proposalID uint64) sdk.Error {
@@ -55,6 +57,14 @@ func ErrInvalidVote(codespace sdk.CodespaceType, voteOption VoteOption) sdk.Erro
 	return sdk.NewError(codespace, CodeInvalidVote, fmt.Sprintf("'%v' is not a valid voting option", voteOption.String()))
 }
 
+func ErrInvalidWeightedVote(codespace sdk.CodespaceType, msg string) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidWeightedVote, fmt.Sprintf("invalid weighted vote: %s", msg))
+}
+
+func ErrInvalidProposer(codespace sdk.CodespaceType, proposalID uint64, proposer sdk.AccAddress) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidProposer, fmt.Sprintf("%s isn't the proposer of proposal %d", proposer, proposalID))
+}
+
 func ErrInvalidGenesis(codespace sdk.CodespaceType, msg string) sdk.Error {
 	return sdk.NewError(codespace, CodeInvalidVote, msg)
 }
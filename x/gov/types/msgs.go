@@ -10,20 +10,23 @@ import (
 const (
 	TypeMsgDeposit        = "deposit"
 	TypeMsgVote           = "vote"
+	TypeMsgVoteWeighted   = "weighted_vote"
 	TypeMsgSubmitProposal = "submit_proposal"
+	TypeMsgCancelProposal = "cancel_proposal"
 )
 
-var _, _, _ sdk.Msg = MsgSubmitProposal{}, MsgDeposit{}, MsgVote{}
+var _, _, _, _, _ sdk.Msg = MsgSubmitProposal{}, MsgDeposit{}, MsgVote{}, MsgVoteWeighted{}, MsgCancelProposal{}
 
 // MsgSubmitProposal
 type MsgSubmitProposal struct {
 	Content        Content        `json:"content" yaml:"content"`
-	InitialDeposit sdk.Coins      `json:"initial_deposit" yaml:"initial_deposit"` //  Initial deposit paid by sender. Must be strictly positive
-	Proposer       sdk.AccAddress `json:"proposer" yaml:"proposer"`               //  Address of the proposer
+	InitialDeposit sdk.Coins      `json:"initial_deposit" yaml:"initial_deposit"`     //  Initial deposit paid by sender. Must be strictly positive
+	Proposer       sdk.AccAddress `json:"proposer" yaml:"proposer"`                   //  Address of the proposer
+	IsExpedited    bool           `json:"is_expedited,omitempty" yaml:"is_expedited"` //  Whether the proposal is expedited. Omitted when false to keep the sign bytes of normal proposals
 }
 
-func NewMsgSubmitProposal(content Content, initialDeposit sdk.Coins, proposer sdk.AccAddress) MsgSubmitProposal {
-	return MsgSubmitProposal{content, initialDeposit, proposer}
+func NewMsgSubmitProposal(content Content, initialDeposit sdk.Coins, proposer sdk.AccAddress, isExpedited bool) MsgSubmitProposal {
+	return MsgSubmitProposal{content, initialDeposit, proposer, isExpedited}
 }
 
 //nolint
@@ -61,7 +64,8 @@ func (msg MsgSubmitProposal) String() string {
 	return fmt.Sprintf(`Submit Proposal Message:
   Content:         %s
   Initial Deposit: %s
-`, msg.Content.String(), msg.InitialDeposit)
+  Expedited:       %t
+`, msg.Content.String(), msg.InitialDeposit, msg.IsExpedited)
 }
 
 // Implements Msg.
@@ -170,3 +174,91 @@ func (msg MsgVote) GetSignBytes() []byte {
 func (msg MsgVote) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{msg.Voter}
 }
+
+// MsgVoteWeighted
+type MsgVoteWeighted struct {
+	ProposalID uint64              `json:"proposal_id" yaml:"proposal_id"` // ID of the proposal
+	Voter      sdk.AccAddress      `json:"voter" yaml:"voter"`             //  address of the voter
+	Options    WeightedVoteOptions `json:"options" yaml:"options"`         //  weighted options the voter splits their voting power across
+}
+
+func NewMsgVoteWeighted(voter sdk.AccAddress, proposalID uint64, options WeightedVoteOptions) MsgVoteWeighted {
+	return MsgVoteWeighted{proposalID, voter, options}
+}
+
+// Implements Msg.
+// nolint
+func (msg MsgVoteWeighted) Route() string { return RouterKey }
+func (msg MsgVoteWeighted) Type() string  { return TypeMsgVoteWeighted }
+
+// Implements Msg.
+func (msg MsgVoteWeighted) ValidateBasic() sdk.Error {
+	if msg.Voter.Empty() {
+		return sdk.ErrInvalidAddress(msg.Voter.String())
+	}
+	if err := ValidWeightedVoteOptions(msg.Options); err != nil {
+		return ErrInvalidWeightedVote(DefaultCodespace, err.Error())
+	}
+
+	return nil
+}
+
+func (msg MsgVoteWeighted) String() string {
+	return fmt.Sprintf(`Weighted Vote Message:
+  Proposal ID: %d
+  Options:     %s
+`, msg.ProposalID, msg.Options)
+}
+
+// Implements Msg.
+func (msg MsgVoteWeighted) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// Implements Msg.
+func (msg MsgVoteWeighted) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Voter}
+}
+
+// MsgCancelProposal
+type MsgCancelProposal struct {
+	ProposalID uint64         `json:"proposal_id" yaml:"proposal_id"` // ID of the proposal
+	Proposer   sdk.AccAddress `json:"proposer" yaml:"proposer"`       //  Address of the proposer
+}
+
+func NewMsgCancelProposal(proposer sdk.AccAddress, proposalID uint64) MsgCancelProposal {
+	return MsgCancelProposal{proposalID, proposer}
+}
+
+// Implements Msg.
+// nolint
+func (msg MsgCancelProposal) Route() string { return RouterKey }
+func (msg MsgCancelProposal) Type() string  { return TypeMsgCancelProposal }
+
+// Implements Msg.
+func (msg MsgCancelProposal) ValidateBasic() sdk.Error {
+	if msg.Proposer.Empty() {
+		return sdk.ErrInvalidAddress(msg.Proposer.String())
+	}
+
+	return nil
+}
+
+func (msg MsgCancelProposal) String() string {
+	return fmt.Sprintf(`Cancel Proposal Message:
+  Proposal ID: %d
+  Proposer:    %s
+`, msg.ProposalID, msg.Proposer)
+}
+
+// Implements Msg.
+func (msg MsgCancelProposal) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// Implements Msg.
+func (msg MsgCancelProposal) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Proposer}
+}
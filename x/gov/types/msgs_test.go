@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto"
 
 	sdk "github.com/shinecloudfoundation/shinecloudnet/types"
 )
@@ -49,6 +50,7 @@ func TestMsgSubmitProposal(t *testing.T) {
 			ContentFromProposalType(tc.title, tc.description, tc.proposalType),
 			tc.initialDeposit,
 			tc.proposerAddr,
+			false,
 		)
 
 		if tc.expectPass {
@@ -117,3 +119,61 @@ func TestMsgVote(t *testing.T) {
 		}
 	}
 }
+
+// test ValidateBasic for MsgVoteWeighted
+func TestMsgVoteWeighted(t *testing.T) {
+	half := sdk.NewDecWithPrec(5, 1)
+
+	tests := []struct {
+		voterAddr  sdk.AccAddress
+		options    WeightedVoteOptions
+		expectPass bool
+	}{
+		{addrs[0], NewNonSplitVoteOption(OptionYes), true},
+		{addrs[0], WeightedVoteOptions{NewWeightedVoteOption(OptionYes, half), NewWeightedVoteOption(OptionNo, half)}, true},
+		{sdk.AccAddress{}, NewNonSplitVoteOption(OptionYes), false},
+		{addrs[0], WeightedVoteOptions{}, false},
+		{addrs[0], NewNonSplitVoteOption(VoteOption(0x13)), false},
+		{addrs[0], WeightedVoteOptions{NewWeightedVoteOption(OptionYes, half), NewWeightedVoteOption(OptionYes, half)}, false},
+		{addrs[0], WeightedVoteOptions{NewWeightedVoteOption(OptionYes, half)}, false},
+		{addrs[0], WeightedVoteOptions{NewWeightedVoteOption(OptionYes, sdk.NewDec(2)), NewWeightedVoteOption(OptionNo, sdk.NewDec(-1))}, false},
+		{addrs[0], WeightedVoteOptions{NewWeightedVoteOption(OptionYes, sdk.OneDec()), NewWeightedVoteOption(OptionNo, sdk.ZeroDec())}, false},
+	}
+
+	for i, tc := range tests {
+		msg := NewMsgVoteWeighted(tc.voterAddr, 0, tc.options)
+		if tc.expectPass {
+			require.Nil(t, msg.ValidateBasic(), "test: %v", i)
+		} else {
+			require.NotNil(t, msg.ValidateBasic(), "test: %v", i)
+		}
+	}
+}
+
+func TestVoteLegacyOption(t *testing.T) {
+	half := sdk.NewDecWithPrec(5, 1)
+	voter := sdk.AccAddress(crypto.AddressHash([]byte("voter")))
+
+	vote := NewVote(1, voter, OptionNo)
+	require.Equal(t, OptionNo, vote.Option)
+	require.Equal(t, "No", vote.GetOptions().String())
+
+	split := WeightedVoteOptions{NewWeightedVoteOption(OptionYes, half), NewWeightedVoteOption(OptionNo, half)}
+	vote = NewWeightedVote(1, voter, split)
+	require.Equal(t, OptionEmpty, vote.Option)
+
+	// split votes round trip through JSON despite their empty legacy option
+	var decoded Vote
+	require.NoError(t, ModuleCdc.UnmarshalJSON(ModuleCdc.MustMarshalJSON(vote), &decoded))
+	require.True(t, vote.Equals(decoded))
+
+	// votes stored before weighted votes were introduced only hold an option
+	legacy := Vote{ProposalID: 1, Voter: voter, Option: OptionYes}
+	require.True(t, legacy.GetOptions().Equals(NewNonSplitVoteOption(OptionYes)))
+}
+
+// test ValidateBasic for MsgCancelProposal
+func TestMsgCancelProposal(t *testing.T) {
+	require.Nil(t, NewMsgCancelProposal(addrs[0], 1).ValidateBasic())
+	require.NotNil(t, NewMsgCancelProposal(sdk.AccAddress{}, 1).ValidateBasic())
+}
@@ -13,15 +13,24 @@ var (
 	ParamStoreKeyDepositParams = []byte("depositparams")
 	ParamStoreKeyVotingParams  = []byte("votingparams")
 	ParamStoreKeyTallyParams   = []byte("tallyparams")
+
+	ParamStoreKeyExpeditedParams = []byte("expeditedparams")
+	ParamStoreKeyBurnParams      = []byte("burnparams")
+)
+
+// Ratios used to derive the expedited params of chains that never set them
+// from the params of normal proposals
+const (
+	ExpeditedMinDepositMultiplier = 5
+	ExpeditedVotingPeriodDivisor  = 2
 )
 
+// Lowest threshold of derived expedited params
+var DefaultExpeditedThreshold = sdk.NewDecWithPrec(667, 3)
+
 // Key declaration for parameters
 func ParamKeyTable() params.KeyTable {
-	return params.NewKeyTable(
-		ParamStoreKeyDepositParams, DepositParams{},
-		ParamStoreKeyVotingParams, VotingParams{},
-		ParamStoreKeyTallyParams, TallyParams{},
-	)
+	return params.NewKeyTable().RegisterParamSet(&Params{})
 }
 
 // Param around deposits for governance
@@ -90,22 +99,166 @@ func (vp VotingParams) String() string {
   Voting Period:      %s`, vp.VotingPeriod)
 }
 
+// Param around expedited proposals, which need a higher deposit to enter a
+// shorter voting period and a higher threshold to pass
+type ExpeditedParams struct {
+	MinDeposit   sdk.Coins     `json:"min_deposit,omitempty" yaml:"min_deposit,omitempty"`     //  Minimum deposit for an expedited proposal to enter voting period.
+	VotingPeriod time.Duration `json:"voting_period,omitempty" yaml:"voting_period,omitempty"` //  Length of the voting period of expedited proposals.
+	Threshold    sdk.Dec       `json:"threshold,omitempty" yaml:"threshold,omitempty"`         //  Minimum proportion of Yes votes for an expedited proposal to pass. Initial value: 0.667
+}
+
+// NewExpeditedParams creates a new ExpeditedParams object
+func NewExpeditedParams(minDeposit sdk.Coins, votingPeriod time.Duration, threshold sdk.Dec) ExpeditedParams {
+	return ExpeditedParams{
+		MinDeposit:   minDeposit,
+		VotingPeriod: votingPeriod,
+		Threshold:    threshold,
+	}
+}
+
+// DeriveExpeditedParams returns the expedited params of chains that never set
+// them: a five times higher min deposit, half the voting period and a
+// threshold of 0.667, or halfway between the threshold and one if higher
+func DeriveExpeditedParams(dp DepositParams, vp VotingParams, tp TallyParams) ExpeditedParams {
+	minDeposit := make(sdk.Coins, len(dp.MinDeposit))
+	for i, coin := range dp.MinDeposit {
+		minDeposit[i] = sdk.NewCoin(coin.Denom, coin.Amount.MulRaw(ExpeditedMinDepositMultiplier))
+	}
+
+	threshold := DefaultExpeditedThreshold
+	if !tp.Threshold.IsNil() && tp.Threshold.GTE(threshold) {
+		threshold = tp.Threshold.Add(sdk.OneDec()).QuoInt64(2)
+	}
+	return NewExpeditedParams(minDeposit, vp.VotingPeriod/ExpeditedVotingPeriodDivisor, threshold)
+}
+
+// IsEmpty returns true for expedited params that were never set, which are
+// derived from the params of normal proposals instead
+func (ep ExpeditedParams) IsEmpty() bool {
+	return ep.MinDeposit.Empty() && ep.VotingPeriod == 0 && ep.Threshold.IsNil()
+}
+
+func (ep ExpeditedParams) String() string {
+	return fmt.Sprintf(`Expedited Params:
+  Min Deposit:        %s
+  Voting Period:      %s
+  Threshold:          %s`, ep.MinDeposit, ep.VotingPeriod, ep.Threshold)
+}
+
+// Validate checks that the expedited params are stricter than the params of
+// normal proposals: a higher deposit, a shorter voting period and a higher
+// threshold
+func (ep ExpeditedParams) Validate(dp DepositParams, vp VotingParams, tp TallyParams) error {
+	if !ep.MinDeposit.IsValid() || !ep.MinDeposit.IsAllGT(dp.MinDeposit) {
+		return fmt.Errorf("expedited min deposit must be valid and greater than the min deposit %s, is %s",
+			dp.MinDeposit, ep.MinDeposit)
+	}
+	if ep.VotingPeriod <= 0 || ep.VotingPeriod >= vp.VotingPeriod {
+		return fmt.Errorf("expedited voting period must be positive and shorter than the voting period %s, is %s",
+			vp.VotingPeriod, ep.VotingPeriod)
+	}
+	if ep.Threshold.IsNil() || ep.Threshold.LTE(tp.Threshold) || ep.Threshold.GT(sdk.OneDec()) {
+		return fmt.Errorf("expedited threshold must be greater than the threshold %s and less or equal to one, is %s",
+			tp.Threshold, ep.Threshold)
+	}
+	return nil
+}
+
+// Param around the deposits burned when a proposal is cancelled or fails
+type BurnParams struct {
+	ProposalCancelRatio        sdk.Dec `json:"proposal_cancel_ratio" yaml:"proposal_cancel_ratio"`                 //  Fraction of the deposits burned when the proposer cancels a proposal. Initial value: 0.5
+	BurnVoteQuorum             bool    `json:"burn_vote_quorum" yaml:"burn_vote_quorum"`                           //  Whether the deposits of a proposal that didn't reach quorum are burned
+	BurnProposalDepositPrevote bool    `json:"burn_proposal_deposit_prevote" yaml:"burn_proposal_deposit_prevote"` //  Whether the deposits of a proposal that didn't reach the min deposit are burned
+}
+
+// DefaultBurnParams returns the default deposit burn params
+func DefaultBurnParams() BurnParams {
+	return BurnParams{
+		ProposalCancelRatio:        sdk.NewDecWithPrec(5, 1),
+		BurnVoteQuorum:             true,
+		BurnProposalDepositPrevote: true,
+	}
+}
+
+// NewBurnParams creates a new BurnParams object
+func NewBurnParams(proposalCancelRatio sdk.Dec, burnVoteQuorum, burnProposalDepositPrevote bool) BurnParams {
+	return BurnParams{
+		ProposalCancelRatio:        proposalCancelRatio,
+		BurnVoteQuorum:             burnVoteQuorum,
+		BurnProposalDepositPrevote: burnProposalDepositPrevote,
+	}
+}
+
+func (bp BurnParams) String() string {
+	return fmt.Sprintf(`Burn Params:
+  Proposal Cancel Ratio:         %s
+  Burn Vote Quorum:              %t
+  Burn Proposal Deposit Prevote: %t`, bp.ProposalCancelRatio, bp.BurnVoteQuorum, bp.BurnProposalDepositPrevote)
+}
+
+// Validate checks that the cancel ratio is a fraction
+func (bp BurnParams) Validate() error {
+	if bp.ProposalCancelRatio.IsNil() || bp.ProposalCancelRatio.IsNegative() || bp.ProposalCancelRatio.GT(sdk.OneDec()) {
+		return fmt.Errorf("proposal cancel ratio must be between zero and one, is %s", bp.ProposalCancelRatio)
+	}
+	return nil
+}
+
 // Params returns all of the governance params
 type Params struct {
-	VotingParams  VotingParams  `json:"voting_params" yaml:"voting_params"`
-	TallyParams   TallyParams   `json:"tally_params" yaml:"tally_params"`
-	DepositParams DepositParams `json:"deposit_params" yaml:"deposit_parmas"`
+	VotingParams    VotingParams    `json:"voting_params" yaml:"voting_params"`
+	TallyParams     TallyParams     `json:"tally_params" yaml:"tally_params"`
+	DepositParams   DepositParams   `json:"deposit_params" yaml:"deposit_parmas"`
+	ExpeditedParams ExpeditedParams `json:"expedited_params" yaml:"expedited_params"`
+	BurnParams      BurnParams      `json:"burn_params" yaml:"burn_params"`
+}
+
+var _ params.DefaultedParamSet = &Params{}
+
+// ParamSetPairs implements params.ParamSet
+func (gp *Params) ParamSetPairs() params.ParamSetPairs {
+	return params.ParamSetPairs{
+		{ParamStoreKeyDepositParams, &gp.DepositParams},
+		{ParamStoreKeyVotingParams, &gp.VotingParams},
+		{ParamStoreKeyTallyParams, &gp.TallyParams},
+		{ParamStoreKeyExpeditedParams, &gp.ExpeditedParams},
+		{ParamStoreKeyBurnParams, &gp.BurnParams},
+	}
+}
+
+// ValidateParamSet checks that the expedited params, derived ones included,
+// stay stricter than the params of normal proposals and validates the burn
+// params, it implements params.ValidatedParamSet
+func (gp Params) ValidateParamSet() error {
+	ep := gp.ExpeditedParams
+	if ep.IsEmpty() {
+		ep = DeriveExpeditedParams(gp.DepositParams, gp.VotingParams, gp.TallyParams)
+	}
+	if err := ep.Validate(gp.DepositParams, gp.VotingParams, gp.TallyParams); err != nil {
+		return err
+	}
+	return gp.BurnParams.Validate()
+}
+
+// DefaultParamSet returns the params of the keys that may be missing from the
+// store: derived expedited params and the default burn params, it implements
+// params.DefaultedParamSet
+func (gp Params) DefaultParamSet() params.ValidatedParamSet {
+	return &Params{BurnParams: DefaultBurnParams()}
 }
 
 func (gp Params) String() string {
 	return gp.VotingParams.String() + "\n" +
-		gp.TallyParams.String() + "\n" + gp.DepositParams.String()
+		gp.TallyParams.String() + "\n" + gp.DepositParams.String() + "\n" +
+		gp.ExpeditedParams.String() + "\n" + gp.BurnParams.String()
 }
 
-func NewParams(vp VotingParams, tp TallyParams, dp DepositParams) Params {
+func NewParams(vp VotingParams, tp TallyParams, dp DepositParams, ep ExpeditedParams, bp BurnParams) Params {
 	return Params{
-		VotingParams:  vp,
-		DepositParams: dp,
-		TallyParams:   tp,
+		VotingParams:    vp,
+		DepositParams:   dp,
+		TallyParams:     tp,
+		ExpeditedParams: ep,
+		BurnParams:      bp,
 	}
 }
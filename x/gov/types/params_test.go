@@ -0,0 +1,90 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/shinecloudfoundation/shinecloudnet/types"
+)
+
+func TestExpeditedParamsValidate(t *testing.T) {
+	dp := NewDepositParams(sdk.NewCoins(sdk.NewInt64Coin("stake", 10)), time.Hour)
+	vp := NewVotingParams(time.Hour)
+	tp := NewTallyParams(sdk.NewDecWithPrec(334, 3), sdk.NewDecWithPrec(5, 1), sdk.NewDecWithPrec(334, 3))
+
+	minDeposit := sdk.NewCoins(sdk.NewInt64Coin("stake", 50))
+	threshold := sdk.NewDecWithPrec(667, 3)
+
+	tests := []struct {
+		name       string
+		params     ExpeditedParams
+		expectPass bool
+	}{
+		{"valid", NewExpeditedParams(minDeposit, time.Minute, threshold), true},
+		{"threshold of one", NewExpeditedParams(minDeposit, time.Minute, sdk.OneDec()), true},
+		{"min deposit too low", NewExpeditedParams(dp.MinDeposit, time.Minute, threshold), false},
+		{"min deposit in another denom", NewExpeditedParams(sdk.NewCoins(sdk.NewInt64Coin("atom", 50)), time.Minute, threshold), false},
+		{"zero voting period", NewExpeditedParams(minDeposit, 0, threshold), false},
+		{"voting period too long", NewExpeditedParams(minDeposit, time.Hour, threshold), false},
+		{"threshold too low", NewExpeditedParams(minDeposit, time.Minute, tp.Threshold), false},
+		{"threshold above one", NewExpeditedParams(minDeposit, time.Minute, sdk.NewDecWithPrec(11, 1)), false},
+		{"missing threshold", NewExpeditedParams(minDeposit, time.Minute, sdk.Dec{}), false},
+	}
+
+	for _, tc := range tests {
+		err := tc.params.Validate(dp, vp, tp)
+		if tc.expectPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
+
+func TestBurnParamsValidate(t *testing.T) {
+	require.NoError(t, NewBurnParams(sdk.ZeroDec(), false, false).Validate())
+	require.NoError(t, NewBurnParams(sdk.NewDecWithPrec(5, 1), true, true).Validate())
+	require.NoError(t, NewBurnParams(sdk.OneDec(), true, false).Validate())
+	require.Error(t, NewBurnParams(sdk.NewDecWithPrec(-1, 1), true, true).Validate())
+	require.Error(t, NewBurnParams(sdk.NewDecWithPrec(11, 1), true, true).Validate())
+	require.Error(t, NewBurnParams(sdk.Dec{}, true, true).Validate())
+}
+
+func TestDeriveExpeditedParams(t *testing.T) {
+	dp := NewDepositParams(sdk.NewCoins(sdk.NewInt64Coin("stake", 10)), time.Hour)
+	vp := NewVotingParams(time.Hour)
+	tp := NewTallyParams(sdk.NewDecWithPrec(334, 3), sdk.NewDecWithPrec(5, 1), sdk.NewDecWithPrec(334, 3))
+
+	ep := DeriveExpeditedParams(dp, vp, tp)
+	require.Equal(t, NewExpeditedParams(sdk.NewCoins(sdk.NewInt64Coin("stake", 50)), 30*time.Minute,
+		DefaultExpeditedThreshold), ep)
+	require.NoError(t, ep.Validate(dp, vp, tp))
+	require.False(t, ep.IsEmpty())
+	require.True(t, ExpeditedParams{}.IsEmpty())
+
+	// a threshold above the default one keeps the expedited threshold higher
+	tp.Threshold = sdk.NewDecWithPrec(8, 1)
+	ep = DeriveExpeditedParams(dp, vp, tp)
+	require.Equal(t, sdk.NewDecWithPrec(9, 1), ep.Threshold)
+	require.NoError(t, ep.Validate(dp, vp, tp))
+}
+
+func TestParamsValidateParamSet(t *testing.T) {
+	dp := NewDepositParams(sdk.NewCoins(sdk.NewInt64Coin("stake", 10)), time.Hour)
+	vp := NewVotingParams(time.Hour)
+	tp := NewTallyParams(sdk.NewDecWithPrec(334, 3), sdk.NewDecWithPrec(5, 1), sdk.NewDecWithPrec(334, 3))
+	bp := NewBurnParams(sdk.NewDecWithPrec(5, 1), true, true)
+	ep := NewExpeditedParams(sdk.NewCoins(sdk.NewInt64Coin("stake", 50)), time.Minute, sdk.NewDecWithPrec(667, 3))
+
+	require.NoError(t, NewParams(vp, tp, dp, ep, bp).ValidateParamSet())
+	require.NoError(t, NewParams(vp, tp, dp, ExpeditedParams{}, bp).ValidateParamSet())
+	require.Error(t, NewParams(NewVotingParams(time.Second), tp, dp, ep, bp).ValidateParamSet())
+	require.Error(t, NewParams(vp, tp, dp, ep, BurnParams{}).ValidateParamSet())
+
+	// the defaults of the keys missing from the store
+	defaults := Params{}.DefaultParamSet().(*Params)
+	require.True(t, defaults.ExpeditedParams.IsEmpty())
+	require.Equal(t, DefaultBurnParams(), defaults.BurnParams)
+}
@@ -24,17 +24,25 @@ type Proposal struct {
 
 	VotingStartTime time.Time `json:"voting_start_time" yaml:"voting_start_time"` // Time of the block where MinDeposit was reached. -1 if MinDeposit is not reached
 	VotingEndTime   time.Time `json:"voting_end_time" yaml:"voting_end_time"`     // Time that the VotingPeriod for this proposal will end and votes will be tallied
+
+	IsExpedited bool `json:"is_expedited" yaml:"is_expedited"` // Whether the proposal uses the expedited params. An expedited proposal failing the expedited threshold is converted into a normal one
+
+	Proposer sdk.AccAddress `json:"proposer" yaml:"proposer"` // Address of the proposer, who is allowed to cancel the proposal
 }
 
-func NewProposal(content Content, id uint64, submitTime, depositEndTime time.Time) Proposal {
+func NewProposal(content Content, id uint64, proposer sdk.AccAddress, submitTime, depositEndTime time.Time,
+	isExpedited bool) Proposal {
+
 	return Proposal{
 		Content:          content,
 		ProposalID:       id,
+		Proposer:         proposer,
 		Status:           StatusDepositPeriod,
 		FinalTallyResult: EmptyTallyResult(),
 		TotalDeposit:     sdk.NewCoins(),
 		SubmitTime:       submitTime,
 		DepositEndTime:   depositEndTime,
+		IsExpedited:      isExpedited,
 	}
 }
 
@@ -49,10 +57,12 @@ func (p Proposal) String() string {
   Total Deposit:      %s
   Voting Start Time:  %s
   Voting End Time:    %s
+  Expedited:          %t
+  Proposer:           %s
   Description:        %s`,
 		p.ProposalID, p.GetTitle(), p.ProposalType(),
 		p.Status, p.SubmitTime, p.DepositEndTime,
-		p.TotalDeposit, p.VotingStartTime, p.VotingEndTime, p.GetDescription(),
+		p.TotalDeposit, p.VotingStartTime, p.VotingEndTime, p.IsExpedited, p.Proposer, p.GetDescription(),
 	)
 }
 
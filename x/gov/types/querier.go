@@ -15,9 +15,11 @@ const (
 	QueryVote      = "vote"
 	QueryTally     = "tally"
 
-	ParamDeposit  = "deposit"
-	ParamVoting   = "voting"
-	ParamTallying = "tallying"
+	ParamDeposit   = "deposit"
+	ParamVoting    = "voting"
+	ParamTallying  = "tallying"
+	ParamExpedited = "expedited"
+	ParamBurn      = "burn"
 )
 
 // Params for queries:
@@ -2,25 +2,47 @@ package types
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
+	"strings"
 
 	sdk "github.com/shinecloudfoundation/shinecloudnet/types"
 )
 
 // Vote
 type Vote struct {
-	ProposalID uint64         `json:"proposal_id" yaml:"proposal_id"` //  proposalID of the proposal
-	Voter      sdk.AccAddress `json:"voter" yaml:"voter"`             //  address of the voter
-	Option     VoteOption     `json:"option" yaml:"option"`           //  option from OptionSet chosen by the voter
+	ProposalID uint64              `json:"proposal_id" yaml:"proposal_id"` //  proposalID of the proposal
+	Voter      sdk.AccAddress      `json:"voter" yaml:"voter"`             //  address of the voter
+	Option     VoteOption          `json:"option" yaml:"option"`           //  option from OptionSet chosen by the voter, empty for split votes
+	Options    WeightedVoteOptions `json:"options" yaml:"options"`         //  weighted options the voter split their voting power across
 }
 
 // NewVote creates a new Vote instance
 func NewVote(proposalID uint64, voter sdk.AccAddress, option VoteOption) Vote {
-	return Vote{proposalID, voter, option}
+	return NewWeightedVote(proposalID, voter, NewNonSplitVoteOption(option))
+}
+
+// NewWeightedVote creates a new Vote instance splitting the voting power of
+// voter across options. The legacy Option is only set for non-split votes.
+func NewWeightedVote(proposalID uint64, voter sdk.AccAddress, options WeightedVoteOptions) Vote {
+	option := OptionEmpty
+	if options.IsNonSplit() {
+		option = options[0].Option
+	}
+	return Vote{proposalID, voter, option, options}
+}
+
+// GetOptions returns the weighted options of the vote. Votes cast before
+// weighted votes were introduced only hold their legacy Option.
+func (v Vote) GetOptions() WeightedVoteOptions {
+	if len(v.Options) == 0 && v.Option != OptionEmpty {
+		return NewNonSplitVoteOption(v.Option)
+	}
+	return v.Options
 }
 
 func (v Vote) String() string {
-	return fmt.Sprintf("voter %s voted with option %s on proposal %d", v.Voter, v.Option, v.ProposalID)
+	return fmt.Sprintf("voter %s voted with option %s on proposal %d", v.Voter, v.GetOptions(), v.ProposalID)
 }
 
 // Votes is a collection of Vote objects
@@ -32,7 +54,7 @@ func (v Votes) String() string {
 	}
 	out := fmt.Sprintf("Votes for Proposal %d:", v[0].ProposalID)
 	for _, vot := range v {
-		out += fmt.Sprintf("\n  %s: %s", vot.Voter, vot.Option)
+		out += fmt.Sprintf("\n  %s: %s", vot.Voter, vot.GetOptions())
 	}
 	return out
 }
@@ -41,7 +63,8 @@ func (v Votes) String() string {
 func (v Vote) Equals(comp Vote) bool {
 	return v.Voter.Equals(comp.Voter) &&
 		v.ProposalID == comp.ProposalID &&
-		v.Option == comp.Option
+		v.Option == comp.Option &&
+		v.GetOptions().Equals(comp.GetOptions())
 }
 
 // Empty returns whether a vote is empty.
@@ -49,6 +72,92 @@ func (v Vote) Empty() bool {
 	return v.Equals(Vote{})
 }
 
+// WeightedVoteOption is a vote option together with the share of the voting
+// power of the voter given to it
+type WeightedVoteOption struct {
+	Option VoteOption `json:"option" yaml:"option"`
+	Weight sdk.Dec    `json:"weight" yaml:"weight"`
+}
+
+// NewWeightedVoteOption creates a new WeightedVoteOption instance
+func NewWeightedVoteOption(option VoteOption, weight sdk.Dec) WeightedVoteOption {
+	return WeightedVoteOption{option, weight}
+}
+
+func (wo WeightedVoteOption) String() string {
+	return fmt.Sprintf("%s=%s", wo.Option, wo.Weight)
+}
+
+// WeightedVoteOptions is a collection of WeightedVoteOption objects
+type WeightedVoteOptions []WeightedVoteOption
+
+// NewNonSplitVoteOption returns the weighted options of a plain vote, giving
+// all the voting power to option
+func NewNonSplitVoteOption(option VoteOption) WeightedVoteOptions {
+	return WeightedVoteOptions{NewWeightedVoteOption(option, sdk.OneDec())}
+}
+
+// IsNonSplit returns true if all the voting power is given to a single option
+func (wos WeightedVoteOptions) IsNonSplit() bool {
+	return len(wos) == 1 && !wos[0].Weight.IsNil() && wos[0].Weight.Equal(sdk.OneDec())
+}
+
+// Equals returns whether two weighted options are equal
+func (wos WeightedVoteOptions) Equals(comp WeightedVoteOptions) bool {
+	if len(wos) != len(comp) {
+		return false
+	}
+	for i, wo := range wos {
+		if wo.Option != comp[i].Option || !wo.Weight.Equal(comp[i].Weight) {
+			return false
+		}
+	}
+	return true
+}
+
+// String formats non-split options as their single option, which keeps the
+// output of plain votes unchanged.
+func (wos WeightedVoteOptions) String() string {
+	if wos.IsNonSplit() {
+		return wos[0].Option.String()
+	}
+	out := make([]string, len(wos))
+	for i, wo := range wos {
+		out[i] = wo.String()
+	}
+	return strings.Join(out, ",")
+}
+
+// ValidWeightedVoteOptions returns an error if the options aren't valid vote
+// options given at most once with positive weights summing up to one.
+func ValidWeightedVoteOptions(options WeightedVoteOptions) error {
+	if len(options) == 0 {
+		return errors.New("no vote options")
+	}
+
+	seen := make(map[VoteOption]bool)
+	totalWeight := sdk.ZeroDec()
+	for _, option := range options {
+		if !ValidVoteOption(option.Option) {
+			return fmt.Errorf("'%v' is not a valid voting option", option.Option.String())
+		}
+		if seen[option.Option] {
+			return fmt.Errorf("duplicate vote option %s", option.Option)
+		}
+		if option.Weight.IsNil() || !option.Weight.IsPositive() || option.Weight.GT(sdk.OneDec()) {
+			return fmt.Errorf("weight of option %s must be positive and at most one, is %s", option.Option, option.Weight)
+		}
+
+		seen[option.Option] = true
+		totalWeight = totalWeight.Add(option.Weight)
+	}
+
+	if !totalWeight.Equal(sdk.OneDec()) {
+		return fmt.Errorf("total weight of the vote options must be one, is %s", totalWeight)
+	}
+	return nil
+}
+
 // VoteOption defines a vote option
 type VoteOption byte
 
@@ -117,6 +226,12 @@ func (vo *VoteOption) UnmarshalJSON(data []byte) error {
 		return err
 	}
 
+	// the legacy option of split votes is empty
+	if s == "" {
+		*vo = OptionEmpty
+		return nil
+	}
+
 	bz2, err := VoteOptionFromString(s)
 	if err != nil {
 		return err
@@ -9,6 +9,18 @@ import (
 
 // AddVote Adds a vote on a specific proposal
 func (keeper Keeper) AddVote(ctx sdk.Context, proposalID uint64, voterAddr sdk.AccAddress, option VoteOption) sdk.Error {
+	if !ValidVoteOption(option) {
+		return ErrInvalidVote(keeper.codespace, option)
+	}
+
+	return keeper.AddWeightedVote(ctx, proposalID, voterAddr, NewNonSplitVoteOption(option))
+}
+
+// AddWeightedVote Adds a vote on a specific proposal splitting the voting
+// power of the voter across the weighted options
+func (keeper Keeper) AddWeightedVote(ctx sdk.Context, proposalID uint64, voterAddr sdk.AccAddress,
+	options WeightedVoteOptions) sdk.Error {
+
 	proposal, ok := keeper.GetProposal(ctx, proposalID)
 	if !ok {
 		return ErrUnknownProposal(keeper.codespace, proposalID)
@@ -17,17 +29,17 @@ func (keeper Keeper) AddVote(ctx sdk.Context, proposalID uint64, voterAddr sdk.A
 		return ErrInactiveProposal(keeper.codespace, proposalID)
 	}
 
-	if !ValidVoteOption(option) {
-		return ErrInvalidVote(keeper.codespace, option)
+	if err := ValidWeightedVoteOptions(options); err != nil {
+		return ErrInvalidWeightedVote(keeper.codespace, err.Error())
 	}
 
-	vote := NewVote(proposalID, voterAddr, option)
+	vote := NewWeightedVote(proposalID, voterAddr, options)
 	keeper.setVote(ctx, proposalID, voterAddr, vote)
 
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
 			types.EventTypeProposalVote,
-			sdk.NewAttribute(types.AttributeKeyOption, option.String()),
+			sdk.NewAttribute(types.AttributeKeyOption, options.String()),
 			sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", proposalID)),
 		),
 	)
@@ -81,3 +93,16 @@ func (keeper Keeper) deleteVote(ctx sdk.Context, proposalID uint64, voterAddr sd
 	store := ctx.KVStore(keeper.storeKey)
 	store.Delete(types.VoteKey(proposalID, voterAddr))
 }
+
+// deleteVotes deletes all the votes on a specific proposal
+func (keeper Keeper) deleteVotes(ctx sdk.Context, proposalID uint64) {
+	var votes Votes
+	keeper.IterateVotes(ctx, proposalID, func(vote Vote) bool {
+		votes = append(votes, vote)
+		return false
+	})
+
+	for _, vote := range votes {
+		keeper.deleteVote(ctx, vote.ProposalID, vote.Voter)
+	}
+}
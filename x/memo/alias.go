@@ -0,0 +1,49 @@
+// nolint
+// autogenerated code using github.com/rigelrozanski/multitool
+// aliases generated for the following subdirectories:
+// ALIASGEN: github.com/shinecloudfoundation/shinecloudnet/x/memo/internal/keeper
+// ALIASGEN: github.com/shinecloudfoundation/shinecloudnet/x/memo/internal/types
+package memo
+
+import (
+	"github.com/shinecloudfoundation/shinecloudnet/x/memo/internal/keeper"
+	"github.com/shinecloudfoundation/shinecloudnet/x/memo/internal/types"
+)
+
+const (
+	DefaultCodespace        = types.DefaultCodespace
+	CodeMemoRequired        = types.CodeMemoRequired
+	EventTypeSetRequireMemo = types.EventTypeSetRequireMemo
+	AttributeKeyAddress     = types.AttributeKeyAddress
+	AttributeKeyRequireMemo = types.AttributeKeyRequireMemo
+	AttributeValueCategory  = types.AttributeValueCategory
+	ModuleName              = types.ModuleName
+	StoreKey                = types.StoreKey
+	RouterKey               = types.RouterKey
+	QuerierRoute            = types.QuerierRoute
+	TypeMsgSetRequireMemo   = types.TypeMsgSetRequireMemo
+	QueryRequireMemo        = types.QueryRequireMemo
+)
+
+var (
+	// functions aliases
+	NewKeeper                 = keeper.NewKeeper
+	NewQuerier                = keeper.NewQuerier
+	RegisterCodec             = types.RegisterCodec
+	ErrMemoRequired           = types.ErrMemoRequired
+	GetRequireMemoKey         = types.GetRequireMemoKey
+	NewMsgSetRequireMemo      = types.NewMsgSetRequireMemo
+	NewQueryRequireMemoParams = types.NewQueryRequireMemoParams
+	NewQueryRequireMemoResult = types.NewQueryRequireMemoResult
+
+	// variable aliases
+	ModuleCdc            = types.ModuleCdc
+	RequireMemoKeyPrefix = types.RequireMemoKeyPrefix
+)
+
+type (
+	Keeper                 = keeper.Keeper
+	MsgSetRequireMemo      = types.MsgSetRequireMemo
+	QueryRequireMemoParams = types.QueryRequireMemoParams
+	QueryRequireMemoResult = types.QueryRequireMemoResult
+)
@@ -0,0 +1,25 @@
+package memo
+
+import (
+	sdk "github.com/shinecloudfoundation/shinecloudnet/types"
+	"github.com/shinecloudfoundation/shinecloudnet/x/auth"
+)
+
+// NewAnteHandler returns an AnteHandler which runs next and then rejects the
+// txs without a memo sending coins to an account requiring one. The check is
+// metered by the gas meter set up by next.
+func NewAnteHandler(next sdk.AnteHandler, k Keeper) sdk.AnteHandler {
+	return func(ctx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, sdk.Result, bool) {
+		newCtx, res, abort := next(ctx, tx, simulate)
+		if abort {
+			return newCtx, res, abort
+		}
+
+		if stdTx, ok := tx.(auth.StdTx); ok {
+			if err := k.ValidateMemo(newCtx, stdTx.Memo, stdTx.Msgs); err != nil {
+				return newCtx, err.Result(), true
+			}
+		}
+		return newCtx, res, abort
+	}
+}
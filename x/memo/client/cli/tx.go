@@ -0,0 +1,71 @@
+package cli
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/shinecloudfoundation/shinecloudnet/client"
+	"github.com/shinecloudfoundation/shinecloudnet/client/context"
+	"github.com/shinecloudfoundation/shinecloudnet/codec"
+	sdk "github.com/shinecloudfoundation/shinecloudnet/types"
+	"github.com/shinecloudfoundation/shinecloudnet/version"
+	"github.com/shinecloudfoundation/shinecloudnet/x/auth"
+	"github.com/shinecloudfoundation/shinecloudnet/x/auth/client/utils"
+	"github.com/shinecloudfoundation/shinecloudnet/x/memo/internal/types"
+)
+
+// GetTxCmd returns the transaction commands for this module
+func GetTxCmd(cdc *codec.Codec) *cobra.Command {
+	memoTxCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Memo transaction subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	memoTxCmd.AddCommand(client.PostCommands(
+		GetCmdSetRequireMemo(cdc),
+	)...)
+
+	return memoTxCmd
+}
+
+// GetCmdSetRequireMemo implements the command to set whether an account
+// requires a memo
+func GetCmdSetRequireMemo(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "set-require-memo [true|false]",
+		Short: "Set whether the transactions sending coins to your account must have a memo",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Set whether the transactions sending coins to the account of the sender
+must have a memo. Sends without a memo to an account requiring one are rejected.
+
+Example:
+$ %s tx %s set-require-memo true --from=mykey
+`,
+				version.ClientName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			requireMemo, err := strconv.ParseBool(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetRequireMemo(cliCtx.GetFromAddress(), requireMemo)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
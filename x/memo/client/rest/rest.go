@@ -0,0 +1,15 @@
+package rest
+
+import (
+	"github.com/gorilla/mux"
+
+	"github.com/shinecloudfoundation/shinecloudnet/client/context"
+	"github.com/shinecloudfoundation/shinecloudnet/x/memo/internal/types"
+)
+
+// RegisterRoutes - Central function to define routes that get registered by the main application
+func RegisterRoutes(cliCtx context.CLIContext, r *mux.Router) {
+	r.HandleFunc("/memo/require_memo", setRequireMemoRequestHandlerFn(cliCtx)).Methods("POST")
+
+	r.HandleFunc("/memo/require_memo/{address}", requireMemoHandlerFn(cliCtx, types.QuerierRoute)).Methods("GET")
+}
@@ -0,0 +1,51 @@
+package memo
+
+import (
+	"fmt"
+
+	sdk "github.com/shinecloudfoundation/shinecloudnet/types"
+)
+
+// GenesisState contains the accounts requiring a memo, persisted from the store
+type GenesisState struct {
+	RequireMemoAccounts []sdk.AccAddress `json:"require_memo_accounts" yaml:"require_memo_accounts"`
+}
+
+// NewGenesisState creates a new GenesisState object
+func NewGenesisState(addrs []sdk.AccAddress) GenesisState {
+	return GenesisState{
+		RequireMemoAccounts: addrs,
+	}
+}
+
+// DefaultGenesisState returns a default genesis state
+func DefaultGenesisState() GenesisState {
+	return NewGenesisState([]sdk.AccAddress{})
+}
+
+// InitGenesis flags the accounts of the genesis state as requiring a memo
+func InitGenesis(ctx sdk.Context, k Keeper, data GenesisState) {
+	for _, addr := range data.RequireMemoAccounts {
+		k.SetRequireMemo(ctx, addr, true)
+	}
+}
+
+// ExportGenesis returns a GenesisState with all the accounts requiring a memo
+func ExportGenesis(ctx sdk.Context, k Keeper) GenesisState {
+	addrs := []sdk.AccAddress{}
+	k.IterateRequireMemoAccounts(ctx, func(addr sdk.AccAddress) bool {
+		addrs = append(addrs, addr)
+		return false
+	})
+	return NewGenesisState(addrs)
+}
+
+// ValidateGenesis performs basic validation of the accounts requiring a memo
+func ValidateGenesis(data GenesisState) error {
+	for _, addr := range data.RequireMemoAccounts {
+		if addr.Empty() {
+			return fmt.Errorf("empty address in the require memo accounts")
+		}
+	}
+	return nil
+}
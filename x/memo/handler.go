@@ -0,0 +1,43 @@
+package memo
+
+import (
+	"fmt"
+	"strconv"
+
+	sdk "github.com/shinecloudfoundation/shinecloudnet/types"
+)
+
+// NewHandler returns a handler for "memo" type messages.
+func NewHandler(k Keeper) sdk.Handler {
+	return func(ctx sdk.Context, msg sdk.Msg) sdk.Result {
+		ctx = ctx.WithEventManager(sdk.NewEventManager())
+
+		switch msg := msg.(type) {
+		case MsgSetRequireMemo:
+			return handleMsgSetRequireMemo(ctx, k, msg)
+
+		default:
+			errMsg := fmt.Sprintf("unrecognized memo message type: %T", msg)
+			return sdk.ErrUnknownRequest(errMsg).Result()
+		}
+	}
+}
+
+func handleMsgSetRequireMemo(ctx sdk.Context, k Keeper, msg MsgSetRequireMemo) sdk.Result {
+	k.SetRequireMemo(ctx, msg.Address, msg.RequireMemo)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			EventTypeSetRequireMemo,
+			sdk.NewAttribute(AttributeKeyAddress, msg.Address.String()),
+			sdk.NewAttribute(AttributeKeyRequireMemo, strconv.FormatBool(msg.RequireMemo)),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Address.String()),
+		),
+	})
+
+	return sdk.Result{Events: ctx.EventManager().Events()}
+}
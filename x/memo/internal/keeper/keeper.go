@@ -0,0 +1,100 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/shinecloudfoundation/shinecloudnet/codec"
+	sdk "github.com/shinecloudfoundation/shinecloudnet/types"
+	"github.com/shinecloudfoundation/shinecloudnet/x/authz"
+	bank "github.com/shinecloudfoundation/shinecloudnet/x/bank/types"
+	"github.com/shinecloudfoundation/shinecloudnet/x/memo/internal/types"
+)
+
+// Keeper manages the accounts which require the txs sending them coins to
+// have a memo
+type Keeper struct {
+	cdc       *codec.Codec
+	storeKey  sdk.StoreKey
+	codespace sdk.CodespaceType
+}
+
+// NewKeeper creates a new memo Keeper instance
+func NewKeeper(cdc *codec.Codec, key sdk.StoreKey, codespace sdk.CodespaceType) Keeper {
+	return Keeper{
+		cdc:       cdc,
+		storeKey:  key,
+		codespace: codespace,
+	}
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
+}
+
+// Codespace returns the keeper's codespace.
+func (k Keeper) Codespace() sdk.CodespaceType {
+	return k.codespace
+}
+
+// SetRequireMemo sets whether the txs sending coins to addr must have a memo
+func (k Keeper) SetRequireMemo(ctx sdk.Context, addr sdk.AccAddress, requireMemo bool) {
+	store := ctx.KVStore(k.storeKey)
+	if requireMemo {
+		store.Set(types.GetRequireMemoKey(addr), []byte{0x01})
+	} else {
+		store.Delete(types.GetRequireMemoKey(addr))
+	}
+}
+
+// RequiresMemo returns true if the txs sending coins to addr must have a memo
+func (k Keeper) RequiresMemo(ctx sdk.Context, addr sdk.AccAddress) bool {
+	return ctx.KVStore(k.storeKey).Has(types.GetRequireMemoKey(addr))
+}
+
+// IterateRequireMemoAccounts iterates over all the accounts requiring a memo
+func (k Keeper) IterateRequireMemoAccounts(ctx sdk.Context, cb func(addr sdk.AccAddress) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.RequireMemoKeyPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		addr := sdk.AccAddress(iterator.Key()[len(types.RequireMemoKeyPrefix):])
+		if cb(addr) {
+			break
+		}
+	}
+}
+
+// ValidateMemo checks that msgs, including the ones executed through authz,
+// don't send coins to an account requiring a memo if the tx memo is empty
+func (k Keeper) ValidateMemo(ctx sdk.Context, memo string, msgs []sdk.Msg) sdk.Error {
+	if memo != "" {
+		return nil
+	}
+
+	for _, msg := range msgs {
+		var recipients []sdk.AccAddress
+		switch msg := msg.(type) {
+		case bank.MsgSend:
+			recipients = []sdk.AccAddress{msg.ToAddress}
+		case bank.MsgMultiSend:
+			for _, out := range msg.Outputs {
+				recipients = append(recipients, out.Address)
+			}
+		case authz.MsgExec:
+			if err := k.ValidateMemo(ctx, memo, msg.Msgs); err != nil {
+				return err
+			}
+		}
+
+		for _, addr := range recipients {
+			if k.RequiresMemo(ctx, addr) {
+				return types.ErrMemoRequired(k.codespace, addr)
+			}
+		}
+	}
+	return nil
+}
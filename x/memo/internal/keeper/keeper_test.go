@@ -0,0 +1,84 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto"
+
+	sdk "github.com/shinecloudfoundation/shinecloudnet/types"
+	"github.com/shinecloudfoundation/shinecloudnet/x/authz"
+	bank "github.com/shinecloudfoundation/shinecloudnet/x/bank/types"
+	"github.com/shinecloudfoundation/shinecloudnet/x/memo/internal/types"
+)
+
+var (
+	addr1 = sdk.AccAddress(crypto.AddressHash([]byte("addr1")))
+	addr2 = sdk.AccAddress(crypto.AddressHash([]byte("addr2")))
+	addr3 = sdk.AccAddress(crypto.AddressHash([]byte("addr3")))
+)
+
+func atoms(amt int64) sdk.Coins {
+	return sdk.NewCoins(sdk.NewInt64Coin("atom", amt))
+}
+
+func TestKeeperSetRequireMemo(t *testing.T) {
+	_, ctx, keeper := SetupTestInput()
+
+	require.False(t, keeper.RequiresMemo(ctx, addr1))
+
+	keeper.SetRequireMemo(ctx, addr1, true)
+	keeper.SetRequireMemo(ctx, addr2, true)
+	require.True(t, keeper.RequiresMemo(ctx, addr1))
+	require.True(t, keeper.RequiresMemo(ctx, addr2))
+	require.False(t, keeper.RequiresMemo(ctx, addr3))
+
+	var addrs []sdk.AccAddress
+	keeper.IterateRequireMemoAccounts(ctx, func(addr sdk.AccAddress) bool {
+		addrs = append(addrs, addr)
+		return false
+	})
+	require.Len(t, addrs, 2)
+
+	keeper.SetRequireMemo(ctx, addr1, false)
+	require.False(t, keeper.RequiresMemo(ctx, addr1))
+	require.True(t, keeper.RequiresMemo(ctx, addr2))
+}
+
+func TestKeeperValidateMemo(t *testing.T) {
+	_, ctx, keeper := SetupTestInput()
+	keeper.SetRequireMemo(ctx, addr2, true)
+
+	send := bank.NewMsgSend(addr1, addr2, atoms(10))
+	multiSend := bank.NewMsgMultiSend(
+		[]bank.Input{bank.NewInput(addr1, atoms(20))},
+		[]bank.Output{bank.NewOutput(addr3, atoms(10)), bank.NewOutput(addr2, atoms(10))},
+	)
+
+	testCases := []struct {
+		name  string
+		memo  string
+		msgs  []sdk.Msg
+		valid bool
+	}{
+		{"send without memo", "", []sdk.Msg{send}, false},
+		{"send with memo", "deposit 42", []sdk.Msg{send}, true},
+		{"multi send without memo", "", []sdk.Msg{multiSend}, false},
+		{"multi send with memo", "deposit 42", []sdk.Msg{multiSend}, true},
+		{"send to other account", "", []sdk.Msg{bank.NewMsgSend(addr2, addr3, atoms(10))}, true},
+		{"other msg", "", []sdk.Msg{types.NewMsgSetRequireMemo(addr2, false)}, true},
+		{"exec send without memo", "", []sdk.Msg{authz.NewMsgExec(addr3, []sdk.Msg{send})}, false},
+		{"exec multi send without memo", "", []sdk.Msg{authz.NewMsgExec(addr3, []sdk.Msg{multiSend})}, false},
+		{"exec send with memo", "deposit 42", []sdk.Msg{authz.NewMsgExec(addr3, []sdk.Msg{send})}, true},
+	}
+
+	for _, tc := range testCases {
+		err := keeper.ValidateMemo(ctx, tc.memo, tc.msgs)
+		if tc.valid {
+			require.Nil(t, err, tc.name)
+		} else {
+			require.NotNil(t, err, tc.name)
+			require.Equal(t, types.CodeMemoRequired, err.Code(), tc.name)
+		}
+	}
+}
@@ -0,0 +1,20 @@
+package types
+
+import (
+	"github.com/shinecloudfoundation/shinecloudnet/codec"
+)
+
+// RegisterCodec registers the msgs of the module
+func RegisterCodec(cdc *codec.Codec) {
+	cdc.RegisterConcrete(MsgSetRequireMemo{}, "cosmos-sdk/MsgSetRequireMemo", nil)
+}
+
+// ModuleCdc generic sealed codec to be used throughout module
+var ModuleCdc *codec.Codec
+
+func init() {
+	ModuleCdc = codec.New()
+	RegisterCodec(ModuleCdc)
+	codec.RegisterCrypto(ModuleCdc)
+	ModuleCdc.Seal()
+}
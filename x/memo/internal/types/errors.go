@@ -0,0 +1,24 @@
+//nolint
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/shinecloudfoundation/shinecloudnet/types"
+)
+
+// Local code type
+type CodeType = sdk.CodeType
+
+const (
+	// Default memo codespace
+	DefaultCodespace sdk.CodespaceType = ModuleName
+
+	CodeMemoRequired CodeType = 101
+)
+
+// ErrMemoRequired is returned if coins are sent to an account requiring a
+// memo by a tx without one
+func ErrMemoRequired(codespace sdk.CodespaceType, addr sdk.AccAddress) sdk.Error {
+	return sdk.NewError(codespace, CodeMemoRequired, fmt.Sprintf("account %s requires a memo", addr))
+}
@@ -0,0 +1,11 @@
+package types
+
+// memo module event types
+const (
+	EventTypeSetRequireMemo = "set_require_memo"
+
+	AttributeKeyAddress     = "address"
+	AttributeKeyRequireMemo = "require_memo"
+
+	AttributeValueCategory = ModuleName
+)
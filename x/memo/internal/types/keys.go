@@ -0,0 +1,29 @@
+package types
+
+import (
+	sdk "github.com/shinecloudfoundation/shinecloudnet/types"
+)
+
+const (
+	// ModuleName is the module name constant used in many places
+	ModuleName = "memo"
+
+	// StoreKey is the store key string for the memo module
+	StoreKey = ModuleName
+
+	// RouterKey is the message route for the memo module
+	RouterKey = ModuleName
+
+	// QuerierRoute is the querier route for the memo module
+	QuerierRoute = ModuleName
+)
+
+var (
+	// RequireMemoKeyPrefix is the prefix of the accounts requiring a memo
+	RequireMemoKeyPrefix = []byte{0x00}
+)
+
+// GetRequireMemoKey is the key used to flag an account as requiring a memo
+func GetRequireMemoKey(addr sdk.AccAddress) []byte {
+	return append(RequireMemoKeyPrefix, addr...)
+}
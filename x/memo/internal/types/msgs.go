@@ -0,0 +1,51 @@
+package types
+
+import (
+	sdk "github.com/shinecloudfoundation/shinecloudnet/types"
+)
+
+// memo message types
+const (
+	TypeMsgSetRequireMemo = "set_require_memo"
+)
+
+var _ sdk.Msg = MsgSetRequireMemo{}
+
+// MsgSetRequireMemo sets whether the txs sending coins to Address must have
+// a memo, e.g. for exchange accounts telling their users apart by memo
+type MsgSetRequireMemo struct {
+	Address     sdk.AccAddress `json:"address" yaml:"address"`
+	RequireMemo bool           `json:"require_memo" yaml:"require_memo"`
+}
+
+// NewMsgSetRequireMemo creates a new MsgSetRequireMemo object
+func NewMsgSetRequireMemo(addr sdk.AccAddress, requireMemo bool) MsgSetRequireMemo {
+	return MsgSetRequireMemo{
+		Address:     addr,
+		RequireMemo: requireMemo,
+	}
+}
+
+// Route implements sdk.Msg
+func (msg MsgSetRequireMemo) Route() string { return RouterKey }
+
+// Type implements sdk.Msg
+func (msg MsgSetRequireMemo) Type() string { return TypeMsgSetRequireMemo }
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgSetRequireMemo) ValidateBasic() sdk.Error {
+	if msg.Address.Empty() {
+		return sdk.ErrInvalidAddress("missing address")
+	}
+	return nil
+}
+
+// GetSignBytes implements sdk.Msg
+func (msg MsgSetRequireMemo) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners implements sdk.Msg
+func (msg MsgSetRequireMemo) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Address}
+}
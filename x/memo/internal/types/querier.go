@@ -0,0 +1,45 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/shinecloudfoundation/shinecloudnet/types"
+)
+
+// querier keys
+const (
+	QueryRequireMemo = "require_memo"
+)
+
+// QueryRequireMemoParams defines the params for querying whether an account
+// requires a memo
+type QueryRequireMemoParams struct {
+	Address sdk.AccAddress `json:"address" yaml:"address"`
+}
+
+// NewQueryRequireMemoParams creates a new QueryRequireMemoParams object
+func NewQueryRequireMemoParams(addr sdk.AccAddress) QueryRequireMemoParams {
+	return QueryRequireMemoParams{
+		Address: addr,
+	}
+}
+
+// QueryRequireMemoResult is the result of a require memo query
+type QueryRequireMemoResult struct {
+	Address     sdk.AccAddress `json:"address" yaml:"address"`
+	RequireMemo bool           `json:"require_memo" yaml:"require_memo"`
+}
+
+// NewQueryRequireMemoResult creates a new QueryRequireMemoResult object
+func NewQueryRequireMemoResult(addr sdk.AccAddress, requireMemo bool) QueryRequireMemoResult {
+	return QueryRequireMemoResult{
+		Address:     addr,
+		RequireMemo: requireMemo,
+	}
+}
+
+func (r QueryRequireMemoResult) String() string {
+	return fmt.Sprintf(`Require Memo:
+  Address:      %s
+  Require Memo: %t`, r.Address, r.RequireMemo)
+}
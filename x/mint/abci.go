@@ -10,20 +10,37 @@ func BeginBlocker(ctx sdk.Context, k Keeper) {
 	// fetch stored minter & params
 	minter := k.GetMinter(ctx)
 	params := k.GetParams(ctx)
-	if ctx.BlockHeight() == 1 {
-		mintedCoins := sdk.NewCoins(sdk.NewCoin(params.MintDenom, sdk.NewIntWithDecimal(259999999, 6)))
+
+	// chains started from a genesis without an initial mint pool mint the
+	// legacy pool at height 1
+	if ctx.BlockHeight() == 1 && k.HasLegacyInitialMint(ctx) {
+		mintedCoins := sdk.NewCoins(sdk.NewCoin(params.MintDenom, types.LegacyInitialLockedAmount))
 		err := k.MintCoins(ctx, mintedCoins)
 		if err != nil {
 			panic(err)
 		}
 		minter.RemainedTokens = mintedCoins
+		k.SetMinter(ctx, minter)
+		k.DeleteLegacyInitialMint(ctx)
+	}
+
+	// unlock the amount of the current schedule period, the last block
+	// unlocks whatever is left once the upgrade unlocking it is applied
+	unfreezeAmount := params.UnlockAmountAt(ctx.BlockHeight())
+	remainedAmount := minter.RemainedTokens.AmountOf(params.MintDenom)
+	if unfreezeAmount.GT(remainedAmount) {
+		if sdk.GlobalUpgradeMgr.IsUpgradeApplied(types.UpgradeUnlockRemainder) {
+			unfreezeAmount = remainedAmount
+		} else {
+			unfreezeAmount = sdk.ZeroInt()
+		}
 	}
-	var unfreezenTokens sdk.Coins
-	updatedParams := k.GetParams(ctx)
-	unfreezenTokens = sdk.NewCoins(sdk.NewCoin(updatedParams.MintDenom, sdk.NewInt(updatedParams.UnfreezeAmountPerBlock)))
-	if minter.RemainedTokens.IsAllGTE(unfreezenTokens) {
-		// send the minted coins to the fee collector account
-		err := k.AddCollectedFees(ctx, unfreezenTokens)
+
+	unfreezenTokens := sdk.NewCoins(sdk.NewCoin(params.MintDenom, unfreezeAmount))
+	if !unfreezenTokens.Empty() {
+		// send the shares of the unlocked coins to their recipients, the rest
+		// goes to the fee collector account
+		err := k.DistributeUnlockedTokens(ctx, params, unfreezenTokens)
 		if err != nil {
 			panic(err)
 		}
@@ -11,12 +11,17 @@ import (
 )
 
 const (
-	ModuleName            = types.ModuleName
-	DefaultParamspace     = types.DefaultParamspace
-	StoreKey              = types.StoreKey
-	QuerierRoute          = types.QuerierRoute
-	QueryParameters       = types.QueryParameters
-	QueryRemainAmount        = types.QueryRemainAmount
+	ModuleName             = types.ModuleName
+	UpgradeUnlockRemainder = types.UpgradeUnlockRemainder
+	RecipientFeeCollector  = types.RecipientFeeCollector
+	RecipientCommunityPool = types.RecipientCommunityPool
+	DefaultParamspace      = types.DefaultParamspace
+	StoreKey               = types.StoreKey
+	QuerierRoute           = types.QuerierRoute
+	QueryParameters        = types.QueryParameters
+	QueryRemainAmount      = types.QueryRemainAmount
+	QueryUnlockProjection  = types.QueryUnlockProjection
+	QueryRecipientTotals   = types.QueryRecipientTotals
 )
 
 var (
@@ -31,15 +36,36 @@ var (
 	NewParams            = types.NewParams
 	DefaultParams        = types.DefaultParams
 	ValidateParams       = types.ValidateParams
+	ProjectUnlock        = types.ProjectUnlock
+	NewMintRecipient     = types.NewMintRecipient
+	NewRecipientTotal    = types.NewRecipientTotal
+	GetRecipientTotalKey = types.GetRecipientTotalKey
 
 	// variable aliases
-	ModuleCdc              = types.ModuleCdc
-	MinterKey              = types.MinterKey
-	KeyMintDenom           = types.KeyMintDenom
+	ModuleCdc                 = types.ModuleCdc
+	MinterKey                 = types.MinterKey
+	LegacyInitialMintKey      = types.LegacyInitialMintKey
+	LegacyInitialLockedAmount = types.LegacyInitialLockedAmount
+	KeyMintDenom              = types.KeyMintDenom
+	KeyUnlockSchedule         = types.KeyUnlockSchedule
+	KeyHalvingInterval        = types.KeyHalvingInterval
+	KeyHalvingDecay           = types.KeyHalvingDecay
+	KeyCommunityPoolShare     = types.KeyCommunityPoolShare
+	KeyFixedRecipients        = types.KeyFixedRecipients
+	RecipientTotalKeyPrefix   = types.RecipientTotalKeyPrefix
 )
 
 type (
 	Keeper = keeper.Keeper
 	Minter = types.Minter
 	Params = types.Params
+
+	UnlockPeriod     = types.UnlockPeriod
+	UnlockSchedule   = types.UnlockSchedule
+	UnlockSegment    = types.UnlockSegment
+	UnlockProjection = types.UnlockProjection
+	MintRecipient    = types.MintRecipient
+	MintRecipients   = types.MintRecipients
+	RecipientTotal   = types.RecipientTotal
+	RecipientTotals  = types.RecipientTotals
 )
@@ -26,6 +26,8 @@ func GetQueryCmd(cdc *codec.Codec) *cobra.Command {
 		client.GetCommands(
 			GetCmdQueryParams(cdc),
 			GetCmdQueryRemainAmount(cdc),
+			GetCmdQueryUnlockProjection(cdc),
+			GetCmdQueryRecipientTotals(cdc),
 		)...,
 	)
 
@@ -83,3 +85,55 @@ func GetCmdQueryRemainAmount(cdc *codec.Codec) *cobra.Command {
 		},
 	}
 }
+
+// GetCmdQueryUnlockProjection implements a command to return the projected
+// unlock curve of the remained tokens.
+func GetCmdQueryUnlockProjection(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "unlock-projection",
+		Short: "Query the projected unlock curve and the height the remained tokens are depleted",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryUnlockProjection)
+			res, _, err := cliCtx.QueryWithData(route, nil)
+			if err != nil {
+				return err
+			}
+
+			var projection types.UnlockProjection
+			if err := cdc.UnmarshalJSON(res, &projection); err != nil {
+				return err
+			}
+
+			return cliCtx.PrintOutput(projection)
+		},
+	}
+}
+
+// GetCmdQueryRecipientTotals implements a command to return the total unlocked
+// tokens received by each recipient.
+func GetCmdQueryRecipientTotals(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "recipient-totals",
+		Short: "Query the total unlocked tokens received by the fee collector, the community pool and the fixed recipients",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryRecipientTotals)
+			res, _, err := cliCtx.QueryWithData(route, nil)
+			if err != nil {
+				return err
+			}
+
+			var totals types.RecipientTotals
+			if err := cdc.UnmarshalJSON(res, &totals); err != nil {
+				return err
+			}
+
+			return cliCtx.PrintOutput(totals)
+		},
+	}
+}
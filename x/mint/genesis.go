@@ -1,6 +1,8 @@
 package mint
 
 import (
+	"fmt"
+
 	sdk "github.com/shinecloudfoundation/shinecloudnet/types"
 )
 
@@ -8,35 +10,63 @@ import (
 type GenesisState struct {
 	Minter Minter `json:"minter" yaml:"minter"` // minter object
 	Params Params `json:"params" yaml:"params"` // inflation params
+
+	// InitialLockedTokens are minted into the minter remained tokens by
+	// InitGenesis. A genesis without MintAtGenesis set predates the initial
+	// pool and mints LegacyInitialLockedAmount at height 1 instead.
+	InitialLockedTokens sdk.Coins `json:"initial_locked_tokens" yaml:"initial_locked_tokens"`
+	MintAtGenesis       bool      `json:"mint_at_genesis" yaml:"mint_at_genesis"`
 }
 
 // NewGenesisState creates a new GenesisState object
-func NewGenesisState(minter Minter, params Params) GenesisState {
+func NewGenesisState(minter Minter, params Params, initialLockedTokens sdk.Coins) GenesisState {
 	return GenesisState{
-		Minter: minter,
-		Params: params,
+		Minter:              minter,
+		Params:              params,
+		InitialLockedTokens: initialLockedTokens,
+		MintAtGenesis:       true,
 	}
 }
 
 // DefaultGenesisState creates a default GenesisState object
 func DefaultGenesisState() GenesisState {
-	return GenesisState{
-		Minter: DefaultInitialMinter(),
-		Params: DefaultParams(),
-	}
+	return NewGenesisState(
+		DefaultInitialMinter(),
+		DefaultParams(),
+		DefaultInitialLockedTokens(),
+	)
+}
+
+// DefaultInitialLockedTokens returns the default initial mint pool
+func DefaultInitialLockedTokens() sdk.Coins {
+	return sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, LegacyInitialLockedAmount))
 }
 
 // InitGenesis new mint genesis
 func InitGenesis(ctx sdk.Context, keeper Keeper, data GenesisState) {
-	keeper.SetMinter(ctx, data.Minter)
+	minter := data.Minter
+	if data.MintAtGenesis {
+		err := keeper.MintCoins(ctx, data.InitialLockedTokens)
+		if err != nil {
+			panic(err)
+		}
+		minter.RemainedTokens = minter.RemainedTokens.Add(data.InitialLockedTokens)
+	} else {
+		keeper.SetLegacyInitialMint(ctx)
+	}
+
+	keeper.SetMinter(ctx, minter)
 	keeper.SetParams(ctx, data.Params)
 }
 
-// ExportGenesis returns a GenesisState for a given context and keeper.
+// ExportGenesis returns a GenesisState for a given context and keeper. The
+// initial pool is already part of the exported minter.
 func ExportGenesis(ctx sdk.Context, keeper Keeper) GenesisState {
 	minter := keeper.GetMinter(ctx)
 	params := keeper.GetParams(ctx)
-	return NewGenesisState(minter, params)
+	data := NewGenesisState(minter, params, sdk.Coins{})
+	data.MintAtGenesis = !keeper.HasLegacyInitialMint(ctx)
+	return data
 }
 
 // ValidateGenesis validates the provided genesis state to ensure the
@@ -52,5 +82,12 @@ func ValidateGenesis(data GenesisState) error {
 		return err
 	}
 
+	if !data.InitialLockedTokens.IsValid() {
+		return fmt.Errorf("mint initial locked tokens are invalid: %s", data.InitialLockedTokens)
+	}
+	if !data.MintAtGenesis && !data.InitialLockedTokens.Empty() {
+		return fmt.Errorf("mint initial locked tokens %s require mint at genesis", data.InitialLockedTokens)
+	}
+
 	return nil
 }
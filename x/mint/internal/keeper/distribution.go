@@ -0,0 +1,117 @@
+package keeper
+
+import (
+	sdk "github.com/shinecloudfoundation/shinecloudnet/types"
+	"github.com/shinecloudfoundation/shinecloudnet/x/mint/internal/types"
+)
+
+// DistributeUnlockedTokens sends the shares of the unlocked tokens to the
+// community pool and the fixed recipients, the remainder goes to the fee
+// collector.
+func (k Keeper) DistributeUnlockedTokens(ctx sdk.Context, params types.Params, unlocked sdk.Coins) sdk.Error {
+	remainder := unlocked
+
+	communityPool := shareOf(unlocked, params.CommunityPoolShare)
+	if !communityPool.Empty() {
+		err := k.distrKeeper.FundCommunityPoolFromModule(ctx, communityPool, types.ModuleName)
+		if err != nil {
+			return err
+		}
+		k.recordDistribution(ctx, types.RecipientCommunityPool, k.distrKeeper.GetDistributionAccount(ctx).GetAddress(), communityPool)
+		remainder = remainder.Sub(communityPool)
+	}
+
+	for _, recipient := range params.FixedRecipients {
+		amount := shareOf(unlocked, recipient.Share)
+		if amount.Empty() {
+			continue
+		}
+		err := k.supplyKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, recipient.Address, amount)
+		if err != nil {
+			return err
+		}
+		k.recordDistribution(ctx, recipient.Name, recipient.Address, amount)
+		remainder = remainder.Sub(amount)
+	}
+
+	if !remainder.Empty() {
+		err := k.AddCollectedFees(ctx, remainder)
+		if err != nil {
+			return err
+		}
+		k.recordDistribution(ctx, types.RecipientFeeCollector, k.supplyKeeper.GetModuleAddress(k.feeCollectorName), remainder)
+	}
+
+	return nil
+}
+
+// recordDistribution adds the amount to the total of the recipient address
+// and emits an event
+func (k Keeper) recordDistribution(ctx sdk.Context, recipient string, addr sdk.AccAddress, amount sdk.Coins) {
+	total := k.GetRecipientTotal(ctx, addr)
+	k.SetRecipientTotal(ctx, types.NewRecipientTotal(recipient, addr, total.Total.Add(amount)))
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeUnlockDistribute,
+			sdk.NewAttribute(types.AttributeKeyRecipient, recipient),
+			sdk.NewAttribute(types.AttributeKeyAddress, addr.String()),
+			sdk.NewAttribute(types.AttributeKeyAmount, amount.String()),
+		),
+	)
+}
+
+// shareOf returns the truncated share of the coins
+func shareOf(coins sdk.Coins, share sdk.Dec) sdk.Coins {
+	if share.IsNil() || !share.IsPositive() {
+		return sdk.Coins{}
+	}
+	amount, _ := sdk.NewDecCoins(coins).MulDecTruncate(share).TruncateDecimal()
+	return amount
+}
+
+// GetRecipientTotal returns the total unlocked tokens received by a recipient
+// address
+func (k Keeper) GetRecipientTotal(ctx sdk.Context, addr sdk.AccAddress) types.RecipientTotal {
+	store := ctx.KVStore(k.storeKey)
+	b := store.Get(types.GetRecipientTotalKey(addr))
+	if b == nil {
+		return types.NewRecipientTotal("", addr, sdk.Coins{})
+	}
+
+	var total types.RecipientTotal
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(b, &total)
+	return total
+}
+
+// SetRecipientTotal sets the total unlocked tokens received by a recipient
+// address
+func (k Keeper) SetRecipientTotal(ctx sdk.Context, total types.RecipientTotal) {
+	store := ctx.KVStore(k.storeKey)
+	b := k.cdc.MustMarshalBinaryLengthPrefixed(total)
+	store.Set(types.GetRecipientTotalKey(total.Address), b)
+}
+
+// IterateRecipientTotals iterates over the recipient totals ordered by address
+func (k Keeper) IterateRecipientTotals(ctx sdk.Context, cb func(total types.RecipientTotal) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.RecipientTotalKeyPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var total types.RecipientTotal
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &total)
+		if cb(total) {
+			break
+		}
+	}
+}
+
+// GetRecipientTotals returns the totals of all the recipients
+func (k Keeper) GetRecipientTotals(ctx sdk.Context) (totals types.RecipientTotals) {
+	k.IterateRecipientTotals(ctx, func(total types.RecipientTotal) bool {
+		totals = append(totals, total)
+		return false
+	})
+	return totals
+}
@@ -0,0 +1,98 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/shinecloudfoundation/shinecloudnet/types"
+	"github.com/shinecloudfoundation/shinecloudnet/x/auth"
+	"github.com/shinecloudfoundation/shinecloudnet/x/mint/internal/types"
+)
+
+func TestDistributeUnlockedTokens(t *testing.T) {
+	input := newTestInput(t)
+	ctx, k := input.ctx, input.mintKeeper
+
+	ecosystem := sdk.AccAddress([]byte("ecosystem-fund______"))
+	dev := sdk.AccAddress([]byte("dev-fund____________"))
+
+	params := types.DefaultParams()
+	params.CommunityPoolShare = sdk.NewDecWithPrec(2, 1)
+	params.FixedRecipients = types.MintRecipients{
+		types.NewMintRecipient("ecosystem", ecosystem, sdk.NewDecWithPrec(1, 1)),
+		types.NewMintRecipient("dev", dev, sdk.NewDecWithPrec(55, 3)),
+	}
+	require.NoError(t, types.ValidateParams(params))
+	k.SetParams(ctx, params)
+
+	unlocked := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000))
+	require.NoError(t, k.MintCoins(ctx, unlocked.Add(unlocked)))
+	for i := 0; i < 2; i++ {
+		require.NoError(t, k.DistributeUnlockedTokens(ctx, params, unlocked))
+	}
+
+	coins := func(amount int64) sdk.Coins {
+		return sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, amount))
+	}
+	feeCollector := input.supplyKeeper.GetModuleAccount(ctx, auth.FeeCollectorName)
+	require.Equal(t, coins(1290), feeCollector.GetCoins())
+	communityPool, _ := input.distrKeeper.GetFeePool(ctx).CommunityPool.TruncateDecimal()
+	require.Equal(t, coins(400), communityPool)
+	require.Equal(t, coins(200), input.accountKeeper.GetAccount(ctx, ecosystem).GetCoins())
+	require.Equal(t, coins(110), input.accountKeeper.GetAccount(ctx, dev).GetCoins())
+
+	feeCollectorAddr := feeCollector.GetAddress()
+	communityPoolAddr := input.distrKeeper.GetDistributionAccount(ctx).GetAddress()
+	require.Len(t, k.GetRecipientTotals(ctx), 4)
+	require.Equal(t, types.NewRecipientTotal(types.RecipientCommunityPool, communityPoolAddr, coins(400)),
+		k.GetRecipientTotal(ctx, communityPoolAddr))
+	require.Equal(t, types.NewRecipientTotal("dev", dev, coins(110)), k.GetRecipientTotal(ctx, dev))
+	require.Equal(t, types.NewRecipientTotal("ecosystem", ecosystem, coins(200)), k.GetRecipientTotal(ctx, ecosystem))
+	require.Equal(t, types.NewRecipientTotal(types.RecipientFeeCollector, feeCollectorAddr, coins(1290)),
+		k.GetRecipientTotal(ctx, feeCollectorAddr))
+
+	// one event per recipient and block
+	var distributed int
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == types.EventTypeUnlockDistribute {
+			distributed++
+		}
+	}
+	require.Equal(t, 8, distributed)
+
+	// renaming a recipient keeps accumulating the total of its address
+	params.FixedRecipients[1].Name = "developers"
+	k.SetParams(ctx, params)
+	require.NoError(t, k.MintCoins(ctx, unlocked))
+	require.NoError(t, k.DistributeUnlockedTokens(ctx, params, unlocked))
+	require.Len(t, k.GetRecipientTotals(ctx), 4)
+	require.Equal(t, types.NewRecipientTotal("developers", dev, coins(165)), k.GetRecipientTotal(ctx, dev))
+}
+
+func TestValidateMintRecipients(t *testing.T) {
+	addr := sdk.AccAddress([]byte("recipient___________"))
+
+	params := types.DefaultParams()
+	params.CommunityPoolShare = sdk.NewDecWithPrec(5, 1)
+	params.FixedRecipients = types.MintRecipients{types.NewMintRecipient("fund", addr, sdk.NewDecWithPrec(5, 1))}
+	require.NoError(t, types.ValidateParams(params))
+
+	params.FixedRecipients[0].Share = sdk.NewDecWithPrec(51, 2)
+	require.Error(t, types.ValidateParams(params))
+
+	params.FixedRecipients[0].Share = sdk.NewDecWithPrec(-1, 2)
+	require.Error(t, types.ValidateParams(params))
+
+	params.FixedRecipients = types.MintRecipients{
+		types.NewMintRecipient("fund", addr, sdk.NewDecWithPrec(1, 1)),
+		types.NewMintRecipient("fund", addr, sdk.NewDecWithPrec(1, 1)),
+	}
+	require.Error(t, types.ValidateParams(params))
+
+	params.FixedRecipients = types.MintRecipients{types.NewMintRecipient(types.RecipientCommunityPool, addr, sdk.ZeroDec())}
+	require.Error(t, types.ValidateParams(params))
+
+	params.FixedRecipients = types.MintRecipients{types.NewMintRecipient("fund", nil, sdk.ZeroDec())}
+	require.Error(t, types.ValidateParams(params))
+}
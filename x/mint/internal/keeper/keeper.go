@@ -17,12 +17,14 @@ type Keeper struct {
 	storeKey         sdk.StoreKey
 	paramSpace       params.Subspace
 	supplyKeeper     types.SupplyKeeper
+	distrKeeper      types.DistrKeeper
 	feeCollectorName string
 }
 
 // NewKeeper creates a new mint Keeper instance
 func NewKeeper(
-	cdc *codec.Codec, key sdk.StoreKey, paramSpace params.Subspace, supplyKeeper types.SupplyKeeper, feeCollectorName string) Keeper {
+	cdc *codec.Codec, key sdk.StoreKey, paramSpace params.Subspace, supplyKeeper types.SupplyKeeper,
+	distrKeeper types.DistrKeeper, feeCollectorName string) Keeper {
 
 	// ensure mint module account is set
 	if addr := supplyKeeper.GetModuleAddress(types.ModuleName); addr == nil {
@@ -34,6 +36,7 @@ func NewKeeper(
 		storeKey:         key,
 		paramSpace:       paramSpace.WithKeyTable(types.ParamKeyTable()),
 		supplyKeeper:     supplyKeeper,
+		distrKeeper:      distrKeeper,
 		feeCollectorName: feeCollectorName,
 	}
 }
@@ -64,11 +67,32 @@ func (k Keeper) SetMinter(ctx sdk.Context, minter types.Minter) {
 	store.Set(types.MinterKey, b)
 }
 
+// HasLegacyInitialMint returns true if the legacy initial pool is still to be
+// minted at height 1
+func (k Keeper) HasLegacyInitialMint(ctx sdk.Context) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.LegacyInitialMintKey)
+}
+
+// SetLegacyInitialMint schedules the legacy initial pool to be minted at height 1
+func (k Keeper) SetLegacyInitialMint(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.LegacyInitialMintKey, []byte{0x01})
+}
+
+// DeleteLegacyInitialMint removes the legacy initial mint marker
+func (k Keeper) DeleteLegacyInitialMint(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.LegacyInitialMintKey)
+}
+
 //______________________________________________________________________
 
-// GetParams returns the total set of minting parameters.
+// GetParams returns the total set of minting parameters. Parameters added
+// after genesis keep their default value until they are set.
 func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
-	k.paramSpace.GetParamSet(ctx, &params)
+	params = types.DefaultParams()
+	k.paramSpace.GetParamSetIfExists(ctx, &params)
 	return params
 }
 
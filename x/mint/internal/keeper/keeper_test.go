@@ -0,0 +1,28 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/shinecloudfoundation/shinecloudnet/types"
+	"github.com/shinecloudfoundation/shinecloudnet/x/mint/internal/types"
+)
+
+func TestParamsUpdateLegacyStore(t *testing.T) {
+	input := newTestInput(t)
+	ss := input.paramsKeeper.Subspace("legacy" + types.DefaultParamspace).WithKeyTable(types.ParamKeyTable())
+
+	// a store written before the later mint parameters were added
+	ss.Set(input.ctx, types.KeyMintDenom, sdk.DefaultBondDenom)
+
+	// the missing parameters keep their defaults during the validation
+	require.NoError(t, ss.Update(input.ctx, types.KeyHalvingInterval, []byte(`"100"`)))
+
+	var halvingInterval int64
+	ss.Get(input.ctx, types.KeyHalvingInterval, &halvingInterval)
+	require.Equal(t, int64(100), halvingInterval)
+	require.False(t, ss.Has(input.ctx, types.KeyCommunityPoolShare))
+
+	require.Error(t, ss.Update(input.ctx, types.KeyHalvingDecay, []byte(`"2.0"`)))
+}
@@ -18,6 +18,10 @@ func NewQuerier(k Keeper) sdk.Querier {
 			return queryParams(ctx, k)
 		case types.QueryRemainAmount:
 			return queryRemained(ctx, k)
+		case types.QueryUnlockProjection:
+			return queryUnlockProjection(ctx, k)
+		case types.QueryRecipientTotals:
+			return queryRecipientTotals(ctx, k)
 
 		default:
 			return nil, sdk.ErrUnknownRequest(fmt.Sprintf("unknown minting query endpoint: %s", path[0]))
@@ -47,3 +51,30 @@ func queryRemained(ctx sdk.Context, k Keeper) ([]byte, sdk.Error) {
 
 	return res, nil
 }
+
+func queryUnlockProjection(ctx sdk.Context, k Keeper) ([]byte, sdk.Error) {
+	params := k.GetParams(ctx)
+	remained := k.GetMinter(ctx).RemainedTokens.AmountOf(params.MintDenom)
+	projection := types.ProjectUnlock(params, remained, ctx.BlockHeight())
+
+	res, err := codec.MarshalJSONIndent(k.cdc, projection)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("failed to marshal JSON", err.Error()))
+	}
+
+	return res, nil
+}
+
+func queryRecipientTotals(ctx sdk.Context, k Keeper) ([]byte, sdk.Error) {
+	totals := k.GetRecipientTotals(ctx)
+	if totals == nil {
+		totals = types.RecipientTotals{}
+	}
+
+	res, err := codec.MarshalJSONIndent(k.cdc, totals)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("failed to marshal JSON", err.Error()))
+	}
+
+	return res, nil
+}
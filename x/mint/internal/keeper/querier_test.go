@@ -5,6 +5,7 @@ import (
 
 	"github.com/stretchr/testify/require"
 
+	sdk "github.com/shinecloudfoundation/shinecloudnet/types"
 	"github.com/shinecloudfoundation/shinecloudnet/x/mint/internal/types"
 
 	abci "github.com/tendermint/tendermint/abci/types"
@@ -25,6 +26,12 @@ func TestNewQuerier(t *testing.T) {
 	_, err = querier(input.ctx, []string{types.QueryRemainAmount}, query)
 	require.NoError(t, err)
 
+	_, err = querier(input.ctx, []string{types.QueryUnlockProjection}, query)
+	require.NoError(t, err)
+
+	_, err = querier(input.ctx, []string{types.QueryRecipientTotals}, query)
+	require.NoError(t, err)
+
 	_, err = querier(input.ctx, []string{"foo"}, query)
 	require.Error(t, err)
 }
@@ -42,3 +49,23 @@ func TestQueryParams(t *testing.T) {
 
 	require.Equal(t, input.mintKeeper.GetParams(input.ctx), params)
 }
+
+func TestQueryUnlockProjection(t *testing.T) {
+	input := newTestInput(t)
+	ctx := input.ctx.WithBlockHeight(100)
+
+	minter := input.mintKeeper.GetMinter(ctx)
+	minter.RemainedTokens = sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(3000000)))
+	input.mintKeeper.SetMinter(ctx, minter)
+
+	var projection types.UnlockProjection
+
+	res, sdkErr := queryUnlockProjection(ctx, input.mintKeeper)
+	require.NoError(t, sdkErr)
+
+	err := input.cdc.UnmarshalJSON(res, &projection)
+	require.NoError(t, err)
+
+	require.Equal(t, types.ProjectUnlock(input.mintKeeper.GetParams(ctx), sdk.NewInt(3000000), 100), projection)
+	require.Equal(t, int64(104), projection.DepletionHeight)
+}
@@ -18,6 +18,7 @@ import (
 	sdk "github.com/shinecloudfoundation/shinecloudnet/types"
 	"github.com/shinecloudfoundation/shinecloudnet/x/auth"
 	"github.com/shinecloudfoundation/shinecloudnet/x/bank"
+	"github.com/shinecloudfoundation/shinecloudnet/x/distribution"
 	"github.com/shinecloudfoundation/shinecloudnet/x/mint/internal/types"
 	"github.com/shinecloudfoundation/shinecloudnet/x/params"
 	"github.com/shinecloudfoundation/shinecloudnet/x/staking"
@@ -25,13 +26,29 @@ import (
 )
 
 type testInput struct {
-	ctx        sdk.Context
-	cdc        *codec.Codec
-	mintKeeper Keeper
+	ctx           sdk.Context
+	cdc           *codec.Codec
+	mintKeeper    Keeper
+	accountKeeper auth.AccountKeeper
+	supplyKeeper  supply.Keeper
+	distrKeeper   distribution.Keeper
+	paramsKeeper  params.Keeper
+}
+
+func makeTestCodec() *codec.Codec {
+	var cdc = codec.New()
+	bank.RegisterCodec(cdc)
+	staking.RegisterCodec(cdc)
+	auth.RegisterCodec(cdc)
+	supply.RegisterCodec(cdc)
+	sdk.RegisterCodec(cdc)
+	codec.RegisterCrypto(cdc)
+	return cdc
 }
 
 func newTestInput(t *testing.T) testInput {
 	db := dbm.NewMemDB()
+	cdc := makeTestCodec()
 
 	keyAcc := sdk.NewKVStoreKey(auth.StoreKey)
 	keySupply := sdk.NewKVStoreKey(supply.StoreKey)
@@ -40,6 +57,7 @@ func newTestInput(t *testing.T) testInput {
 	keyParams := sdk.NewKVStoreKey(params.StoreKey)
 	tkeyParams := sdk.NewTransientStoreKey(params.TStoreKey)
 	keyMint := sdk.NewKVStoreKey(types.StoreKey)
+	keyDistr := sdk.NewKVStoreKey(distribution.StoreKey)
 
 	ms := store.NewCommitMultiStore(db)
 	ms.MountStoreWithDB(keyAcc, sdk.StoreTypeIAVL, db)
@@ -48,6 +66,7 @@ func newTestInput(t *testing.T) testInput {
 	ms.MountStoreWithDB(keySupply, sdk.StoreTypeIAVL, db)
 	ms.MountStoreWithDB(keyParams, sdk.StoreTypeIAVL, db)
 	ms.MountStoreWithDB(keyMint, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(keyDistr, sdk.StoreTypeIAVL, db)
 	ms.MountStoreWithDB(tkeyParams, sdk.StoreTypeTransient, db)
 	err := ms.LoadLatestVersion()
 	require.Nil(t, err)
@@ -58,35 +77,46 @@ func newTestInput(t *testing.T) testInput {
 	notBondedPool := supply.NewEmptyModuleAccount(staking.NotBondedPoolName, supply.Burner, supply.Staking)
 	bondPool := supply.NewEmptyModuleAccount(staking.BondedPoolName, supply.Burner, supply.Staking)
 	minterAcc := supply.NewEmptyModuleAccount(types.ModuleName, supply.Minter)
+	distrAcc := supply.NewEmptyModuleAccount(distribution.ModuleName)
 
 	blacklistedAddrs := make(map[string]bool)
 	blacklistedAddrs[feeCollectorAcc.String()] = true
 	blacklistedAddrs[notBondedPool.String()] = true
 	blacklistedAddrs[bondPool.String()] = true
 	blacklistedAddrs[minterAcc.String()] = true
+	blacklistedAddrs[distrAcc.String()] = true
 
-	paramsKeeper := params.NewKeeper(types.ModuleCdc, keyParams, tkeyParams, params.DefaultCodespace)
-	accountKeeper := auth.NewAccountKeeper(types.ModuleCdc, keyAcc, paramsKeeper.Subspace(auth.DefaultParamspace), auth.ProtoBaseAccount)
+	paramsKeeper := params.NewKeeper(cdc, keyParams, tkeyParams, params.DefaultCodespace)
+	accountKeeper := auth.NewAccountKeeper(cdc, keyAcc, paramsKeeper.Subspace(auth.DefaultParamspace), auth.ProtoBaseAccount)
 	bankKeeper := bank.NewBaseKeeper(accountKeeper, paramsKeeper.Subspace(bank.DefaultParamspace), bank.DefaultCodespace, blacklistedAddrs)
 	maccPerms := map[string][]string{
 		auth.FeeCollectorName:     nil,
 		types.ModuleName:          []string{supply.Minter},
 		staking.NotBondedPoolName: []string{supply.Burner, supply.Staking},
 		staking.BondedPoolName:    []string{supply.Burner, supply.Staking},
+		distribution.ModuleName:   nil,
 	}
-	supplyKeeper := supply.NewKeeper(types.ModuleCdc, keySupply, accountKeeper, bankKeeper, maccPerms)
+	supplyKeeper := supply.NewKeeper(cdc, keySupply, accountKeeper, bankKeeper, maccPerms)
 	supplyKeeper.SetSupply(ctx, supply.NewSupply(sdk.Coins{}))
 
-	mintKeeper := NewKeeper(types.ModuleCdc, keyMint, paramsKeeper.Subspace(types.DefaultParamspace), supplyKeeper, auth.FeeCollectorName)
+	stakingKeeper := staking.NewKeeper(cdc, keyStaking, tkeyStaking, accountKeeper, supplyKeeper,
+		paramsKeeper.Subspace(staking.DefaultParamspace), staking.DefaultCodespace)
+	distrKeeper := distribution.NewKeeper(cdc, keyDistr, paramsKeeper.Subspace(distribution.DefaultParamspace),
+		stakingKeeper, supplyKeeper, distribution.DefaultCodespace, auth.FeeCollectorName, blacklistedAddrs)
+	mintKeeper := NewKeeper(cdc, keyMint, paramsKeeper.Subspace(types.DefaultParamspace), supplyKeeper,
+		distrKeeper, auth.FeeCollectorName)
 
 	// set module accounts
 	supplyKeeper.SetModuleAccount(ctx, feeCollectorAcc)
 	supplyKeeper.SetModuleAccount(ctx, minterAcc)
 	supplyKeeper.SetModuleAccount(ctx, notBondedPool)
 	supplyKeeper.SetModuleAccount(ctx, bondPool)
+	supplyKeeper.SetModuleAccount(ctx, distrAcc)
+
+	distrKeeper.SetFeePool(ctx, distribution.InitialFeePool())
 
 	mintKeeper.SetParams(ctx, types.DefaultParams())
 	mintKeeper.SetMinter(ctx, types.DefaultInitialMinter())
 
-	return testInput{ctx, types.ModuleCdc, mintKeeper}
+	return testInput{ctx, cdc, mintKeeper, accountKeeper, supplyKeeper, distrKeeper, paramsKeeper}
 }
@@ -2,8 +2,12 @@ package types
 
 // Minting module event types
 const (
-	EventTypeMint = ModuleName
+	EventTypeMint             = ModuleName
+	EventTypeUnlockDistribute = "unlock_distribute"
 
 	AttributeKeyRemainedTokens      = "remained_tokens"
 	AttributeKeyUnfreezenTokens     = "unfreezen_tokens"
+	AttributeKeyRecipient           = "recipient"
+	AttributeKeyAddress             = "address"
+	AttributeKeyAmount              = "amount"
 )
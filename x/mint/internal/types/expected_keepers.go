@@ -16,3 +16,9 @@ type SupplyKeeper interface {
 	SendCoinsFromModuleToModule(ctx sdk.Context, senderModule, recipientModule string, amt sdk.Coins) sdk.Error
 	MintCoins(ctx sdk.Context, name string, amt sdk.Coins) sdk.Error
 }
+
+// DistrKeeper defines the expected distribution keeper
+type DistrKeeper interface {
+	FundCommunityPoolFromModule(ctx sdk.Context, amount sdk.Coins, senderModule string) sdk.Error
+	GetDistributionAccount(ctx sdk.Context) exported.ModuleAccountI
+}
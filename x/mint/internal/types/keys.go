@@ -1,13 +1,38 @@
 package types
 
-// the one key to use for the keeper store
-var MinterKey = []byte{0x00}
+import (
+	sdk "github.com/shinecloudfoundation/shinecloudnet/types"
+)
+
+// keys to use for the keeper store
+var (
+	MinterKey = []byte{0x00}
+
+	// LegacyInitialMintKey marks a chain started from a genesis without an
+	// initial mint pool, the legacy pool is minted at height 1
+	LegacyInitialMintKey = []byte{0x01}
+
+	// RecipientTotalKeyPrefix prefixes the total unlocked tokens received by
+	// each recipient address
+	RecipientTotalKeyPrefix = []byte{0x02}
+)
+
+// GetRecipientTotalKey returns the key of the total received by a recipient
+// address
+func GetRecipientTotalKey(addr sdk.AccAddress) []byte {
+	return append(RecipientTotalKeyPrefix, addr.Bytes()...)
+}
 
 // nolint
 const (
 	// module name
 	ModuleName = "mint"
 
+	// UpgradeUnlockRemainder is the upgrade from which the last block unlocks
+	// the remained tokens lower than the unlock amount. Before it, or while it
+	// isn't registered, they stay locked.
+	UpgradeUnlockRemainder = "mintUnlockRemainder"
+
 	// default paramspace for params keeper
 	DefaultParamspace = ModuleName
 
@@ -18,6 +43,8 @@ const (
 	QuerierRoute = StoreKey
 
 	// Query endpoints supported by the minting querier
-	QueryParameters   = "parameters"
-	QueryRemainAmount = "remained_amount"
+	QueryParameters       = "parameters"
+	QueryRemainAmount     = "remained_amount"
+	QueryUnlockProjection = "unlock_projection"
+	QueryRecipientTotals  = "recipient_totals"
 )
@@ -6,6 +6,10 @@ import (
 	sdk "github.com/shinecloudfoundation/shinecloudnet/types"
 )
 
+// LegacyInitialLockedAmount is the amount minted at height 1 by chains started
+// from a genesis without an initial mint pool
+var LegacyInitialLockedAmount = sdk.NewIntWithDecimal(259999999, 6)
+
 // Minter represents the minting state.
 type Minter struct {
 	RemainedTokens   sdk.Coins `json:"remained_tokens" yaml:"remained_tokens"`
@@ -11,12 +11,22 @@ import (
 var (
 	KeyMintDenom              = []byte("MintDenom")
 	KeyUnfreezeAmountPerBlock = []byte("UnfreezeAmountPerBlock")
+	KeyUnlockSchedule         = []byte("UnlockSchedule")
+	KeyHalvingInterval        = []byte("HalvingInterval")
+	KeyHalvingDecay           = []byte("HalvingDecay")
+	KeyCommunityPoolShare     = []byte("CommunityPoolShare")
+	KeyFixedRecipients        = []byte("FixedRecipients")
 )
 
 // mint parameters
 type Params struct {
-	MintDenom              string `json:"mint_denom" yaml:"mint_denom"`
-	UnfreezeAmountPerBlock int64  `json:"unfreeze_amount_per_block" yaml:"unfreeze_amount_per_block"`
+	MintDenom              string         `json:"mint_denom" yaml:"mint_denom"`
+	UnfreezeAmountPerBlock int64          `json:"unfreeze_amount_per_block" yaml:"unfreeze_amount_per_block"`
+	UnlockSchedule         UnlockSchedule `json:"unlock_schedule" yaml:"unlock_schedule"`           // per block amounts overriding UnfreezeAmountPerBlock from their start height on
+	HalvingInterval        int64          `json:"halving_interval" yaml:"halving_interval"`         // blocks per decay epoch, 0 disables the decay
+	HalvingDecay           sdk.Dec        `json:"halving_decay" yaml:"halving_decay"`               // factor applied to the per block amount every epoch
+	CommunityPoolShare     sdk.Dec        `json:"community_pool_share" yaml:"community_pool_share"` // share of the unlocked tokens sent to the community pool
+	FixedRecipients        MintRecipients `json:"fixed_recipients" yaml:"fixed_recipients"`         // addresses receiving fixed shares of the unlocked tokens
 }
 
 // ParamTable for minting module.
@@ -24,10 +34,17 @@ func ParamKeyTable() params.KeyTable {
 	return params.NewKeyTable().RegisterParamSet(&Params{})
 }
 
-func NewParams (mintDenom string, unfreezeAmountPerBlock int64) Params {
+func NewParams(mintDenom string, unfreezeAmountPerBlock int64, schedule UnlockSchedule,
+	halvingInterval int64, halvingDecay, communityPoolShare sdk.Dec, fixedRecipients MintRecipients) Params {
+
 	return Params{
 		MintDenom:              mintDenom,
 		UnfreezeAmountPerBlock: unfreezeAmountPerBlock,
+		UnlockSchedule:         schedule,
+		HalvingInterval:        halvingInterval,
+		HalvingDecay:           halvingDecay,
+		CommunityPoolShare:     communityPoolShare,
+		FixedRecipients:        fixedRecipients,
 	}
 }
 
@@ -36,6 +53,11 @@ func DefaultParams() Params {
 	return Params{
 		MintDenom:              sdk.DefaultBondDenom,
 		UnfreezeAmountPerBlock: 750000,
+		UnlockSchedule:         UnlockSchedule{},
+		HalvingInterval:        0,
+		HalvingDecay:           sdk.NewDecWithPrec(5, 1),
+		CommunityPoolShare:     sdk.ZeroDec(),
+		FixedRecipients:        MintRecipients{},
 	}
 }
 
@@ -44,15 +66,57 @@ func ValidateParams(params Params) error {
 	if params.MintDenom == "" {
 		return fmt.Errorf("mint parameter MintDenom can't be an empty string")
 	}
+	return params.ValidateParamSet()
+}
+
+// ValidateParamSet validates the unlock and distribution parameters, it
+// implements params.ValidatedParamSet
+func (p Params) ValidateParamSet() error {
+	if p.UnfreezeAmountPerBlock < 0 {
+		return fmt.Errorf("mint parameter UnfreezeAmountPerBlock should be non-negative, is %d", p.UnfreezeAmountPerBlock)
+	}
+	if err := p.UnlockSchedule.Validate(); err != nil {
+		return err
+	}
+	if p.HalvingInterval < 0 {
+		return fmt.Errorf("mint parameter HalvingInterval should be non-negative, is %d", p.HalvingInterval)
+	}
+	if p.HalvingInterval > 0 {
+		if p.HalvingDecay.IsNil() || !p.HalvingDecay.IsPositive() || p.HalvingDecay.GT(sdk.OneDec()) {
+			return fmt.Errorf("mint parameter HalvingDecay should be in (0, 1], is %s", p.HalvingDecay)
+		}
+	}
+	if p.CommunityPoolShare.IsNil() || p.CommunityPoolShare.IsNegative() {
+		return fmt.Errorf("mint parameter CommunityPoolShare should be non-negative, is %s", p.CommunityPoolShare)
+	}
+	if err := p.FixedRecipients.Validate(); err != nil {
+		return err
+	}
+	if total := p.CommunityPoolShare.Add(p.FixedRecipients.TotalShare()); total.GT(sdk.OneDec()) {
+		return fmt.Errorf("mint parameter shares should sum to at most 1, is %s", total)
+	}
 	return nil
 }
 
+// DefaultParamSet returns the default minting parameters, it implements
+// params.DefaultedParamSet
+func (p Params) DefaultParamSet() params.ValidatedParamSet {
+	defaults := DefaultParams()
+	return &defaults
+}
+
 func (p Params) String() string {
 	return fmt.Sprintf(`Minting Params:
   Mint Denom:             %s
   UnfreezeAmountPerBlock: %d
+  UnlockSchedule:         %s
+  HalvingInterval:        %d
+  HalvingDecay:           %s
+  CommunityPoolShare:     %s
+  FixedRecipients:        %s
 `,
-		p.MintDenom, p.UnfreezeAmountPerBlock,
+		p.MintDenom, p.UnfreezeAmountPerBlock, p.UnlockSchedule, p.HalvingInterval, p.HalvingDecay,
+		p.CommunityPoolShare, p.FixedRecipients,
 	)
 }
 
@@ -61,5 +125,10 @@ func (p *Params) ParamSetPairs() params.ParamSetPairs {
 	return params.ParamSetPairs{
 		{KeyMintDenom, &p.MintDenom},
 		{KeyUnfreezeAmountPerBlock, &p.UnfreezeAmountPerBlock},
+		{KeyUnlockSchedule, &p.UnlockSchedule},
+		{KeyHalvingInterval, &p.HalvingInterval},
+		{KeyHalvingDecay, &p.HalvingDecay},
+		{KeyCommunityPoolShare, &p.CommunityPoolShare},
+		{KeyFixedRecipients, &p.FixedRecipients},
 	}
 }
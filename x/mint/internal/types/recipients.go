@@ -0,0 +1,111 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/shinecloudfoundation/shinecloudnet/types"
+)
+
+// Recipients of the unlocked tokens other than the fixed addresses
+const (
+	RecipientFeeCollector  = "fee_collector"
+	RecipientCommunityPool = "community_pool"
+)
+
+// MintRecipient receives a fixed share of the tokens unlocked every block
+type MintRecipient struct {
+	Name    string         `json:"name" yaml:"name"`
+	Address sdk.AccAddress `json:"address" yaml:"address"`
+	Share   sdk.Dec        `json:"share" yaml:"share"`
+}
+
+// NewMintRecipient creates a new MintRecipient object
+func NewMintRecipient(name string, address sdk.AccAddress, share sdk.Dec) MintRecipient {
+	return MintRecipient{
+		Name:    name,
+		Address: address,
+		Share:   share,
+	}
+}
+
+// MintRecipients is a list of fixed recipients of the unlocked tokens
+type MintRecipients []MintRecipient
+
+// Validate checks that the recipients are named uniquely and have valid shares
+func (rs MintRecipients) Validate() error {
+	names := make(map[string]bool, len(rs))
+	for _, r := range rs {
+		if strings.TrimSpace(r.Name) == "" {
+			return fmt.Errorf("mint recipient name can't be blank")
+		}
+		if r.Name == RecipientFeeCollector || r.Name == RecipientCommunityPool {
+			return fmt.Errorf("mint recipient name %s is reserved", r.Name)
+		}
+		if names[r.Name] {
+			return fmt.Errorf("duplicate mint recipient %s", r.Name)
+		}
+		names[r.Name] = true
+
+		if r.Address.Empty() {
+			return fmt.Errorf("mint recipient %s has an empty address", r.Name)
+		}
+		if r.Share.IsNil() || r.Share.IsNegative() {
+			return fmt.Errorf("mint recipient %s share should be non-negative, is %s", r.Name, r.Share)
+		}
+	}
+	return nil
+}
+
+// TotalShare returns the sum of the recipient shares
+func (rs MintRecipients) TotalShare() sdk.Dec {
+	total := sdk.ZeroDec()
+	for _, r := range rs {
+		total = total.Add(r.Share)
+	}
+	return total
+}
+
+func (rs MintRecipients) String() string {
+	if len(rs) == 0 {
+		return "[]"
+	}
+
+	recipients := make([]string, len(rs))
+	for i, r := range rs {
+		recipients[i] = fmt.Sprintf("%s:%s:%s", r.Name, r.Address, r.Share)
+	}
+	return "[" + strings.Join(recipients, " ") + "]"
+}
+
+// RecipientTotal is the total amount of unlocked tokens a recipient address
+// received, the recipient is the name the address was last distributed to under
+type RecipientTotal struct {
+	Recipient string         `json:"recipient" yaml:"recipient"`
+	Address   sdk.AccAddress `json:"address" yaml:"address"`
+	Total     sdk.Coins      `json:"total" yaml:"total"`
+}
+
+// NewRecipientTotal creates a new RecipientTotal object
+func NewRecipientTotal(recipient string, address sdk.AccAddress, total sdk.Coins) RecipientTotal {
+	return RecipientTotal{
+		Recipient: recipient,
+		Address:   address,
+		Total:     total,
+	}
+}
+
+func (rt RecipientTotal) String() string {
+	return fmt.Sprintf("%s (%s): %s", rt.Recipient, rt.Address, rt.Total)
+}
+
+// RecipientTotals is a list of recipient totals
+type RecipientTotals []RecipientTotal
+
+func (rts RecipientTotals) String() string {
+	out := "Unlocked Token Recipients:"
+	for _, rt := range rts {
+		out += "\n  " + rt.String()
+	}
+	return out
+}
@@ -0,0 +1,197 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/shinecloudfoundation/shinecloudnet/types"
+)
+
+// MaxUnlockProjectionSegments caps the number of segments of a projected unlock curve
+const MaxUnlockProjectionSegments = 1000
+
+// UnlockPeriod sets the amount unlocked per block from its start height on
+type UnlockPeriod struct {
+	StartHeight    int64 `json:"start_height" yaml:"start_height"`
+	AmountPerBlock int64 `json:"amount_per_block" yaml:"amount_per_block"`
+}
+
+// UnlockSchedule is a list of unlock periods ordered by start height
+type UnlockSchedule []UnlockPeriod
+
+// Validate checks that the start heights are positive and strictly increasing
+func (s UnlockSchedule) Validate() error {
+	var lastHeight int64
+	for _, period := range s {
+		if period.StartHeight <= lastHeight {
+			return fmt.Errorf("mint unlock schedule must have strictly increasing positive start heights, got %d after %d",
+				period.StartHeight, lastHeight)
+		}
+		if period.AmountPerBlock < 0 {
+			return fmt.Errorf("mint unlock schedule amount per block should be non-negative, is %d at height %d",
+				period.AmountPerBlock, period.StartHeight)
+		}
+		lastHeight = period.StartHeight
+	}
+	return nil
+}
+
+func (s UnlockSchedule) String() string {
+	if len(s) == 0 {
+		return "[]"
+	}
+
+	periods := make([]string, len(s))
+	for i, period := range s {
+		periods[i] = fmt.Sprintf("%d:%d", period.StartHeight, period.AmountPerBlock)
+	}
+	return "[" + strings.Join(periods, " ") + "]"
+}
+
+// activePeriod returns the start height and base amount per block in effect at
+// height. Before the first scheduled period UnfreezeAmountPerBlock applies.
+func (p Params) activePeriod(height int64) (start, amount int64) {
+	amount = p.UnfreezeAmountPerBlock
+	for _, period := range p.UnlockSchedule {
+		if period.StartHeight > height {
+			break
+		}
+		start, amount = period.StartHeight, period.AmountPerBlock
+	}
+	return start, amount
+}
+
+// UnlockAmountAt returns the amount unlocked at height, before it is capped by
+// the remained tokens. The decay epochs are counted from the start of the
+// active schedule period.
+func (p Params) UnlockAmountAt(height int64) sdk.Int {
+	start, amount := p.activePeriod(height)
+	base := sdk.NewInt(amount)
+	if p.HalvingInterval <= 0 || base.IsZero() || height <= start {
+		return base
+	}
+
+	epochs := (height - start) / p.HalvingInterval
+	if epochs == 0 {
+		return base
+	}
+	return decayPower(p.HalvingDecay, uint64(epochs)).MulInt(base).TruncateInt()
+}
+
+// nextUnlockChange returns the first height after height at which the unlock
+// amount can change, or 0 if it never changes again.
+func (p Params) nextUnlockChange(height int64) int64 {
+	next := p.nextPeriodStart(height)
+	if p.HalvingInterval > 0 {
+		start, _ := p.activePeriod(height)
+		epochEnd := start + ((height-start)/p.HalvingInterval+1)*p.HalvingInterval
+		if next == 0 || epochEnd < next {
+			next = epochEnd
+		}
+	}
+	return next
+}
+
+// nextPeriodStart returns the start height of the first schedule period after
+// height, or 0 if there is none.
+func (p Params) nextPeriodStart(height int64) int64 {
+	for _, period := range p.UnlockSchedule {
+		if period.StartHeight > height {
+			return period.StartHeight
+		}
+	}
+	return 0
+}
+
+// decayPower computes decay^n by squaring
+func decayPower(decay sdk.Dec, n uint64) sdk.Dec {
+	result := sdk.OneDec()
+	for n > 0 {
+		if n&1 == 1 {
+			result = result.Mul(decay)
+		}
+		n >>= 1
+		if n > 0 {
+			decay = decay.Mul(decay)
+		}
+		if result.IsZero() {
+			break
+		}
+	}
+	return result
+}
+
+// UnlockSegment is a range of heights with the same unlock amount per block
+type UnlockSegment struct {
+	StartHeight    int64   `json:"start_height" yaml:"start_height"`
+	EndHeight      int64   `json:"end_height" yaml:"end_height"` // 0 if the segment never ends
+	AmountPerBlock sdk.Int `json:"amount_per_block" yaml:"amount_per_block"`
+	Unlocked       sdk.Int `json:"unlocked" yaml:"unlocked"`
+}
+
+// UnlockProjection is the projected unlock curve of the remained tokens
+type UnlockProjection struct {
+	Height          int64           `json:"height" yaml:"height"`
+	RemainedTokens  sdk.Int         `json:"remained_tokens" yaml:"remained_tokens"`
+	Curve           []UnlockSegment `json:"curve" yaml:"curve"`
+	DepletionHeight int64           `json:"depletion_height" yaml:"depletion_height"` // 0 if the tokens are not depleted within the projection
+}
+
+func (up UnlockProjection) String() string {
+	out := fmt.Sprintf(`Unlock Projection:
+  Height:           %d
+  Remained Tokens:  %s
+  Depletion Height: %d
+  Curve:`, up.Height, up.RemainedTokens, up.DepletionHeight)
+	for _, segment := range up.Curve {
+		out += fmt.Sprintf("\n    %d-%d: %s per block, %s unlocked",
+			segment.StartHeight, segment.EndHeight, segment.AmountPerBlock, segment.Unlocked)
+	}
+	return out
+}
+
+// ProjectUnlock projects the unlock of the remained tokens for the blocks after height
+func ProjectUnlock(params Params, remained sdk.Int, height int64) UnlockProjection {
+	projection := UnlockProjection{
+		Height:         height,
+		RemainedTokens: remained,
+	}
+	if !remained.IsPositive() {
+		projection.DepletionHeight = height
+		return projection
+	}
+
+	h := height + 1
+	for len(projection.Curve) < MaxUnlockProjectionSegments && remained.IsPositive() {
+		amount := params.UnlockAmountAt(h)
+
+		// a decayed amount stays zero until the next schedule period
+		if amount.IsZero() {
+			next := params.nextPeriodStart(h)
+			if next == 0 {
+				projection.Curve = append(projection.Curve, UnlockSegment{h, 0, amount, sdk.ZeroInt()})
+				break
+			}
+			projection.Curve = append(projection.Curve, UnlockSegment{h, next - 1, amount, sdk.ZeroInt()})
+			h = next
+			continue
+		}
+
+		// the last block unlocks whatever is left
+		next := params.nextUnlockChange(h)
+		blocks := remained.Add(amount).SubRaw(1).Quo(amount)
+		if next == 0 || blocks.LTE(sdk.NewInt(next-h)) {
+			end := h + blocks.Int64() - 1
+			projection.Curve = append(projection.Curve, UnlockSegment{h, end, amount, remained})
+			projection.DepletionHeight = end
+			break
+		}
+
+		unlocked := amount.MulRaw(next - h)
+		projection.Curve = append(projection.Curve, UnlockSegment{h, next - 1, amount, unlocked})
+		remained = remained.Sub(unlocked)
+		h = next
+	}
+
+	return projection
+}
@@ -0,0 +1,110 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/shinecloudfoundation/shinecloudnet/types"
+)
+
+func TestUnlockScheduleValidate(t *testing.T) {
+	tests := []struct {
+		schedule  UnlockSchedule
+		expectErr bool
+	}{
+		{UnlockSchedule{}, false},
+		{UnlockSchedule{{100, 10}, {200, 5}, {300, 0}}, false},
+		{UnlockSchedule{{0, 10}}, true},
+		{UnlockSchedule{{100, 10}, {100, 5}}, true},
+		{UnlockSchedule{{200, 10}, {100, 5}}, true},
+		{UnlockSchedule{{100, -1}}, true},
+	}
+
+	for i, tc := range tests {
+		err := tc.schedule.Validate()
+		require.Equal(t, tc.expectErr, err != nil, "test case #%d", i)
+	}
+}
+
+func TestParamsValidateHalving(t *testing.T) {
+	params := DefaultParams()
+	require.NoError(t, ValidateParams(params))
+
+	params.HalvingInterval = 100
+	params.HalvingDecay = sdk.ZeroDec()
+	require.Error(t, ValidateParams(params))
+
+	params.HalvingDecay = sdk.NewDecWithPrec(11, 1)
+	require.Error(t, ValidateParams(params))
+
+	params.HalvingDecay = sdk.OneDec()
+	require.NoError(t, ValidateParams(params))
+
+	params.HalvingInterval = -1
+	require.Error(t, ValidateParams(params))
+}
+
+func TestUnlockAmountAt(t *testing.T) {
+	params := DefaultParams()
+	params.UnfreezeAmountPerBlock = 1000
+	params.UnlockSchedule = UnlockSchedule{{100, 800}, {200, 400}}
+	params.HalvingInterval = 50
+	params.HalvingDecay = sdk.NewDecWithPrec(5, 1)
+
+	tests := []struct {
+		height int64
+		amount int64
+	}{
+		{1, 1000},
+		{49, 1000},
+		{50, 500},
+		{99, 500},
+		{100, 800},
+		{149, 800},
+		{150, 400},
+		{199, 400},
+		{200, 400},
+		{250, 200},
+		{300, 100},
+		{200 + 50*20, 0},
+	}
+
+	for _, tc := range tests {
+		require.True(t, sdk.NewInt(tc.amount).Equal(params.UnlockAmountAt(tc.height)), "height %d", tc.height)
+	}
+}
+
+func TestProjectUnlock(t *testing.T) {
+	params := DefaultParams()
+	params.UnfreezeAmountPerBlock = 10
+	params.UnlockSchedule = UnlockSchedule{{21, 5}}
+
+	// 10 per block for heights 11-20, then 5 per block with a partial last block
+	projection := ProjectUnlock(params, sdk.NewInt(123), 10)
+	require.Equal(t, int64(25), projection.DepletionHeight)
+	require.Equal(t, []UnlockSegment{
+		{11, 20, sdk.NewInt(10), sdk.NewInt(100)},
+		{21, 25, sdk.NewInt(5), sdk.NewInt(23)},
+	}, projection.Curve)
+
+	// depleted exactly at the end of a segment
+	projection = ProjectUnlock(params, sdk.NewInt(100), 10)
+	require.Equal(t, int64(20), projection.DepletionHeight)
+	require.Len(t, projection.Curve, 1)
+
+	// nothing left to unlock
+	projection = ProjectUnlock(params, sdk.ZeroInt(), 10)
+	require.Equal(t, int64(10), projection.DepletionHeight)
+	require.Empty(t, projection.Curve)
+
+	// a decaying amount that reaches zero never depletes the tokens
+	params.UnlockSchedule = UnlockSchedule{}
+	params.HalvingInterval = 10
+	params.HalvingDecay = sdk.NewDecWithPrec(5, 1)
+	projection = ProjectUnlock(params, sdk.NewInt(1000000), 0)
+	require.Equal(t, int64(0), projection.DepletionHeight)
+	last := projection.Curve[len(projection.Curve)-1]
+	require.True(t, last.AmountPerBlock.IsZero())
+	require.Equal(t, int64(0), last.EndHeight)
+}
@@ -48,6 +48,8 @@ type (
 	ParamSetPair            = subspace.ParamSetPair
 	ParamSetPairs           = subspace.ParamSetPairs
 	ParamSet                = subspace.ParamSet
+	ValidatedParamSet       = subspace.ValidatedParamSet
+	DefaultedParamSet       = subspace.DefaultedParamSet
 	Subspace                = subspace.Subspace
 	ReadOnlySubspace        = subspace.ReadOnlySubspace
 	KeyTable                = subspace.KeyTable
@@ -75,7 +75,7 @@ Where proposal.json contains:
 			from := cliCtx.GetFromAddress()
 			content := types.NewParameterChangeProposal(proposal.Title, proposal.Description, proposal.Changes.ToParamChanges())
 
-			msg := gov.NewMsgSubmitProposal(content, proposal.Deposit, from)
+			msg := gov.NewMsgSubmitProposal(content, proposal.Deposit, from, proposal.IsExpedited)
 			if err := msg.ValidateBasic(); err != nil {
 				return err
 			}
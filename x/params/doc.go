@@ -99,6 +99,23 @@ be able to be passed to SetParamSet.
 The method is pointer receiver because there could be a case that we read from
 the store and set the result to the struct.
 
+A struct that also implements params.ValidatedParamSet is checked as a whole
+whenever one of its parameters is changed by a ParameterChangeProposal. Keys
+that are not stored yet keep their zero value during the check, unless the
+struct also implements params.DefaultedParamSet to provide their defaults.
+
+	func (p MyParams) ValidateParamSet() error {
+		if p.Parameter1 == 0 {
+			return fmt.Errorf("Parameter1 must be positive")
+		}
+		return nil
+	}
+
+	func (p MyParams) DefaultParamSet() params.ValidatedParamSet {
+		defaults := DefaultParams()
+		return &defaults
+	}
+
 Master Keeper Usage:
 
 Keepers that require master permission to the paramstore, such as gov, can take
@@ -1,6 +1,7 @@
 package params_test
 
 import (
+	"errors"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -127,3 +128,70 @@ func TestProposalHandlerUpdateOmitempty(t *testing.T) {
 	ss.Get(input.ctx, []byte(keySlashingRate), &param)
 	require.Equal(t, testParamsSlashingRate{10, 7}, param)
 }
+
+type testValidatedParams struct {
+	testParams
+}
+
+func (tp *testValidatedParams) ParamSetPairs() subspace.ParamSetPairs {
+	return tp.testParams.ParamSetPairs()
+}
+
+func (tp testValidatedParams) ValidateParamSet() error {
+	if tp.SlashingRate.Downtime > tp.MaxValidators {
+		return errors.New("downtime slashing rate exceeds max validators")
+	}
+	return nil
+}
+
+func TestProposalHandlerValidatedParamSet(t *testing.T) {
+	input := newTestInput(t)
+	ss := input.keeper.Subspace(testSubspace).WithKeyTable(
+		params.NewKeyTable().RegisterParamSet(&testValidatedParams{}),
+	)
+
+	hdlr := params.NewParamChangeProposalHandler(input.keeper)
+
+	tp := testProposal(params.NewParamChange(testSubspace, keySlashingRate, `{"downtime": 7}`))
+	require.Error(t, hdlr(input.ctx, tp))
+	require.False(t, ss.Has(input.ctx, []byte(keySlashingRate)))
+
+	tp = testProposal(params.NewParamChange(testSubspace, keyMaxValidators, "10"))
+	require.NoError(t, hdlr(input.ctx, tp))
+
+	tp = testProposal(params.NewParamChange(testSubspace, keySlashingRate, `{"downtime": 7}`))
+	require.NoError(t, hdlr(input.ctx, tp))
+
+	var param testParamsSlashingRate
+	ss.Get(input.ctx, []byte(keySlashingRate), &param)
+	require.Equal(t, testParamsSlashingRate{0, 7}, param)
+}
+
+type testDefaultedParams struct {
+	testValidatedParams
+}
+
+func (tp *testDefaultedParams) ParamSetPairs() subspace.ParamSetPairs {
+	return tp.testParams.ParamSetPairs()
+}
+
+func (tp testDefaultedParams) DefaultParamSet() subspace.ValidatedParamSet {
+	return &testDefaultedParams{testValidatedParams{testParams{MaxValidators: 10}}}
+}
+
+func TestProposalHandlerDefaultedParamSet(t *testing.T) {
+	input := newTestInput(t)
+	ss := input.keeper.Subspace(testSubspace).WithKeyTable(
+		params.NewKeyTable().RegisterParamSet(&testDefaultedParams{}),
+	)
+
+	hdlr := params.NewParamChangeProposalHandler(input.keeper)
+
+	// the unset max validators keeps its default during the check
+	tp := testProposal(params.NewParamChange(testSubspace, keySlashingRate, `{"downtime": 7}`))
+	require.NoError(t, hdlr(input.ctx, tp))
+	require.False(t, ss.Has(input.ctx, []byte(keyMaxValidators)))
+
+	tp = testProposal(params.NewParamChange(testSubspace, keySlashingRate, `{"downtime": 11}`))
+	require.Error(t, hdlr(input.ctx, tp))
+}
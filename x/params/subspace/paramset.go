@@ -13,3 +13,19 @@ type ParamSetPairs []ParamSetPair
 type ParamSet interface {
 	ParamSetPairs() ParamSetPairs
 }
+
+// Interface for parameter structs that check their values as a whole.
+// Parameter changes applied through Subspace.Update are validated against
+// the full set before they are stored.
+type ValidatedParamSet interface {
+	ParamSet
+	ValidateParamSet() error
+}
+
+// Interface for validated parameter structs with default values. Parameter
+// changes are validated against the defaults of the keys not stored yet,
+// e.g. the ones added to the struct after genesis, instead of zero values.
+type DefaultedParamSet interface {
+	ValidatedParamSet
+	DefaultParamSet() ValidatedParamSet
+}
@@ -1,6 +1,7 @@
 package subspace
 
 import (
+	"bytes"
 	"errors"
 	"reflect"
 
@@ -213,6 +214,13 @@ func (s Subspace) Update(ctx sdk.Context, key []byte, param []byte) error {
 		return err
 	}
 
+	if attr.set != nil {
+		err = s.validateParamSet(ctx, attr.set, key, dest)
+		if err != nil {
+			return err
+		}
+	}
+
 	s.Set(ctx, key, dest)
 	tStore := s.transientStore(ctx)
 	tStore.Set(key, []byte{})
@@ -220,6 +228,25 @@ func (s Subspace) Update(ctx sdk.Context, key []byte, param []byte) error {
 	return nil
 }
 
+// validateParamSet loads the stored ParamSet of the given type over its
+// defaults, replaces the parameter under key with the pointer value and
+// validates the result.
+func (s Subspace) validateParamSet(ctx sdk.Context, set reflect.Type, key []byte, value interface{}) error {
+	ps := reflect.New(set).Interface().(ValidatedParamSet)
+	if dps, ok := ps.(DefaultedParamSet); ok {
+		ps = dps.DefaultParamSet()
+	}
+	s.GetParamSetIfExists(ctx, ps)
+
+	for _, pair := range ps.ParamSetPairs() {
+		if bytes.Equal(pair.Key, key) {
+			reflect.ValueOf(pair.Value).Elem().Set(reflect.ValueOf(value).Elem())
+		}
+	}
+
+	return ps.ValidateParamSet()
+}
+
 // SetWithSubkey set a parameter with a key and subkey
 // Checks parameter type only over the key
 func (s Subspace) SetWithSubkey(ctx sdk.Context, key []byte, subkey []byte, param interface{}) {
@@ -271,6 +298,14 @@ func (s Subspace) GetParamSet(ctx sdk.Context, ps ParamSet) {
 	}
 }
 
+// GetParamSetIfExists gets from ParamSet, leaving the fields of
+// parameters that are not stored yet untouched
+func (s Subspace) GetParamSetIfExists(ctx sdk.Context, ps ParamSet) {
+	for _, pair := range ps.ParamSetPairs() {
+		s.GetIfExists(ctx, pair.Key, pair.Value)
+	}
+}
+
 // Set from ParamSet
 func (s Subspace) SetParamSet(ctx sdk.Context, ps ParamSet) {
 	for _, pair := range ps.ParamSetPairs() {
@@ -6,6 +6,9 @@ import (
 
 type attribute struct {
 	ty reflect.Type
+
+	// type of the ValidatedParamSet the key was registered with, if any
+	set reflect.Type
 }
 
 // KeyTable subspaces appropriate type for each parameter key
@@ -73,6 +76,18 @@ func (t KeyTable) RegisterParamSet(ps ParamSet) KeyTable {
 	for _, kvp := range ps.ParamSetPairs() {
 		t = t.RegisterType(kvp.Key, kvp.Value)
 	}
+
+	if _, ok := ps.(ValidatedParamSet); ok {
+		set := reflect.TypeOf(ps)
+		if set.Kind() != reflect.Ptr {
+			panic("ValidatedParamSet must be registered as a pointer")
+		}
+		for _, kvp := range ps.ParamSetPairs() {
+			attr := t.m[string(kvp.Key)]
+			attr.set = set.Elem()
+			t.m[string(kvp.Key)] = attr
+		}
+	}
 	return t
 }
 
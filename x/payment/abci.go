@@ -0,0 +1,10 @@
+package payment
+
+import (
+	sdk "github.com/shinecloudfoundation/shinecloudnet/types"
+)
+
+// EndBlocker makes the payments of the schedules due by the block time
+func EndBlocker(ctx sdk.Context, k Keeper) {
+	k.ProcessDuePayments(ctx)
+}
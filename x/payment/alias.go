@@ -0,0 +1,92 @@
+// nolint
+// autogenerated code using github.com/rigelrozanski/multitool
+// aliases generated for the following subdirectories:
+// ALIASGEN: github.com/shinecloudfoundation/shinecloudnet/x/payment/internal/keeper
+// ALIASGEN: github.com/shinecloudfoundation/shinecloudnet/x/payment/internal/types
+package payment
+
+import (
+	"github.com/shinecloudfoundation/shinecloudnet/x/payment/internal/keeper"
+	"github.com/shinecloudfoundation/shinecloudnet/x/payment/internal/types"
+)
+
+const (
+	DefaultCodespace        = types.DefaultCodespace
+	CodeUnknownSchedule     = types.CodeUnknownSchedule
+	CodeInvalidSchedule     = types.CodeInvalidSchedule
+	EventTypeCreateSchedule = types.EventTypeCreateSchedule
+	EventTypeCancelSchedule = types.EventTypeCancelSchedule
+	EventTypePayment        = types.EventTypePayment
+	AttributeKeyScheduleID  = types.AttributeKeyScheduleID
+	AttributeKeyPayer       = types.AttributeKeyPayer
+	AttributeKeyPayee       = types.AttributeKeyPayee
+	AttributeKeyAmount      = types.AttributeKeyAmount
+	AttributeKeyRefund      = types.AttributeKeyRefund
+	AttributeValueCategory  = types.AttributeValueCategory
+	ModuleName              = types.ModuleName
+	StoreKey                = types.StoreKey
+	RouterKey               = types.RouterKey
+	QuerierRoute            = types.QuerierRoute
+	TypeMsgCreateSchedule   = types.TypeMsgCreateSchedule
+	TypeMsgCancelSchedule   = types.TypeMsgCancelSchedule
+	QuerySchedule           = types.QuerySchedule
+	QueryPayerSchedules     = types.QueryPayerSchedules
+	QueryPayeeSchedules     = types.QueryPayeeSchedules
+	AttributeKeyPayments    = types.AttributeKeyPayments
+	DefaultParamspace       = types.DefaultParamspace
+	DefaultMinInterval      = types.DefaultMinInterval
+
+	DefaultMaxSchedulesPerBlock = types.DefaultMaxSchedulesPerBlock
+)
+
+var (
+	// functions aliases
+	NewKeeper               = keeper.NewKeeper
+	NewQuerier              = keeper.NewQuerier
+	RegisterInvariants      = keeper.RegisterInvariants
+	AllInvariants           = keeper.AllInvariants
+	ModuleAccountInvariant  = keeper.ModuleAccountInvariant
+	ScheduleQueueInvariant  = keeper.ScheduleQueueInvariant
+	RegisterCodec           = types.RegisterCodec
+	ErrUnknownSchedule      = types.ErrUnknownSchedule
+	ErrInvalidSchedule      = types.ErrInvalidSchedule
+	GetScheduleKey          = types.GetScheduleKey
+	ScheduleQueueByTimeKey  = types.ScheduleQueueByTimeKey
+	ScheduleQueueKey        = types.ScheduleQueueKey
+	GetPayerSchedulesKey    = types.GetPayerSchedulesKey
+	GetPayerScheduleKey     = types.GetPayerScheduleKey
+	GetPayeeSchedulesKey    = types.GetPayeeSchedulesKey
+	GetPayeeScheduleKey     = types.GetPayeeScheduleKey
+	SplitScheduleQueueKey   = types.SplitScheduleQueueKey
+	SplitAddressScheduleKey = types.SplitAddressScheduleKey
+	NewMsgCreateSchedule    = types.NewMsgCreateSchedule
+	NewMsgCancelSchedule    = types.NewMsgCancelSchedule
+	NewQueryScheduleParams  = types.NewQueryScheduleParams
+	NewQuerySchedulesParams = types.NewQuerySchedulesParams
+	NewSchedule             = types.NewSchedule
+	NewParams               = types.NewParams
+	DefaultParams           = types.DefaultParams
+	ParamKeyTable           = types.ParamKeyTable
+
+	// variable aliases
+	ModuleCdc               = types.ModuleCdc
+	SchedulesKeyPrefix      = types.SchedulesKeyPrefix
+	ScheduleQueueKeyPrefix  = types.ScheduleQueueKeyPrefix
+	NextScheduleIDKey       = types.NextScheduleIDKey
+	PayerSchedulesKeyPrefix = types.PayerSchedulesKeyPrefix
+	PayeeSchedulesKeyPrefix = types.PayeeSchedulesKeyPrefix
+
+	ParamStoreKeyMinInterval          = types.ParamStoreKeyMinInterval
+	ParamStoreKeyMaxSchedulesPerBlock = types.ParamStoreKeyMaxSchedulesPerBlock
+)
+
+type (
+	Keeper               = keeper.Keeper
+	Params               = types.Params
+	MsgCreateSchedule    = types.MsgCreateSchedule
+	MsgCancelSchedule    = types.MsgCancelSchedule
+	QueryScheduleParams  = types.QueryScheduleParams
+	QuerySchedulesParams = types.QuerySchedulesParams
+	Schedule             = types.Schedule
+	Schedules            = types.Schedules
+)
@@ -0,0 +1,112 @@
+package cli
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/spf13/cobra"
+
+	"github.com/shinecloudfoundation/shinecloudnet/client"
+	"github.com/shinecloudfoundation/shinecloudnet/client/context"
+	"github.com/shinecloudfoundation/shinecloudnet/codec"
+	sdk "github.com/shinecloudfoundation/shinecloudnet/types"
+	"github.com/shinecloudfoundation/shinecloudnet/x/payment/internal/types"
+)
+
+// GetQueryCmd returns the cli query commands for this module
+func GetQueryCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	paymentQueryCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Querying commands for the payment module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	paymentQueryCmd.AddCommand(client.GetCommands(
+		GetCmdQuerySchedule(queryRoute, cdc),
+		GetCmdQueryPayerSchedules(queryRoute, cdc),
+		GetCmdQueryPayeeSchedules(queryRoute, cdc),
+	)...)
+
+	return paymentQueryCmd
+}
+
+// GetCmdQuerySchedule implements the query schedule command
+func GetCmdQuerySchedule(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "schedule [schedule_id]",
+		Short: "Query a payment schedule",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			scheduleID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("schedule id %s not a valid uint, please input a valid schedule id", args[0])
+			}
+
+			bz, err := cdc.MarshalJSON(types.NewQueryScheduleParams(scheduleID))
+			if err != nil {
+				return err
+			}
+
+			route := fmt.Sprintf("custom/%s/%s", queryRoute, types.QuerySchedule)
+			res, _, err := cliCtx.QueryWithData(route, bz)
+			if err != nil {
+				return err
+			}
+
+			var schedule types.Schedule
+			cdc.MustUnmarshalJSON(res, &schedule)
+			return cliCtx.PrintOutput(schedule)
+		},
+	}
+}
+
+// GetCmdQueryPayerSchedules implements the query payer schedules command
+func GetCmdQueryPayerSchedules(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "payer-schedules [payer]",
+		Short: "Query the payment schedules paid by an account",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return querySchedules(cdc, fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryPayerSchedules), args[0])
+		},
+	}
+}
+
+// GetCmdQueryPayeeSchedules implements the query payee schedules command
+func GetCmdQueryPayeeSchedules(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "payee-schedules [payee]",
+		Short: "Query the payment schedules paying an account",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return querySchedules(cdc, fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryPayeeSchedules), args[0])
+		},
+	}
+}
+
+func querySchedules(cdc *codec.Codec, route, bech32Addr string) error {
+	cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+	addr, err := sdk.AccAddressFromBech32(bech32Addr)
+	if err != nil {
+		return err
+	}
+
+	bz, err := cdc.MarshalJSON(types.NewQuerySchedulesParams(addr))
+	if err != nil {
+		return err
+	}
+
+	res, _, err := cliCtx.QueryWithData(route, bz)
+	if err != nil {
+		return err
+	}
+
+	var schedules types.Schedules
+	cdc.MustUnmarshalJSON(res, &schedules)
+	return cliCtx.PrintOutput(schedules)
+}
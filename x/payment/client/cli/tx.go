@@ -0,0 +1,138 @@
+package cli
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/shinecloudfoundation/shinecloudnet/client"
+	"github.com/shinecloudfoundation/shinecloudnet/client/context"
+	"github.com/shinecloudfoundation/shinecloudnet/codec"
+	sdk "github.com/shinecloudfoundation/shinecloudnet/types"
+	"github.com/shinecloudfoundation/shinecloudnet/version"
+	"github.com/shinecloudfoundation/shinecloudnet/x/auth"
+	"github.com/shinecloudfoundation/shinecloudnet/x/auth/client/utils"
+	"github.com/shinecloudfoundation/shinecloudnet/x/payment/internal/types"
+)
+
+const (
+	flagStartTime = "start-time"
+)
+
+// GetTxCmd returns the transaction commands for this module
+func GetTxCmd(cdc *codec.Codec) *cobra.Command {
+	paymentTxCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Payment transaction subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	paymentTxCmd.AddCommand(client.PostCommands(
+		GetCmdCreateSchedule(cdc),
+		GetCmdCancelSchedule(cdc),
+	)...)
+
+	return paymentTxCmd
+}
+
+// GetCmdCreateSchedule implements the command to create a payment schedule
+func GetCmdCreateSchedule(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-schedule [payee] [amount] [interval] [num_payments]",
+		Short: "Create a schedule paying an amount to a payee at a regular interval",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Create a schedule paying the amount to the payee num_payments times, every
+interval (e.g. 720h) from the start time on. The start time (unix epoch)
+defaults to the block time. The amount of all the payments is escrowed from
+the sender's account when the schedule is created; the escrow of the remaining
+payments is refunded if the schedule is cancelled.
+
+Example:
+$ %s tx %s create-schedule scloud1skjw... 1000uscds 720h 12 --start-time=1640995200 --from=mykey
+`,
+				version.ClientName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			payee, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			amount, err := sdk.ParseCoins(args[1])
+			if err != nil {
+				return err
+			}
+
+			interval, err := time.ParseDuration(args[2])
+			if err != nil {
+				return err
+			}
+
+			numPayments, err := strconv.ParseUint(args[3], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			var startTime time.Time
+			if unix := viper.GetInt64(flagStartTime); unix != 0 {
+				startTime = time.Unix(unix, 0).UTC()
+			}
+
+			msg := types.NewMsgCreateSchedule(cliCtx.GetFromAddress(), payee, amount, startTime, interval, numPayments)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.Flags().Int64(flagStartTime, 0, "Time (unix epoch) of the first payment, the block time if zero")
+	return cmd
+}
+
+// GetCmdCancelSchedule implements the command to cancel a payment schedule
+func GetCmdCancelSchedule(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "cancel-schedule [schedule_id]",
+		Short: "Cancel a payment schedule and refund the escrow of its remaining payments",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Cancel a payment schedule created by the sender. The escrow of the payments
+that haven't been made yet is refunded to the sender.
+
+Example:
+$ %s tx %s cancel-schedule 1 --from=mykey
+`,
+				version.ClientName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			scheduleID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("schedule id %s not a valid uint, please input a valid schedule id", args[0])
+			}
+
+			msg := types.NewMsgCancelSchedule(cliCtx.GetFromAddress(), scheduleID)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
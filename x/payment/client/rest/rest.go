@@ -0,0 +1,20 @@
+package rest
+
+import (
+	"github.com/gorilla/mux"
+
+	"github.com/shinecloudfoundation/shinecloudnet/client/context"
+	"github.com/shinecloudfoundation/shinecloudnet/x/payment/internal/types"
+)
+
+// RegisterRoutes - Central function to define routes that get registered by the main application
+func RegisterRoutes(cliCtx context.CLIContext, r *mux.Router) {
+	r.HandleFunc("/payment/schedules", createScheduleHandlerFn(cliCtx)).Methods("POST")
+	r.HandleFunc("/payment/schedules/{scheduleID}/cancel", cancelScheduleHandlerFn(cliCtx)).Methods("POST")
+
+	r.HandleFunc("/payment/schedules/{scheduleID}", scheduleHandlerFn(cliCtx, types.QuerierRoute)).Methods("GET")
+	r.HandleFunc("/payment/payers/{address}/schedules",
+		schedulesHandlerFn(cliCtx, types.QuerierRoute, types.QueryPayerSchedules)).Methods("GET")
+	r.HandleFunc("/payment/payees/{address}/schedules",
+		schedulesHandlerFn(cliCtx, types.QuerierRoute, types.QueryPayeeSchedules)).Methods("GET")
+}
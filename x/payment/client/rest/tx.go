@@ -0,0 +1,105 @@
+package rest
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/gorilla/mux"
+
+	"github.com/shinecloudfoundation/shinecloudnet/client/context"
+	sdk "github.com/shinecloudfoundation/shinecloudnet/types"
+	"github.com/shinecloudfoundation/shinecloudnet/types/rest"
+	"github.com/shinecloudfoundation/shinecloudnet/x/auth/client/utils"
+	"github.com/shinecloudfoundation/shinecloudnet/x/payment/internal/types"
+)
+
+// CreateScheduleReq defines the properties of a create schedule request's
+// body. StartTime is a unix epoch, the block time if zero, and Interval a
+// duration string such as "720h".
+type CreateScheduleReq struct {
+	BaseReq     rest.BaseReq   `json:"base_req" yaml:"base_req"`
+	Payee       sdk.AccAddress `json:"payee" yaml:"payee"`
+	Amount      sdk.Coins      `json:"amount" yaml:"amount"`
+	StartTime   int64          `json:"start_time" yaml:"start_time"`
+	Interval    string         `json:"interval" yaml:"interval"`
+	NumPayments uint64         `json:"num_payments" yaml:"num_payments"`
+}
+
+// CancelScheduleReq defines the properties of a cancel schedule request's body.
+type CancelScheduleReq struct {
+	BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+}
+
+// createScheduleHandlerFn - http request handler to create a payment schedule
+func createScheduleHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req CreateScheduleReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		fromAddr, err := sdk.AccAddressFromBech32(req.BaseReq.From)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		interval, err := time.ParseDuration(req.Interval)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		var startTime time.Time
+		if req.StartTime != 0 {
+			startTime = time.Unix(req.StartTime, 0).UTC()
+		}
+
+		msg := types.NewMsgCreateSchedule(fromAddr, req.Payee, req.Amount, startTime, interval, req.NumPayments)
+		if err := msg.ValidateBasic(); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		utils.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
+
+// cancelScheduleHandlerFn - http request handler to cancel a payment schedule
+func cancelScheduleHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		scheduleID, ok := rest.ParseUint64OrReturnBadRequest(w, mux.Vars(r)["scheduleID"])
+		if !ok {
+			return
+		}
+
+		var req CancelScheduleReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		fromAddr, err := sdk.AccAddressFromBech32(req.BaseReq.From)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		msg := types.NewMsgCancelSchedule(fromAddr, scheduleID)
+		if err := msg.ValidateBasic(); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		utils.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
@@ -0,0 +1,95 @@
+package payment
+
+import (
+	"fmt"
+
+	sdk "github.com/shinecloudfoundation/shinecloudnet/types"
+)
+
+// GenesisState contains the payment parameters, the payment schedules and
+// the id of the next one
+type GenesisState struct {
+	Params         Params    `json:"params" yaml:"params"`
+	NextScheduleID uint64    `json:"next_schedule_id" yaml:"next_schedule_id"`
+	Schedules      Schedules `json:"schedules" yaml:"schedules"`
+}
+
+// NewGenesisState creates a new GenesisState object
+func NewGenesisState(params Params, nextScheduleID uint64, schedules Schedules) GenesisState {
+	return GenesisState{
+		Params:         params,
+		NextScheduleID: nextScheduleID,
+		Schedules:      schedules,
+	}
+}
+
+// DefaultGenesisState returns a default genesis state
+func DefaultGenesisState() GenesisState {
+	return NewGenesisState(DefaultParams(), 1, Schedules{})
+}
+
+// InitGenesis stores the schedules of the genesis state and queues their next
+// payments. The module account is funded with their escrow if it doesn't
+// hold any coins yet.
+func InitGenesis(ctx sdk.Context, k Keeper, data GenesisState) {
+	k.SetParams(ctx, data.Params)
+	k.SetNextScheduleID(ctx, data.NextScheduleID)
+
+	// check if the escrow account exists
+	moduleAcc := k.GetPaymentAccount(ctx)
+	if moduleAcc == nil {
+		panic(fmt.Sprintf("%s module account has not been set", ModuleName))
+	}
+
+	var totalEscrow sdk.Coins
+	for _, schedule := range data.Schedules {
+		k.SetSchedule(ctx, schedule)
+		k.InsertScheduleQueue(ctx, schedule.ID, schedule.NextPaymentTime())
+		totalEscrow = totalEscrow.Add(schedule.Escrow())
+	}
+
+	// add coins if not provided on genesis
+	if moduleAcc.GetCoins().IsZero() {
+		if err := moduleAcc.SetCoins(totalEscrow); err != nil {
+			panic(err)
+		}
+		k.SetPaymentAccount(ctx, moduleAcc)
+	}
+}
+
+// ExportGenesis returns a GenesisState with all the schedules
+func ExportGenesis(ctx sdk.Context, k Keeper) GenesisState {
+	schedules := Schedules{}
+	k.IterateSchedules(ctx, func(schedule Schedule) bool {
+		schedules = append(schedules, schedule)
+		return false
+	})
+	return NewGenesisState(k.GetParams(ctx), k.GetNextScheduleID(ctx), schedules)
+}
+
+// ValidateGenesis performs basic validation of the payment genesis data
+func ValidateGenesis(data GenesisState) error {
+	if err := data.Params.ValidateParamSet(); err != nil {
+		return err
+	}
+	if data.NextScheduleID == 0 {
+		return fmt.Errorf("next schedule id must be positive")
+	}
+
+	ids := make(map[uint64]bool, len(data.Schedules))
+	for _, schedule := range data.Schedules {
+		if schedule.ID == 0 || schedule.ID >= data.NextScheduleID {
+			return fmt.Errorf("schedule id %d must be positive and less than the next schedule id %d",
+				schedule.ID, data.NextScheduleID)
+		}
+		if ids[schedule.ID] {
+			return fmt.Errorf("duplicate schedule id %d", schedule.ID)
+		}
+		ids[schedule.ID] = true
+
+		if err := schedule.ValidateBasic(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,76 @@
+package payment
+
+import (
+	"fmt"
+
+	sdk "github.com/shinecloudfoundation/shinecloudnet/types"
+)
+
+// NewHandler returns a handler for "payment" type messages.
+func NewHandler(k Keeper) sdk.Handler {
+	return func(ctx sdk.Context, msg sdk.Msg) sdk.Result {
+		ctx = ctx.WithEventManager(sdk.NewEventManager())
+
+		switch msg := msg.(type) {
+		case MsgCreateSchedule:
+			return handleMsgCreateSchedule(ctx, k, msg)
+
+		case MsgCancelSchedule:
+			return handleMsgCancelSchedule(ctx, k, msg)
+
+		default:
+			errMsg := fmt.Sprintf("unrecognized payment message type: %T", msg)
+			return sdk.ErrUnknownRequest(errMsg).Result()
+		}
+	}
+}
+
+func handleMsgCreateSchedule(ctx sdk.Context, k Keeper, msg MsgCreateSchedule) sdk.Result {
+	schedule, err := k.CreateSchedule(ctx, msg.Payer, msg.Payee, msg.Amount, msg.StartTime, msg.Interval, msg.NumPayments)
+	if err != nil {
+		return err.Result()
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			EventTypeCreateSchedule,
+			sdk.NewAttribute(AttributeKeyScheduleID, fmt.Sprintf("%d", schedule.ID)),
+			sdk.NewAttribute(AttributeKeyPayer, msg.Payer.String()),
+			sdk.NewAttribute(AttributeKeyPayee, msg.Payee.String()),
+			sdk.NewAttribute(AttributeKeyAmount, msg.Amount.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Payer.String()),
+		),
+	})
+
+	return sdk.Result{
+		Data:   ModuleCdc.MustMarshalBinaryLengthPrefixed(schedule.ID),
+		Events: ctx.EventManager().Events(),
+	}
+}
+
+func handleMsgCancelSchedule(ctx sdk.Context, k Keeper, msg MsgCancelSchedule) sdk.Result {
+	refund, err := k.CancelSchedule(ctx, msg.Payer, msg.ScheduleID)
+	if err != nil {
+		return err.Result()
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			EventTypeCancelSchedule,
+			sdk.NewAttribute(AttributeKeyScheduleID, fmt.Sprintf("%d", msg.ScheduleID)),
+			sdk.NewAttribute(AttributeKeyPayer, msg.Payer.String()),
+			sdk.NewAttribute(AttributeKeyRefund, refund.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Payer.String()),
+		),
+	})
+
+	return sdk.Result{Events: ctx.EventManager().Events()}
+}
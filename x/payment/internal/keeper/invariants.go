@@ -0,0 +1,68 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/shinecloudfoundation/shinecloudnet/types"
+	"github.com/shinecloudfoundation/shinecloudnet/x/payment/internal/types"
+)
+
+// RegisterInvariants registers all payment invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(types.ModuleName, "module-account", ModuleAccountInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "schedule-queue", ScheduleQueueInvariant(k))
+}
+
+// AllInvariants runs all invariants of the payment module
+func AllInvariants(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		res, stop := ModuleAccountInvariant(k)(ctx)
+		if stop {
+			return res, stop
+		}
+		return ScheduleQueueInvariant(k)(ctx)
+	}
+}
+
+// ModuleAccountInvariant checks that the module account coins reflect the
+// escrow of the remaining payments of all the schedules
+func ModuleAccountInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var expectedEscrow sdk.Coins
+
+		k.IterateSchedules(ctx, func(schedule types.Schedule) bool {
+			expectedEscrow = expectedEscrow.Add(schedule.Escrow())
+			return false
+		})
+
+		macc := k.GetPaymentAccount(ctx)
+		broken := !macc.GetCoins().IsEqual(expectedEscrow)
+
+		return sdk.FormatInvariant(types.ModuleName, "module account",
+			fmt.Sprintf("\tpayment ModuleAccount coins: %s\n\tsum of schedule escrows:     %s\n",
+				macc.GetCoins(), expectedEscrow)), broken
+	}
+}
+
+// ScheduleQueueInvariant checks that every unfinished schedule is queued at
+// the time of its next payment
+func ScheduleQueueInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg    string
+			broken bool
+		)
+
+		store := ctx.KVStore(k.storeKey)
+		k.IterateSchedules(ctx, func(schedule types.Schedule) bool {
+			if schedule.IsDone() || !store.Has(types.ScheduleQueueKey(schedule.ID, schedule.NextPaymentTime())) {
+				msg += fmt.Sprintf("\tschedule %d isn't queued at its next payment time %s\n",
+					schedule.ID, schedule.NextPaymentTime())
+				broken = true
+			}
+			return false
+		})
+
+		return sdk.FormatInvariant(types.ModuleName, "schedule queue", msg), broken
+	}
+}
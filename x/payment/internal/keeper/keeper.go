@@ -0,0 +1,329 @@
+package keeper
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/shinecloudfoundation/shinecloudnet/codec"
+	sdk "github.com/shinecloudfoundation/shinecloudnet/types"
+	"github.com/shinecloudfoundation/shinecloudnet/x/params"
+	"github.com/shinecloudfoundation/shinecloudnet/x/payment/internal/types"
+	supplyexported "github.com/shinecloudfoundation/shinecloudnet/x/supply/exported"
+)
+
+// Keeper manages the payment schedules and the escrow of their remaining
+// payments held by the module account
+type Keeper struct {
+	cdc          *codec.Codec
+	storeKey     sdk.StoreKey
+	paramSpace   params.Subspace
+	bankKeeper   types.BankKeeper
+	supplyKeeper types.SupplyKeeper
+	codespace    sdk.CodespaceType
+}
+
+// NewKeeper creates a new payment Keeper instance
+func NewKeeper(cdc *codec.Codec, key sdk.StoreKey, paramSpace params.Subspace, bk types.BankKeeper,
+	sk types.SupplyKeeper, codespace sdk.CodespaceType) Keeper {
+
+	return Keeper{
+		cdc:          cdc,
+		storeKey:     key,
+		paramSpace:   paramSpace.WithKeyTable(types.ParamKeyTable()),
+		bankKeeper:   bk,
+		supplyKeeper: sk,
+		codespace:    codespace,
+	}
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
+}
+
+// Codespace returns the keeper's codespace.
+func (k Keeper) Codespace() sdk.CodespaceType {
+	return k.codespace
+}
+
+// GetPaymentAccount returns the module account holding the escrow of the
+// schedules
+func (k Keeper) GetPaymentAccount(ctx sdk.Context) supplyexported.ModuleAccountI {
+	return k.supplyKeeper.GetModuleAccount(ctx, types.ModuleName)
+}
+
+// SetPaymentAccount sets the module account holding the escrow of the
+// schedules
+func (k Keeper) SetPaymentAccount(ctx sdk.Context, macc supplyexported.ModuleAccountI) {
+	k.supplyKeeper.SetModuleAccount(ctx, macc)
+}
+
+// CreateSchedule escrows the payments of a new schedule from the payer into
+// the module account and queues its first payment
+func (k Keeper) CreateSchedule(ctx sdk.Context, payer, payee sdk.AccAddress, amount sdk.Coins,
+	startTime time.Time, interval time.Duration, numPayments uint64) (types.Schedule, sdk.Error) {
+
+	if err := k.bankKeeper.IsSendEnabledCoins(ctx, amount...); err != nil {
+		return types.Schedule{}, err
+	}
+	if k.bankKeeper.BlockedAddr(ctx, payee) {
+		return types.Schedule{}, sdk.ErrUnauthorized(fmt.Sprintf("%s is not allowed to receive transactions", payee))
+	}
+
+	blockTime := ctx.BlockHeader().Time
+	if startTime.IsZero() {
+		startTime = blockTime
+	}
+	if startTime.Before(blockTime) {
+		return types.Schedule{}, types.ErrInvalidSchedule(k.codespace, "start time must not be in the past")
+	}
+	if minInterval := k.GetParams(ctx).MinInterval; interval < minInterval {
+		return types.Schedule{}, types.ErrInvalidSchedule(k.codespace,
+			fmt.Sprintf("interval %s is shorter than the minimum interval %s", interval, minInterval))
+	}
+
+	schedule := types.NewSchedule(k.GetNextScheduleID(ctx), payer, payee, amount, startTime, interval, numPayments)
+	if err := schedule.ValidateBasic(); err != nil {
+		return types.Schedule{}, err
+	}
+
+	if err := k.supplyKeeper.SendCoinsFromAccountToModule(ctx, payer, types.ModuleName, schedule.Escrow()); err != nil {
+		return types.Schedule{}, err
+	}
+
+	k.SetNextScheduleID(ctx, schedule.ID+1)
+	k.SetSchedule(ctx, schedule)
+	k.InsertScheduleQueue(ctx, schedule.ID, schedule.NextPaymentTime())
+	return schedule, nil
+}
+
+// CancelSchedule deletes a schedule of payer and refunds the escrow of its
+// remaining payments
+func (k Keeper) CancelSchedule(ctx sdk.Context, payer sdk.AccAddress, scheduleID uint64) (sdk.Coins, sdk.Error) {
+	schedule, found := k.GetSchedule(ctx, scheduleID)
+	if !found {
+		return nil, types.ErrUnknownSchedule(k.codespace, scheduleID)
+	}
+	if !schedule.Payer.Equals(payer) {
+		return nil, sdk.ErrUnauthorized(fmt.Sprintf("%s is not the payer of schedule %d", payer, scheduleID))
+	}
+
+	refund := schedule.Escrow()
+	if err := k.supplyKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, payer, refund); err != nil {
+		return nil, err
+	}
+
+	k.RemoveFromScheduleQueue(ctx, schedule.ID, schedule.NextPaymentTime())
+	k.DeleteSchedule(ctx, schedule)
+	return refund, nil
+}
+
+// ProcessDuePayments makes the payments of the queued schedules that are due
+// by the block time. Schedules that missed several payments, e.g. after a
+// halt, make all of them in a single transfer. At most MaxSchedulesPerBlock
+// schedules are paid, the others stay queued for the next blocks. Finished
+// schedules are deleted. Payments of denoms that can't be sent, or to payees
+// that are blocked from receiving funds, are held in escrow and postponed to
+// the next interval.
+func (k Keeper) ProcessDuePayments(ctx sdk.Context) {
+	blockTime := ctx.BlockHeader().Time
+	maxSchedules := int(k.GetParams(ctx).MaxSchedulesPerBlock)
+
+	// the queue is updated while processing, so the due schedules are
+	// collected first
+	var due types.Schedules
+	k.IterateScheduleQueue(ctx, blockTime, func(schedule types.Schedule) bool {
+		due = append(due, schedule)
+		return len(due) >= maxSchedules
+	})
+
+	for _, schedule := range due {
+		k.RemoveFromScheduleQueue(ctx, schedule.ID, schedule.NextPaymentTime())
+
+		// the escrow always covers the remaining payments
+		dueCount := schedule.DuePayments(blockTime)
+		amount := schedule.AmountOf(dueCount)
+		if err := k.bankKeeper.IsSendEnabledCoins(ctx, amount...); err != nil {
+			k.holdPayments(ctx, schedule, amount, types.AttributeValueSendDisabled)
+			continue
+		}
+		if k.bankKeeper.BlockedAddr(ctx, schedule.Payee) {
+			k.holdPayments(ctx, schedule, amount, types.AttributeValueBlockedPayee)
+			continue
+		}
+		if err := k.supplyKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, schedule.Payee, amount); err != nil {
+			panic(err)
+		}
+		schedule.PaymentsMade += dueCount
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypePayment,
+				sdk.NewAttribute(types.AttributeKeyScheduleID, fmt.Sprintf("%d", schedule.ID)),
+				sdk.NewAttribute(types.AttributeKeyPayer, schedule.Payer.String()),
+				sdk.NewAttribute(types.AttributeKeyPayee, schedule.Payee.String()),
+				sdk.NewAttribute(types.AttributeKeyAmount, amount.String()),
+				sdk.NewAttribute(types.AttributeKeyPayments, fmt.Sprintf("%d", dueCount)),
+			),
+		)
+
+		if schedule.IsDone() {
+			k.DeleteSchedule(ctx, schedule)
+			k.Logger(ctx).Info(fmt.Sprintf("payment schedule %d finished", schedule.ID))
+			continue
+		}
+
+		k.SetSchedule(ctx, schedule)
+		k.InsertScheduleQueue(ctx, schedule.ID, schedule.NextPaymentTime())
+	}
+}
+
+// holdPayments postpones the due payments of a schedule, keeping them in
+// escrow until they can be made
+func (k Keeper) holdPayments(ctx sdk.Context, schedule types.Schedule, amount sdk.Coins, reason string) {
+	schedule = schedule.Postpone(ctx.BlockHeader().Time)
+	k.SetSchedule(ctx, schedule)
+	k.InsertScheduleQueue(ctx, schedule.ID, schedule.NextPaymentTime())
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeHoldPayment,
+			sdk.NewAttribute(types.AttributeKeyScheduleID, fmt.Sprintf("%d", schedule.ID)),
+			sdk.NewAttribute(types.AttributeKeyPayer, schedule.Payer.String()),
+			sdk.NewAttribute(types.AttributeKeyPayee, schedule.Payee.String()),
+			sdk.NewAttribute(types.AttributeKeyAmount, amount.String()),
+			sdk.NewAttribute(types.AttributeKeyReason, reason),
+		),
+	)
+	k.Logger(ctx).Info(fmt.Sprintf("payment schedule %d held until %s: %s", schedule.ID, schedule.NextPaymentTime(), reason))
+}
+
+// GetNextScheduleID returns the id of the next schedule
+func (k Keeper) GetNextScheduleID(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.NextScheduleIDKey)
+	if bz == nil {
+		return 1
+	}
+
+	var id uint64
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &id)
+	return id
+}
+
+// SetNextScheduleID sets the id of the next schedule
+func (k Keeper) SetNextScheduleID(ctx sdk.Context, id uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.NextScheduleIDKey, k.cdc.MustMarshalBinaryLengthPrefixed(id))
+}
+
+// GetSchedule returns the schedule with the given id
+func (k Keeper) GetSchedule(ctx sdk.Context, scheduleID uint64) (schedule types.Schedule, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetScheduleKey(scheduleID))
+	if bz == nil {
+		return schedule, false
+	}
+
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &schedule)
+	return schedule, true
+}
+
+// SetSchedule stores a schedule and indexes it by payer and payee
+func (k Keeper) SetSchedule(ctx sdk.Context, schedule types.Schedule) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetScheduleKey(schedule.ID), k.cdc.MustMarshalBinaryLengthPrefixed(schedule))
+	store.Set(types.GetPayerScheduleKey(schedule.Payer, schedule.ID), []byte{})
+	store.Set(types.GetPayeeScheduleKey(schedule.Payee, schedule.ID), []byte{})
+}
+
+// DeleteSchedule removes a schedule and its payer and payee indexes
+func (k Keeper) DeleteSchedule(ctx sdk.Context, schedule types.Schedule) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetScheduleKey(schedule.ID))
+	store.Delete(types.GetPayerScheduleKey(schedule.Payer, schedule.ID))
+	store.Delete(types.GetPayeeScheduleKey(schedule.Payee, schedule.ID))
+}
+
+// InsertScheduleQueue inserts a scheduleID into the schedule queue at the
+// time of its next payment
+func (k Keeper) InsertScheduleQueue(ctx sdk.Context, scheduleID uint64, paymentTime time.Time) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.ScheduleQueueKey(scheduleID, paymentTime), sdk.Uint64ToBigEndian(scheduleID))
+}
+
+// RemoveFromScheduleQueue removes a scheduleID from the schedule queue
+func (k Keeper) RemoveFromScheduleQueue(ctx sdk.Context, scheduleID uint64, paymentTime time.Time) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.ScheduleQueueKey(scheduleID, paymentTime))
+}
+
+// ScheduleQueueIterator returns an sdk.Iterator for all the schedules in the
+// queue with a payment due by paymentTime
+func (k Keeper) ScheduleQueueIterator(ctx sdk.Context, paymentTime time.Time) sdk.Iterator {
+	store := ctx.KVStore(k.storeKey)
+	return store.Iterator(types.ScheduleQueueKeyPrefix, sdk.PrefixEndBytes(types.ScheduleQueueByTimeKey(paymentTime)))
+}
+
+// IterateScheduleQueue iterates over the schedules in the queue with a
+// payment due by paymentTime, in payment time order
+func (k Keeper) IterateScheduleQueue(ctx sdk.Context, paymentTime time.Time, cb func(schedule types.Schedule) (stop bool)) {
+	iterator := k.ScheduleQueueIterator(ctx, paymentTime)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		scheduleID, _ := types.SplitScheduleQueueKey(iterator.Key())
+		schedule, found := k.GetSchedule(ctx, scheduleID)
+		if !found {
+			panic(fmt.Sprintf("payment schedule %d does not exist", scheduleID))
+		}
+
+		if cb(schedule) {
+			break
+		}
+	}
+}
+
+// IterateSchedules iterates over all the schedules
+func (k Keeper) IterateSchedules(ctx sdk.Context, cb func(schedule types.Schedule) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.SchedulesKeyPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var schedule types.Schedule
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &schedule)
+		if cb(schedule) {
+			break
+		}
+	}
+}
+
+// GetSchedulesByPayer returns the schedules paid by payer
+func (k Keeper) GetSchedulesByPayer(ctx sdk.Context, payer sdk.AccAddress) types.Schedules {
+	return k.getIndexedSchedules(ctx, types.GetPayerSchedulesKey(payer))
+}
+
+// GetSchedulesByPayee returns the schedules paying payee
+func (k Keeper) GetSchedulesByPayee(ctx sdk.Context, payee sdk.AccAddress) types.Schedules {
+	return k.getIndexedSchedules(ctx, types.GetPayeeSchedulesKey(payee))
+}
+
+func (k Keeper) getIndexedSchedules(ctx sdk.Context, prefix []byte) types.Schedules {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, prefix)
+	defer iterator.Close()
+
+	schedules := types.Schedules{}
+	for ; iterator.Valid(); iterator.Next() {
+		scheduleID := types.SplitAddressScheduleKey(iterator.Key())
+		schedule, found := k.GetSchedule(ctx, scheduleID)
+		if !found {
+			panic(fmt.Sprintf("payment schedule %d does not exist", scheduleID))
+		}
+		schedules = append(schedules, schedule)
+	}
+	return schedules
+}
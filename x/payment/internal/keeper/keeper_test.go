@@ -0,0 +1,329 @@
+package keeper
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto"
+
+	sdk "github.com/shinecloudfoundation/shinecloudnet/types"
+	"github.com/shinecloudfoundation/shinecloudnet/x/bank"
+	"github.com/shinecloudfoundation/shinecloudnet/x/payment/internal/types"
+)
+
+var (
+	payer = sdk.AccAddress(crypto.AddressHash([]byte("payer")))
+	payee = sdk.AccAddress(crypto.AddressHash([]byte("payee")))
+)
+
+func atoms(amt int64) sdk.Coins {
+	return sdk.NewCoins(sdk.NewInt64Coin("atom", amt))
+}
+
+func TestCreateSchedule(t *testing.T) {
+	ctx, keeper, ak, _ := SetupTestInput()
+	now := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx = ctx.WithBlockTime(now)
+
+	acc := ak.NewAccountWithAddress(ctx, payer)
+	require.NoError(t, acc.SetCoins(atoms(100)))
+	ak.SetAccount(ctx, acc)
+
+	// the escrow of all the payments must be covered
+	_, err := keeper.CreateSchedule(ctx, payer, payee, atoms(30), time.Time{}, time.Hour, 4)
+	require.Error(t, err)
+
+	// the start time must not be in the past
+	_, err = keeper.CreateSchedule(ctx, payer, payee, atoms(10), now.Add(-time.Hour), time.Hour, 3)
+	require.Error(t, err)
+
+	schedule, err := keeper.CreateSchedule(ctx, payer, payee, atoms(10), time.Time{}, time.Hour, 3)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), schedule.ID)
+	require.Equal(t, now, schedule.StartTime)
+	require.Equal(t, uint64(2), keeper.GetNextScheduleID(ctx))
+
+	stored, found := keeper.GetSchedule(ctx, schedule.ID)
+	require.True(t, found)
+	require.Equal(t, schedule, stored)
+	require.Equal(t, types.Schedules{schedule}, keeper.GetSchedulesByPayer(ctx, payer))
+	require.Equal(t, types.Schedules{schedule}, keeper.GetSchedulesByPayee(ctx, payee))
+	require.Empty(t, keeper.GetSchedulesByPayer(ctx, payee))
+
+	require.Equal(t, atoms(70), ak.GetAccount(ctx, payer).GetCoins())
+	require.Equal(t, atoms(30), keeper.GetPaymentAccount(ctx).GetCoins())
+
+	_, broken := AllInvariants(keeper)(ctx)
+	require.False(t, broken)
+}
+
+func TestProcessDuePayments(t *testing.T) {
+	ctx, keeper, ak, _ := SetupTestInput()
+	now := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx = ctx.WithBlockTime(now)
+
+	acc := ak.NewAccountWithAddress(ctx, payer)
+	require.NoError(t, acc.SetCoins(atoms(100)))
+	ak.SetAccount(ctx, acc)
+
+	schedule, err := keeper.CreateSchedule(ctx, payer, payee, atoms(10), now.Add(time.Hour), time.Hour, 3)
+	require.NoError(t, err)
+
+	// nothing is due before the start time
+	keeper.ProcessDuePayments(ctx.WithBlockTime(now.Add(time.Minute)))
+	require.Nil(t, ak.GetAccount(ctx, payee))
+
+	ctx = ctx.WithBlockTime(now.Add(time.Hour))
+	keeper.ProcessDuePayments(ctx)
+	require.Equal(t, atoms(10), ak.GetAccount(ctx, payee).GetCoins())
+
+	schedule, found := keeper.GetSchedule(ctx, schedule.ID)
+	require.True(t, found)
+	require.Equal(t, uint64(1), schedule.PaymentsMade)
+	require.Equal(t, now.Add(2*time.Hour), schedule.NextPaymentTime())
+
+	_, broken := AllInvariants(keeper)(ctx)
+	require.False(t, broken)
+
+	// missed payments are all made, and the finished schedule is deleted
+	ctx = ctx.WithBlockTime(now.Add(10 * time.Hour))
+	keeper.ProcessDuePayments(ctx)
+	require.Equal(t, atoms(30), ak.GetAccount(ctx, payee).GetCoins())
+	require.Equal(t, atoms(70), ak.GetAccount(ctx, payer).GetCoins())
+
+	_, found = keeper.GetSchedule(ctx, schedule.ID)
+	require.False(t, found)
+	require.Empty(t, keeper.GetSchedulesByPayer(ctx, payer))
+	require.Empty(t, keeper.GetSchedulesByPayee(ctx, payee))
+	require.True(t, keeper.GetPaymentAccount(ctx).GetCoins().IsZero())
+
+	_, broken = AllInvariants(keeper)(ctx)
+	require.False(t, broken)
+}
+
+func TestCancelSchedule(t *testing.T) {
+	ctx, keeper, ak, _ := SetupTestInput()
+	now := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx = ctx.WithBlockTime(now)
+
+	acc := ak.NewAccountWithAddress(ctx, payer)
+	require.NoError(t, acc.SetCoins(atoms(100)))
+	ak.SetAccount(ctx, acc)
+
+	schedule, err := keeper.CreateSchedule(ctx, payer, payee, atoms(10), time.Time{}, time.Hour, 5)
+	require.NoError(t, err)
+
+	ctx = ctx.WithBlockTime(now.Add(time.Hour))
+	keeper.ProcessDuePayments(ctx)
+	require.Equal(t, atoms(20), ak.GetAccount(ctx, payee).GetCoins())
+
+	// only the payer can cancel the schedule
+	_, err = keeper.CancelSchedule(ctx, payee, schedule.ID)
+	require.Error(t, err)
+
+	_, err = keeper.CancelSchedule(ctx, payer, schedule.ID+1)
+	require.Error(t, err)
+	require.Equal(t, types.CodeUnknownSchedule, err.Code())
+
+	refund, err := keeper.CancelSchedule(ctx, payer, schedule.ID)
+	require.NoError(t, err)
+	require.Equal(t, atoms(30), refund)
+	require.Equal(t, atoms(80), ak.GetAccount(ctx, payer).GetCoins())
+	require.True(t, keeper.GetPaymentAccount(ctx).GetCoins().IsZero())
+
+	_, found := keeper.GetSchedule(ctx, schedule.ID)
+	require.False(t, found)
+
+	// the cancelled schedule is no longer queued
+	keeper.ProcessDuePayments(ctx.WithBlockTime(now.Add(10 * time.Hour)))
+	require.Equal(t, atoms(20), ak.GetAccount(ctx, payee).GetCoins())
+
+	_, broken := AllInvariants(keeper)(ctx)
+	require.False(t, broken)
+}
+
+func TestProcessDuePaymentsTinyInterval(t *testing.T) {
+	ctx, keeper, ak, _ := SetupTestInput()
+	now := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx = ctx.WithBlockTime(now)
+
+	acc := ak.NewAccountWithAddress(ctx, payer)
+	require.NoError(t, acc.SetCoins(atoms(100000000)))
+	ak.SetAccount(ctx, acc)
+
+	// intervals below the minimum are rejected
+	_, err := keeper.CreateSchedule(ctx, payer, payee, atoms(1), time.Time{}, time.Nanosecond, 10000000)
+	require.Error(t, err)
+	require.Equal(t, types.CodeInvalidSchedule, err.Code())
+
+	keeper.SetParams(ctx, types.NewParams(time.Nanosecond, types.DefaultMaxSchedulesPerBlock))
+	schedule, err := keeper.CreateSchedule(ctx, payer, payee, atoms(1), time.Time{}, time.Nanosecond, 10000000)
+	require.NoError(t, err)
+
+	// all the periods due by the block time are paid in a single transfer
+	ctx = ctx.WithBlockTime(now.Add(time.Millisecond)).WithEventManager(sdk.NewEventManager())
+	keeper.ProcessDuePayments(ctx)
+	require.Equal(t, atoms(1000001), ak.GetAccount(ctx, payee).GetCoins())
+	require.Equal(t, 1, countEvents(ctx.EventManager().Events(), "transfer"))
+	require.Equal(t, 1, countEvents(ctx.EventManager().Events(), types.EventTypePayment))
+
+	schedule, found := keeper.GetSchedule(ctx, schedule.ID)
+	require.True(t, found)
+	require.Equal(t, uint64(1000001), schedule.PaymentsMade)
+
+	// the remaining payments are capped by the schedule
+	ctx = ctx.WithBlockTime(now.Add(time.Second)).WithEventManager(sdk.NewEventManager())
+	keeper.ProcessDuePayments(ctx)
+	require.Equal(t, atoms(10000000), ak.GetAccount(ctx, payee).GetCoins())
+	require.Equal(t, atoms(90000000), ak.GetAccount(ctx, payer).GetCoins())
+	require.Equal(t, 1, countEvents(ctx.EventManager().Events(), "transfer"))
+	require.Equal(t, 1, countEvents(ctx.EventManager().Events(), types.EventTypePayment))
+
+	_, found = keeper.GetSchedule(ctx, schedule.ID)
+	require.False(t, found)
+	require.True(t, keeper.GetPaymentAccount(ctx).GetCoins().IsZero())
+
+	_, broken := AllInvariants(keeper)(ctx)
+	require.False(t, broken)
+}
+
+func TestProcessDuePaymentsMaxSchedulesPerBlock(t *testing.T) {
+	ctx, keeper, ak, _ := SetupTestInput()
+	now := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx = ctx.WithBlockTime(now)
+	keeper.SetParams(ctx, types.NewParams(types.DefaultMinInterval, 2))
+
+	acc := ak.NewAccountWithAddress(ctx, payer)
+	require.NoError(t, acc.SetCoins(atoms(100)))
+	ak.SetAccount(ctx, acc)
+
+	for i := 0; i < 3; i++ {
+		_, err := keeper.CreateSchedule(ctx, payer, payee, atoms(10), time.Time{}, time.Hour, 1)
+		require.NoError(t, err)
+	}
+
+	// only two schedules are paid, the third one stays queued
+	keeper.ProcessDuePayments(ctx)
+	require.Equal(t, atoms(20), ak.GetAccount(ctx, payee).GetCoins())
+	require.Len(t, keeper.GetSchedulesByPayer(ctx, payer), 1)
+
+	keeper.ProcessDuePayments(ctx)
+	require.Equal(t, atoms(30), ak.GetAccount(ctx, payee).GetCoins())
+	require.Empty(t, keeper.GetSchedulesByPayer(ctx, payer))
+
+	_, broken := AllInvariants(keeper)(ctx)
+	require.False(t, broken)
+}
+
+func TestProcessDuePaymentsSendDisabled(t *testing.T) {
+	ctx, keeper, ak, _ := SetupTestInput()
+	now := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx = ctx.WithBlockTime(now)
+	bk := keeper.bankKeeper.(bank.Keeper)
+
+	acc := ak.NewAccountWithAddress(ctx, payer)
+	require.NoError(t, acc.SetCoins(atoms(100)))
+	ak.SetAccount(ctx, acc)
+
+	schedule, err := keeper.CreateSchedule(ctx, payer, payee, atoms(10), time.Time{}, time.Hour, 3)
+	require.NoError(t, err)
+
+	// the denom is frozen after the schedule is created
+	params := bk.GetParams(ctx)
+	params.SendEnabledDenoms = bank.SendEnabledDenoms{bank.NewSendEnabledDenom("atom", false)}
+	bk.SetParams(ctx, params)
+
+	// the due payments are held in escrow and postponed
+	ctx = ctx.WithBlockTime(now.Add(90 * time.Minute)).WithEventManager(sdk.NewEventManager())
+	keeper.ProcessDuePayments(ctx)
+	require.Nil(t, ak.GetAccount(ctx, payee))
+	require.Equal(t, atoms(30), keeper.GetPaymentAccount(ctx).GetCoins())
+	require.Equal(t, 1, countEvents(ctx.EventManager().Events(), types.EventTypeHoldPayment))
+	require.Equal(t, 0, countEvents(ctx.EventManager().Events(), types.EventTypePayment))
+
+	schedule, found := keeper.GetSchedule(ctx, schedule.ID)
+	require.True(t, found)
+	require.Equal(t, uint64(0), schedule.PaymentsMade)
+	require.Equal(t, now.Add(2*time.Hour), schedule.NextPaymentTime())
+
+	// nothing is processed again before the postponed payment time
+	keeper.ProcessDuePayments(ctx.WithBlockTime(now.Add(100 * time.Minute)))
+	require.Nil(t, ak.GetAccount(ctx, payee))
+
+	_, broken := AllInvariants(keeper)(ctx)
+	require.False(t, broken)
+
+	// the held payments are made once the denom is unfrozen
+	params.SendEnabledDenoms = nil
+	bk.SetParams(ctx, params)
+	ctx = ctx.WithBlockTime(now.Add(3 * time.Hour))
+	keeper.ProcessDuePayments(ctx)
+	require.Equal(t, atoms(20), ak.GetAccount(ctx, payee).GetCoins())
+
+	schedule, found = keeper.GetSchedule(ctx, schedule.ID)
+	require.True(t, found)
+	require.Equal(t, uint64(2), schedule.PaymentsMade)
+
+	// and the schedule can still be cancelled for a refund of the escrow
+	refund, err := keeper.CancelSchedule(ctx, payer, schedule.ID)
+	require.NoError(t, err)
+	require.Equal(t, atoms(10), refund)
+	require.Equal(t, atoms(80), ak.GetAccount(ctx, payer).GetCoins())
+
+	_, broken = AllInvariants(keeper)(ctx)
+	require.False(t, broken)
+}
+
+func TestProcessDuePaymentsBlockedPayee(t *testing.T) {
+	ctx, keeper, ak, _ := SetupTestInput()
+	now := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx = ctx.WithBlockTime(now)
+	bk := keeper.bankKeeper.(bank.Keeper)
+
+	acc := ak.NewAccountWithAddress(ctx, payer)
+	require.NoError(t, acc.SetCoins(atoms(100)))
+	ak.SetAccount(ctx, acc)
+
+	schedule, err := keeper.CreateSchedule(ctx, payer, payee, atoms(10), now.Add(time.Hour), time.Hour, 3)
+	require.NoError(t, err)
+
+	// the payee is blocked after the schedule is created
+	params := bk.GetParams(ctx)
+	params.BlockedAddrs = []sdk.AccAddress{payee}
+	bk.SetParams(ctx, params)
+
+	// no payment reaches the payee, they stay in escrow
+	ctx = ctx.WithBlockTime(now.Add(time.Hour)).WithEventManager(sdk.NewEventManager())
+	keeper.ProcessDuePayments(ctx)
+	require.Nil(t, ak.GetAccount(ctx, payee))
+	require.Equal(t, atoms(30), keeper.GetPaymentAccount(ctx).GetCoins())
+	require.Equal(t, 1, countEvents(ctx.EventManager().Events(), types.EventTypeHoldPayment))
+
+	schedule, found := keeper.GetSchedule(ctx, schedule.ID)
+	require.True(t, found)
+	require.Equal(t, uint64(0), schedule.PaymentsMade)
+	require.Equal(t, now.Add(2*time.Hour), schedule.NextPaymentTime())
+
+	_, broken := AllInvariants(keeper)(ctx)
+	require.False(t, broken)
+
+	// the payer gets the held payments back by cancelling the schedule
+	refund, err := keeper.CancelSchedule(ctx, payer, schedule.ID)
+	require.NoError(t, err)
+	require.Equal(t, atoms(30), refund)
+	require.Equal(t, atoms(100), ak.GetAccount(ctx, payer).GetCoins())
+	require.Nil(t, ak.GetAccount(ctx, payee))
+
+	_, broken = AllInvariants(keeper)(ctx)
+	require.False(t, broken)
+}
+
+func countEvents(events sdk.Events, eventType string) (n int) {
+	for _, event := range events {
+		if event.Type == eventType {
+			n++
+		}
+	}
+	return n
+}
@@ -0,0 +1,60 @@
+package keeper
+
+import (
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/shinecloudfoundation/shinecloudnet/codec"
+	"github.com/shinecloudfoundation/shinecloudnet/store"
+	sdk "github.com/shinecloudfoundation/shinecloudnet/types"
+	"github.com/shinecloudfoundation/shinecloudnet/x/auth"
+	"github.com/shinecloudfoundation/shinecloudnet/x/bank"
+	"github.com/shinecloudfoundation/shinecloudnet/x/params"
+	"github.com/shinecloudfoundation/shinecloudnet/x/payment/internal/types"
+	"github.com/shinecloudfoundation/shinecloudnet/x/supply"
+)
+
+// SetupTestInput returns a context, a payment keeper and the keepers it
+// depends on, on a fresh store
+func SetupTestInput() (sdk.Context, Keeper, auth.AccountKeeper, supply.Keeper) {
+	db := dbm.NewMemDB()
+
+	cdc := codec.New()
+	codec.RegisterCrypto(cdc)
+	auth.RegisterCodec(cdc)
+	supply.RegisterCodec(cdc)
+	types.RegisterCodec(cdc)
+
+	authKey := sdk.NewKVStoreKey(auth.StoreKey)
+	paramsKey := sdk.NewKVStoreKey(params.StoreKey)
+	tParamsKey := sdk.NewTransientStoreKey(params.TStoreKey)
+	supplyKey := sdk.NewKVStoreKey(supply.StoreKey)
+	paymentKey := sdk.NewKVStoreKey(types.StoreKey)
+
+	ms := store.NewCommitMultiStore(db)
+	ms.MountStoreWithDB(authKey, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(paramsKey, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(tParamsKey, sdk.StoreTypeTransient, db)
+	ms.MountStoreWithDB(supplyKey, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(paymentKey, sdk.StoreTypeIAVL, db)
+	_ = ms.LoadLatestVersion()
+
+	ctx := sdk.NewContext(ms, abci.Header{ChainID: "test-chain-id"}, false, log.NewNopLogger())
+
+	maccPerms := map[string][]string{
+		types.ModuleName: nil,
+	}
+	blacklistedAddrs := map[string]bool{
+		supply.NewModuleAddress(types.ModuleName).String(): true,
+	}
+
+	paramsKeeper := params.NewKeeper(cdc, paramsKey, tParamsKey, params.DefaultCodespace)
+	accountKeeper := auth.NewAccountKeeper(cdc, authKey, paramsKeeper.Subspace(auth.DefaultParamspace), auth.ProtoBaseAccount)
+	bankKeeper := bank.NewBaseKeeper(accountKeeper, paramsKeeper.Subspace(bank.DefaultParamspace), bank.DefaultCodespace, blacklistedAddrs)
+	supplyKeeper := supply.NewKeeper(cdc, supplyKey, accountKeeper, bankKeeper, maccPerms)
+
+	keeper := NewKeeper(cdc, paymentKey, paramsKeeper.Subspace(types.DefaultParamspace), bankKeeper,
+		supplyKeeper, types.DefaultCodespace)
+	return ctx, keeper, accountKeeper, supplyKeeper
+}
@@ -0,0 +1,29 @@
+//nolint
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/shinecloudfoundation/shinecloudnet/types"
+)
+
+// Local code type
+type CodeType = sdk.CodeType
+
+const (
+	// Default payment codespace
+	DefaultCodespace sdk.CodespaceType = ModuleName
+
+	CodeUnknownSchedule CodeType = 101
+	CodeInvalidSchedule CodeType = 102
+)
+
+// ErrUnknownSchedule is returned if a schedule doesn't exist
+func ErrUnknownSchedule(codespace sdk.CodespaceType, scheduleID uint64) sdk.Error {
+	return sdk.NewError(codespace, CodeUnknownSchedule, fmt.Sprintf("unknown payment schedule %d", scheduleID))
+}
+
+// ErrInvalidSchedule is returned if a schedule is malformed
+func ErrInvalidSchedule(codespace sdk.CodespaceType, msg string) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidSchedule, msg)
+}
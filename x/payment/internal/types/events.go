@@ -0,0 +1,21 @@
+package types
+
+// payment module event types
+const (
+	EventTypeCreateSchedule = "create_schedule"
+	EventTypeCancelSchedule = "cancel_schedule"
+	EventTypePayment        = "scheduled_payment"
+	EventTypeHoldPayment    = "hold_scheduled_payment"
+
+	AttributeKeyScheduleID = "schedule_id"
+	AttributeKeyPayer      = "payer"
+	AttributeKeyPayee      = "payee"
+	AttributeKeyAmount     = "amount"
+	AttributeKeyPayments   = "payments"
+	AttributeKeyRefund     = "refund"
+	AttributeKeyReason     = "reason"
+
+	AttributeValueCategory     = ModuleName
+	AttributeValueSendDisabled = "send_disabled"
+	AttributeValueBlockedPayee = "blocked_payee"
+)
@@ -0,0 +1,20 @@
+package types
+
+import (
+	sdk "github.com/shinecloudfoundation/shinecloudnet/types"
+	supplyexported "github.com/shinecloudfoundation/shinecloudnet/x/supply/exported"
+)
+
+// BankKeeper defines the expected bank keeper (noalias)
+type BankKeeper interface {
+	IsSendEnabledCoins(ctx sdk.Context, coins ...sdk.Coin) sdk.Error
+	BlockedAddr(ctx sdk.Context, addr sdk.AccAddress) bool
+}
+
+// SupplyKeeper defines the expected supply keeper (noalias)
+type SupplyKeeper interface {
+	GetModuleAccount(ctx sdk.Context, moduleName string) supplyexported.ModuleAccountI
+	SetModuleAccount(ctx sdk.Context, macc supplyexported.ModuleAccountI)
+	SendCoinsFromModuleToAccount(ctx sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) sdk.Error
+	SendCoinsFromAccountToModule(ctx sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) sdk.Error
+}
@@ -0,0 +1,106 @@
+package types
+
+import (
+	"encoding/binary"
+	"fmt"
+	"time"
+
+	sdk "github.com/shinecloudfoundation/shinecloudnet/types"
+)
+
+const (
+	// ModuleName is the module name constant used in many places
+	ModuleName = "payment"
+
+	// StoreKey is the store key string for the payment module
+	StoreKey = ModuleName
+
+	// RouterKey is the message route for the payment module
+	RouterKey = ModuleName
+
+	// QuerierRoute is the querier route for the payment module
+	QuerierRoute = ModuleName
+)
+
+// Keys for the payment store
+// Items are stored with the following key: values
+//
+// - 0x00<scheduleID_Bytes>: Schedule
+//
+// - 0x01<nextPaymentTime_Bytes><scheduleID_Bytes>: scheduleID
+//
+// - 0x02: nextScheduleID
+//
+// - 0x10<payerAddr_Bytes><scheduleID_Bytes>: scheduleID
+//
+// - 0x20<payeeAddr_Bytes><scheduleID_Bytes>: scheduleID
+var (
+	SchedulesKeyPrefix     = []byte{0x00}
+	ScheduleQueueKeyPrefix = []byte{0x01}
+	NextScheduleIDKey      = []byte{0x02}
+
+	PayerSchedulesKeyPrefix = []byte{0x10}
+	PayeeSchedulesKeyPrefix = []byte{0x20}
+)
+
+var lenTime = len(sdk.FormatTimeBytes(time.Now()))
+
+// GetScheduleKey gets the key of a specific schedule
+func GetScheduleKey(scheduleID uint64) []byte {
+	return append(SchedulesKeyPrefix, sdk.Uint64ToBigEndian(scheduleID)...)
+}
+
+// ScheduleQueueByTimeKey gets the schedule queue key by next payment time
+func ScheduleQueueByTimeKey(paymentTime time.Time) []byte {
+	return append(ScheduleQueueKeyPrefix, sdk.FormatTimeBytes(paymentTime)...)
+}
+
+// ScheduleQueueKey returns the key for a scheduleID in the schedule queue
+func ScheduleQueueKey(scheduleID uint64, paymentTime time.Time) []byte {
+	return append(ScheduleQueueByTimeKey(paymentTime), sdk.Uint64ToBigEndian(scheduleID)...)
+}
+
+// GetPayerSchedulesKey gets the prefix of the schedules paid by payer
+func GetPayerSchedulesKey(payer sdk.AccAddress) []byte {
+	return append(PayerSchedulesKeyPrefix, payer.Bytes()...)
+}
+
+// GetPayerScheduleKey gets the key of a schedule in the index of its payer
+func GetPayerScheduleKey(payer sdk.AccAddress, scheduleID uint64) []byte {
+	return append(GetPayerSchedulesKey(payer), sdk.Uint64ToBigEndian(scheduleID)...)
+}
+
+// GetPayeeSchedulesKey gets the prefix of the schedules paying payee
+func GetPayeeSchedulesKey(payee sdk.AccAddress) []byte {
+	return append(PayeeSchedulesKeyPrefix, payee.Bytes()...)
+}
+
+// GetPayeeScheduleKey gets the key of a schedule in the index of its payee
+func GetPayeeScheduleKey(payee sdk.AccAddress, scheduleID uint64) []byte {
+	return append(GetPayeeSchedulesKey(payee), sdk.Uint64ToBigEndian(scheduleID)...)
+}
+
+// SplitScheduleQueueKey splits the schedule queue key and returns the
+// schedule id and next payment time
+func SplitScheduleQueueKey(key []byte) (scheduleID uint64, paymentTime time.Time) {
+	if len(key[1:]) != 8+lenTime {
+		panic(fmt.Sprintf("unexpected key length (%d ≠ %d)", len(key[1:]), lenTime+8))
+	}
+
+	paymentTime, err := sdk.ParseTimeBytes(key[1 : 1+lenTime])
+	if err != nil {
+		panic(err)
+	}
+	scheduleID = binary.BigEndian.Uint64(key[1+lenTime:])
+	return
+}
+
+// SplitAddressScheduleKey splits a payer or payee index key and returns the
+// schedule id
+func SplitAddressScheduleKey(key []byte) (scheduleID uint64) {
+	if len(key[1:]) != sdk.AddrLen+8 {
+		panic(fmt.Sprintf("unexpected key length (%d ≠ %d)", len(key[1:]), sdk.AddrLen+8))
+	}
+
+	return binary.BigEndian.Uint64(key[1+sdk.AddrLen:])
+}
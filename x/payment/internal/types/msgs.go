@@ -0,0 +1,104 @@
+package types
+
+import (
+	"time"
+
+	sdk "github.com/shinecloudfoundation/shinecloudnet/types"
+)
+
+// payment message types
+const (
+	TypeMsgCreateSchedule = "create_schedule"
+	TypeMsgCancelSchedule = "cancel_schedule"
+)
+
+var (
+	_ sdk.Msg = MsgCreateSchedule{}
+	_ sdk.Msg = MsgCancelSchedule{}
+)
+
+// MsgCreateSchedule escrows NumPayments times Amount from Payer and pays
+// Amount to Payee every Interval from StartTime on. A zero StartTime starts
+// the schedule at the block time.
+type MsgCreateSchedule struct {
+	Payer       sdk.AccAddress `json:"payer" yaml:"payer"`
+	Payee       sdk.AccAddress `json:"payee" yaml:"payee"`
+	Amount      sdk.Coins      `json:"amount" yaml:"amount"`
+	StartTime   time.Time      `json:"start_time" yaml:"start_time"`
+	Interval    time.Duration  `json:"interval" yaml:"interval"`
+	NumPayments uint64         `json:"num_payments" yaml:"num_payments"`
+}
+
+// NewMsgCreateSchedule creates a new MsgCreateSchedule object
+func NewMsgCreateSchedule(payer, payee sdk.AccAddress, amount sdk.Coins, startTime time.Time,
+	interval time.Duration, numPayments uint64) MsgCreateSchedule {
+
+	return MsgCreateSchedule{
+		Payer:       payer,
+		Payee:       payee,
+		Amount:      amount,
+		StartTime:   startTime,
+		Interval:    interval,
+		NumPayments: numPayments,
+	}
+}
+
+// Route implements sdk.Msg
+func (msg MsgCreateSchedule) Route() string { return RouterKey }
+
+// Type implements sdk.Msg
+func (msg MsgCreateSchedule) Type() string { return TypeMsgCreateSchedule }
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgCreateSchedule) ValidateBasic() sdk.Error {
+	return validateSchedule(msg.Payer, msg.Payee, msg.Amount, msg.Interval, msg.NumPayments)
+}
+
+// GetSignBytes implements sdk.Msg
+func (msg MsgCreateSchedule) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners implements sdk.Msg
+func (msg MsgCreateSchedule) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Payer}
+}
+
+// MsgCancelSchedule cancels a schedule of Payer and refunds the escrow of
+// its remaining payments
+type MsgCancelSchedule struct {
+	Payer      sdk.AccAddress `json:"payer" yaml:"payer"`
+	ScheduleID uint64         `json:"schedule_id" yaml:"schedule_id"`
+}
+
+// NewMsgCancelSchedule creates a new MsgCancelSchedule object
+func NewMsgCancelSchedule(payer sdk.AccAddress, scheduleID uint64) MsgCancelSchedule {
+	return MsgCancelSchedule{
+		Payer:      payer,
+		ScheduleID: scheduleID,
+	}
+}
+
+// Route implements sdk.Msg
+func (msg MsgCancelSchedule) Route() string { return RouterKey }
+
+// Type implements sdk.Msg
+func (msg MsgCancelSchedule) Type() string { return TypeMsgCancelSchedule }
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgCancelSchedule) ValidateBasic() sdk.Error {
+	if msg.Payer.Empty() {
+		return sdk.ErrInvalidAddress("missing payer address")
+	}
+	return nil
+}
+
+// GetSignBytes implements sdk.Msg
+func (msg MsgCancelSchedule) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners implements sdk.Msg
+func (msg MsgCancelSchedule) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Payer}
+}
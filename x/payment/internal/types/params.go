@@ -0,0 +1,92 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/shinecloudfoundation/shinecloudnet/x/params"
+)
+
+const (
+	// DefaultParamspace for params keeper
+	DefaultParamspace = ModuleName
+
+	// DefaultMinInterval is the default minimum interval between two payments
+	// of a schedule
+	DefaultMinInterval = time.Hour
+
+	// DefaultMaxSchedulesPerBlock is the default maximum number of due
+	// schedules paid in a single block
+	DefaultMaxSchedulesPerBlock uint32 = 100
+)
+
+var (
+	// ParamStoreKeyMinInterval is store's key for MinInterval
+	ParamStoreKeyMinInterval = []byte("mininterval")
+	// ParamStoreKeyMaxSchedulesPerBlock is store's key for MaxSchedulesPerBlock
+	ParamStoreKeyMaxSchedulesPerBlock = []byte("maxschedulesperblock")
+)
+
+var _ params.DefaultedParamSet = &Params{}
+
+// Params defines the parameters for the payment module. MinInterval bounds
+// how often a schedule may pay out, and MaxSchedulesPerBlock bounds the work
+// of the EndBlocker; due schedules beyond it stay queued for the next blocks.
+type Params struct {
+	MinInterval          time.Duration `json:"min_interval" yaml:"min_interval"`
+	MaxSchedulesPerBlock uint32        `json:"max_schedules_per_block" yaml:"max_schedules_per_block"`
+}
+
+// NewParams creates a new Params object
+func NewParams(minInterval time.Duration, maxSchedulesPerBlock uint32) Params {
+	return Params{
+		MinInterval:          minInterval,
+		MaxSchedulesPerBlock: maxSchedulesPerBlock,
+	}
+}
+
+// DefaultParams returns a default set of parameters.
+func DefaultParams() Params {
+	return NewParams(DefaultMinInterval, DefaultMaxSchedulesPerBlock)
+}
+
+// ParamKeyTable type declaration for parameters
+func ParamKeyTable() params.KeyTable {
+	return params.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// ParamSetPairs implements the ParamSet interface and returns all the key/value pairs
+// pairs of payment module's parameters.
+// nolint
+func (p *Params) ParamSetPairs() params.ParamSetPairs {
+	return params.ParamSetPairs{
+		{ParamStoreKeyMinInterval, &p.MinInterval},
+		{ParamStoreKeyMaxSchedulesPerBlock, &p.MaxSchedulesPerBlock},
+	}
+}
+
+// ValidateParamSet validates the payment parameters, it implements
+// params.ValidatedParamSet
+func (p Params) ValidateParamSet() error {
+	if p.MinInterval <= 0 {
+		return fmt.Errorf("payment parameter MinInterval must be positive, is %s", p.MinInterval)
+	}
+	if p.MaxSchedulesPerBlock == 0 {
+		return fmt.Errorf("payment parameter MaxSchedulesPerBlock must be positive")
+	}
+	return nil
+}
+
+// DefaultParamSet returns the default payment parameters, it implements
+// params.DefaultedParamSet
+func (p Params) DefaultParamSet() params.ValidatedParamSet {
+	defaults := DefaultParams()
+	return &defaults
+}
+
+// String implements the stringer interface.
+func (p Params) String() string {
+	return fmt.Sprintf(`Params:
+  MinInterval:          %s
+  MaxSchedulesPerBlock: %d`, p.MinInterval, p.MaxSchedulesPerBlock)
+}
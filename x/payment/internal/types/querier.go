@@ -0,0 +1,37 @@
+package types
+
+import (
+	sdk "github.com/shinecloudfoundation/shinecloudnet/types"
+)
+
+// querier keys
+const (
+	QuerySchedule       = "schedule"
+	QueryPayerSchedules = "payer_schedules"
+	QueryPayeeSchedules = "payee_schedules"
+)
+
+// QueryScheduleParams defines the params for querying a schedule by id
+type QueryScheduleParams struct {
+	ScheduleID uint64 `json:"schedule_id" yaml:"schedule_id"`
+}
+
+// NewQueryScheduleParams creates a new QueryScheduleParams object
+func NewQueryScheduleParams(scheduleID uint64) QueryScheduleParams {
+	return QueryScheduleParams{
+		ScheduleID: scheduleID,
+	}
+}
+
+// QuerySchedulesParams defines the params for querying the schedules of a
+// payer or a payee
+type QuerySchedulesParams struct {
+	Address sdk.AccAddress `json:"address" yaml:"address"`
+}
+
+// NewQuerySchedulesParams creates a new QuerySchedulesParams object
+func NewQuerySchedulesParams(addr sdk.AccAddress) QuerySchedulesParams {
+	return QuerySchedulesParams{
+		Address: addr,
+	}
+}
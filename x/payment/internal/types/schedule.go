@@ -0,0 +1,168 @@
+package types
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+	"strings"
+	"time"
+
+	sdk "github.com/shinecloudfoundation/shinecloudnet/types"
+)
+
+// Schedule pays Amount from the escrow of Payer to Payee NumPayments times,
+// every Interval from StartTime on
+type Schedule struct {
+	ID           uint64         `json:"id" yaml:"id"`
+	Payer        sdk.AccAddress `json:"payer" yaml:"payer"`
+	Payee        sdk.AccAddress `json:"payee" yaml:"payee"`
+	Amount       sdk.Coins      `json:"amount" yaml:"amount"`
+	StartTime    time.Time      `json:"start_time" yaml:"start_time"`
+	Interval     time.Duration  `json:"interval" yaml:"interval"`
+	NumPayments  uint64         `json:"num_payments" yaml:"num_payments"`
+	PaymentsMade uint64         `json:"payments_made" yaml:"payments_made"`
+}
+
+// NewSchedule creates a new Schedule object
+func NewSchedule(id uint64, payer, payee sdk.AccAddress, amount sdk.Coins, startTime time.Time,
+	interval time.Duration, numPayments uint64) Schedule {
+
+	return Schedule{
+		ID:          id,
+		Payer:       payer,
+		Payee:       payee,
+		Amount:      amount,
+		StartTime:   startTime,
+		Interval:    interval,
+		NumPayments: numPayments,
+	}
+}
+
+// NextPaymentTime returns the time the next payment of the schedule is due
+func (s Schedule) NextPaymentTime() time.Time {
+	return s.StartTime.Add(s.Interval * time.Duration(s.PaymentsMade))
+}
+
+// RemainingPayments returns the number of payments left to make
+func (s Schedule) RemainingPayments() uint64 {
+	return s.NumPayments - s.PaymentsMade
+}
+
+// IsDone returns true if all the payments of the schedule have been made
+func (s Schedule) IsDone() bool {
+	return s.PaymentsMade >= s.NumPayments
+}
+
+// DuePayments returns the number of payments due by blockTime, at most the
+// remaining ones
+func (s Schedule) DuePayments(blockTime time.Time) uint64 {
+	next := s.NextPaymentTime()
+	if s.IsDone() || next.After(blockTime) {
+		return 0
+	}
+
+	due := uint64(blockTime.Sub(next)/s.Interval) + 1
+	if remaining := s.RemainingPayments(); due > remaining {
+		return remaining
+	}
+	return due
+}
+
+// Postpone delays the payments due by blockTime by whole intervals, so the
+// next payment is due after blockTime. The escrow keeps covering them.
+func (s Schedule) Postpone(blockTime time.Time) Schedule {
+	next := s.NextPaymentTime()
+	if next.After(blockTime) {
+		return s
+	}
+
+	intervals := blockTime.Sub(next)/s.Interval + 1
+	s.StartTime = s.StartTime.Add(s.Interval * intervals)
+	return s
+}
+
+// AmountOf returns the coins paid by n payments of the schedule
+func (s Schedule) AmountOf(n uint64) sdk.Coins {
+	count := sdk.NewIntFromBigInt(new(big.Int).SetUint64(n))
+
+	amount := make(sdk.Coins, len(s.Amount))
+	for i, coin := range s.Amount {
+		amount[i] = sdk.NewCoin(coin.Denom, coin.Amount.Mul(count))
+	}
+	return amount
+}
+
+// Escrow returns the coins held in escrow for the remaining payments
+func (s Schedule) Escrow() sdk.Coins {
+	return s.AmountOf(s.RemainingPayments())
+}
+
+// ValidateBasic performs a stateless validation of the schedule
+func (s Schedule) ValidateBasic() sdk.Error {
+	if err := validateSchedule(s.Payer, s.Payee, s.Amount, s.Interval, s.NumPayments); err != nil {
+		return err
+	}
+	if s.StartTime.IsZero() {
+		return ErrInvalidSchedule(DefaultCodespace, "missing start time")
+	}
+	if s.PaymentsMade >= s.NumPayments {
+		return ErrInvalidSchedule(DefaultCodespace,
+			fmt.Sprintf("payments made %d must be less than the number of payments %d", s.PaymentsMade, s.NumPayments))
+	}
+	return nil
+}
+
+func (s Schedule) String() string {
+	return fmt.Sprintf(`Schedule %d:
+  Payer:              %s
+  Payee:              %s
+  Amount:             %s
+  Start Time:         %s
+  Interval:           %s
+  Number Of Payments: %d
+  Payments Made:      %d
+  Next Payment Time:  %s`, s.ID, s.Payer, s.Payee, s.Amount, s.StartTime, s.Interval,
+		s.NumPayments, s.PaymentsMade, s.NextPaymentTime())
+}
+
+// Schedules is a list of payment schedules
+type Schedules []Schedule
+
+func (ss Schedules) String() string {
+	out := make([]string, len(ss))
+	for i, s := range ss {
+		out[i] = s.String()
+	}
+	return strings.Join(out, "\n")
+}
+
+// validateSchedule validates the fields shared by schedules and the msgs
+// creating them
+func validateSchedule(payer, payee sdk.AccAddress, amount sdk.Coins, interval time.Duration,
+	numPayments uint64) sdk.Error {
+
+	if payer.Empty() {
+		return sdk.ErrInvalidAddress("missing payer address")
+	}
+	if payee.Empty() {
+		return sdk.ErrInvalidAddress("missing payee address")
+	}
+	if payer.Equals(payee) {
+		return sdk.ErrInvalidAddress("payer and payee must be different")
+	}
+	if !amount.IsValid() || !amount.IsAllPositive() {
+		return sdk.ErrInvalidCoins(fmt.Sprintf("invalid payment amount %s", amount))
+	}
+	if interval <= 0 {
+		return ErrInvalidSchedule(DefaultCodespace, "interval must be positive")
+	}
+	if numPayments == 0 {
+		return ErrInvalidSchedule(DefaultCodespace, "number of payments must be positive")
+	}
+	// the schedule must end in a representable time
+	if numPayments > uint64(math.MaxInt64/interval) {
+		return ErrInvalidSchedule(DefaultCodespace,
+			fmt.Sprintf("%d payments every %s overflow the schedule duration", numPayments, interval))
+	}
+	return nil
+}
@@ -0,0 +1,109 @@
+package types
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto"
+
+	sdk "github.com/shinecloudfoundation/shinecloudnet/types"
+)
+
+var (
+	payer = sdk.AccAddress(crypto.AddressHash([]byte("payer")))
+	payee = sdk.AccAddress(crypto.AddressHash([]byte("payee")))
+	start = time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+)
+
+func TestScheduleEscrow(t *testing.T) {
+	amount := sdk.NewCoins(sdk.NewInt64Coin("atom", 10), sdk.NewInt64Coin("stake", 3))
+	schedule := NewSchedule(1, payer, payee, amount, start, time.Hour, 4)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("atom", 40), sdk.NewInt64Coin("stake", 12)), schedule.Escrow())
+	require.Equal(t, start, schedule.NextPaymentTime())
+
+	schedule.PaymentsMade = 3
+	require.Equal(t, amount, schedule.Escrow())
+	require.Equal(t, start.Add(3*time.Hour), schedule.NextPaymentTime())
+	require.False(t, schedule.IsDone())
+
+	schedule.PaymentsMade = 4
+	require.True(t, schedule.Escrow().IsZero())
+	require.True(t, schedule.IsDone())
+}
+
+func TestScheduleDuePayments(t *testing.T) {
+	schedule := NewSchedule(1, payer, payee, sdk.NewCoins(sdk.NewInt64Coin("atom", 10)), start, time.Hour, 4)
+	require.Equal(t, uint64(0), schedule.DuePayments(start.Add(-time.Second)))
+	require.Equal(t, uint64(1), schedule.DuePayments(start))
+	require.Equal(t, uint64(2), schedule.DuePayments(start.Add(time.Hour)))
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("atom", 20)), schedule.AmountOf(2))
+
+	// due payments never exceed the remaining ones
+	schedule.PaymentsMade = 1
+	require.Equal(t, uint64(3), schedule.DuePayments(start.Add(100*time.Hour)))
+
+	schedule.PaymentsMade = 4
+	require.Equal(t, uint64(0), schedule.DuePayments(start.Add(100*time.Hour)))
+}
+
+func TestSchedulePostpone(t *testing.T) {
+	schedule := NewSchedule(1, payer, payee, sdk.NewCoins(sdk.NewInt64Coin("atom", 10)), start, time.Hour, 4)
+	schedule.PaymentsMade = 1
+	require.Equal(t, schedule, schedule.Postpone(start))
+
+	// the due payments move past the block time by whole intervals
+	postponed := schedule.Postpone(start.Add(150 * time.Minute))
+	require.Equal(t, start.Add(3*time.Hour), postponed.NextPaymentTime())
+	require.Equal(t, uint64(1), postponed.PaymentsMade)
+	require.Equal(t, schedule.Escrow(), postponed.Escrow())
+
+	postponed = schedule.Postpone(start.Add(time.Hour))
+	require.Equal(t, start.Add(2*time.Hour), postponed.NextPaymentTime())
+}
+
+func TestScheduleValidateBasic(t *testing.T) {
+	atoms := sdk.NewCoins(sdk.NewInt64Coin("atom", 10))
+
+	cases := map[string]struct {
+		schedule Schedule
+		valid    bool
+	}{
+		"valid":             {NewSchedule(1, payer, payee, atoms, start, time.Hour, 12), true},
+		"missing payer":     {NewSchedule(1, nil, payee, atoms, start, time.Hour, 12), false},
+		"missing payee":     {NewSchedule(1, payer, nil, atoms, start, time.Hour, 12), false},
+		"self payment":      {NewSchedule(1, payer, payer, atoms, start, time.Hour, 12), false},
+		"zero amount":       {NewSchedule(1, payer, payee, sdk.Coins{sdk.NewInt64Coin("atom", 0)}, start, time.Hour, 12), false},
+		"empty amount":      {NewSchedule(1, payer, payee, sdk.Coins{}, start, time.Hour, 12), false},
+		"zero interval":     {NewSchedule(1, payer, payee, atoms, start, 0, 12), false},
+		"no payments":       {NewSchedule(1, payer, payee, atoms, start, time.Hour, 0), false},
+		"missing start":     {NewSchedule(1, payer, payee, atoms, time.Time{}, time.Hour, 12), false},
+		"duration overflow": {NewSchedule(1, payer, payee, atoms, start, time.Hour, math.MaxInt64), false},
+	}
+
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			err := tc.schedule.ValidateBasic()
+			if tc.valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+
+			msg := NewMsgCreateSchedule(tc.schedule.Payer, tc.schedule.Payee, tc.schedule.Amount,
+				tc.schedule.StartTime, tc.schedule.Interval, tc.schedule.NumPayments)
+			// the start time of a msg defaults to the block time
+			if tc.valid || name == "missing start" {
+				require.NoError(t, msg.ValidateBasic())
+			} else {
+				require.Error(t, msg.ValidateBasic())
+			}
+		})
+	}
+
+	finished := NewSchedule(1, payer, payee, atoms, start, time.Hour, 12)
+	finished.PaymentsMade = 12
+	require.Error(t, finished.ValidateBasic())
+}
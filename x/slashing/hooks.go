@@ -37,6 +37,29 @@ func (k Keeper) AfterValidatorRemoved(ctx sdk.Context, address sdk.ConsAddress)
 	k.deleteAddrPubkeyRelation(ctx, crypto.Address(address))
 }
 
+// When a validator's consensus pubkey is rotated, add the address-pubkey
+// relation of the new pubkey and move the signing info over to the new
+// consensus address. The relation of the old pubkey is kept, so evidence
+// against it can still be handled.
+func (k Keeper) AfterConsPubKeyRotated(ctx sdk.Context, oldConsAddr, newConsAddr sdk.ConsAddress, valAddr sdk.ValAddress) {
+	validator := k.sk.Validator(ctx, valAddr)
+	k.addPubkey(ctx, validator.GetConsPubKey())
+
+	signingInfo, found := k.getValidatorSigningInfo(ctx, oldConsAddr)
+	if !found {
+		return
+	}
+	signingInfo.Address = newConsAddr
+	k.SetValidatorSigningInfo(ctx, newConsAddr, signingInfo)
+	k.deleteValidatorSigningInfo(ctx, oldConsAddr)
+
+	k.IterateValidatorMissedBlockBitArray(ctx, oldConsAddr, func(index int64, missed bool) (stop bool) {
+		k.setValidatorMissedBlockBitArray(ctx, newConsAddr, index, missed)
+		return false
+	})
+	k.clearValidatorMissedBlockBitArray(ctx, oldConsAddr)
+}
+
 //_________________________________________________________________________________________
 
 // Hooks wrapper struct for slashing keeper
@@ -66,6 +89,11 @@ func (h Hooks) AfterValidatorCreated(ctx sdk.Context, valAddr sdk.ValAddress) {
 	h.k.AfterValidatorCreated(ctx, valAddr)
 }
 
+// Implements sdk.ValidatorHooks
+func (h Hooks) AfterConsPubKeyRotated(ctx sdk.Context, oldConsAddr, newConsAddr sdk.ConsAddress, valAddr sdk.ValAddress) {
+	h.k.AfterConsPubKeyRotated(ctx, oldConsAddr, newConsAddr, valAddr)
+}
+
 // nolint - unused hooks
 func (h Hooks) AfterValidatorBeginUnbonding(_ sdk.Context, _ sdk.ConsAddress, _ sdk.ValAddress)  {}
 func (h Hooks) BeforeValidatorModified(_ sdk.Context, _ sdk.ValAddress)                          {}
@@ -82,10 +82,11 @@ func (k Keeper) HandleDoubleSign(ctx sdk.Context, addr crypto.Address, infractio
 		return
 	}
 
-	// fetch the validator signing info
-	signInfo, found := k.getValidatorSigningInfo(ctx, consAddr)
+	// fetch the validator signing info, which follows consensus pubkey rotations
+	signInfoAddr := validator.GetConsAddr()
+	signInfo, found := k.getValidatorSigningInfo(ctx, signInfoAddr)
 	if !found {
-		panic(fmt.Sprintf("Expected signing info for validator %s but not found", consAddr))
+		panic(fmt.Sprintf("Expected signing info for validator %s but not found", signInfoAddr))
 	}
 
 	// validator is already tombstoned
@@ -140,7 +141,7 @@ func (k Keeper) HandleDoubleSign(ctx sdk.Context, addr crypto.Address, infractio
 	signInfo.JailedUntil = types.DoubleSignJailEndTime
 
 	// Set validator signing info
-	k.SetValidatorSigningInfo(ctx, consAddr, signInfo)
+	k.SetValidatorSigningInfo(ctx, signInfoAddr, signInfo)
 }
 
 // handle a validator signature, must be called once per validator per block
@@ -154,10 +155,11 @@ func (k Keeper) HandleValidatorSignature(ctx sdk.Context, addr crypto.Address, p
 		panic(fmt.Sprintf("Validator consensus-address %s not found", consAddr))
 	}
 
-	// fetch signing info
-	signInfo, found := k.getValidatorSigningInfo(ctx, consAddr)
+	// fetch signing info, which follows consensus pubkey rotations
+	signInfoAddr := k.signingInfoAddress(ctx, consAddr)
+	signInfo, found := k.getValidatorSigningInfo(ctx, signInfoAddr)
 	if !found {
-		panic(fmt.Sprintf("Expected signing info for validator %s but not found", consAddr))
+		panic(fmt.Sprintf("Expected signing info for validator %s but not found", signInfoAddr))
 	}
 
 	// this is a relative index, so it counts blocks the validator *should* have signed
@@ -168,16 +170,16 @@ func (k Keeper) HandleValidatorSignature(ctx sdk.Context, addr crypto.Address, p
 	// Update signed block bit array & counter
 	// This counter just tracks the sum of the bit array
 	// That way we avoid needing to read/write the whole array each time
-	previous := k.getValidatorMissedBlockBitArray(ctx, consAddr, index)
+	previous := k.getValidatorMissedBlockBitArray(ctx, signInfoAddr, index)
 	missed := !signed
 	switch {
 	case !previous && missed:
 		// Array value has changed from not missed to missed, increment counter
-		k.setValidatorMissedBlockBitArray(ctx, consAddr, index, true)
+		k.setValidatorMissedBlockBitArray(ctx, signInfoAddr, index, true)
 		signInfo.MissedBlocksCounter++
 	case previous && !missed:
 		// Array value has changed from missed to not missed, decrement counter
-		k.setValidatorMissedBlockBitArray(ctx, consAddr, index, false)
+		k.setValidatorMissedBlockBitArray(ctx, signInfoAddr, index, false)
 		signInfo.MissedBlocksCounter--
 	default:
 		// Array value at this index has not changed, no need to update counter
@@ -233,7 +235,7 @@ func (k Keeper) HandleValidatorSignature(ctx sdk.Context, addr crypto.Address, p
 			// We need to reset the counter & array so that the validator won't be immediately slashed for downtime upon rebonding.
 			signInfo.MissedBlocksCounter = 0
 			signInfo.IndexOffset = 0
-			k.clearValidatorMissedBlockBitArray(ctx, consAddr)
+			k.clearValidatorMissedBlockBitArray(ctx, signInfoAddr)
 		} else {
 			// Validator was (a) not found or (b) already jailed, don't slash
 			logger.Info(
@@ -243,7 +245,18 @@ func (k Keeper) HandleValidatorSignature(ctx sdk.Context, addr crypto.Address, p
 	}
 
 	// Set the updated signing info
-	k.SetValidatorSigningInfo(ctx, consAddr, signInfo)
+	k.SetValidatorSigningInfo(ctx, signInfoAddr, signInfo)
+}
+
+// signingInfoAddress returns the consensus address the signing info of the
+// validator with the given consensus address is stored by. After a consensus
+// pubkey rotation this is the address of the validator's new pubkey.
+func (k Keeper) signingInfoAddress(ctx sdk.Context, consAddr sdk.ConsAddress) sdk.ConsAddress {
+	validator := k.sk.ValidatorByConsAddr(ctx, consAddr)
+	if validator == nil {
+		return consAddr
+	}
+	return validator.GetConsAddr()
 }
 
 func (k Keeper) addPubkey(ctx sdk.Context, pubkey crypto.PubKey) {
@@ -84,6 +84,58 @@ func TestHandleDoubleSign(t *testing.T) {
 
 // ______________________________________________________________
 
+// Test that the signing info follows a consensus pubkey rotation and that
+// evidence against the old pubkey still slashes the validator
+func TestHandleDoubleSignAfterConsPubKeyRotation(t *testing.T) {
+
+	// initial setup
+	ctx, _, sk, _, keeper := createTestInput(t, keeperTestParams())
+	power := int64(100)
+	amt := sdk.TokensFromConsensusPower(power)
+	operatorAddr, oldPk, newPk := addrs[0], pks[0], pks[1]
+	got := staking.NewHandler(sk)(ctx, NewTestMsgCreateValidator(operatorAddr, oldPk, amt))
+	require.True(t, got.IsOK())
+	staking.EndBlocker(ctx, sk)
+
+	// miss a block with the old pubkey
+	keeper.HandleValidatorSignature(ctx, oldPk.Address(), power, false)
+
+	got = staking.NewHandler(sk)(ctx, staking.NewMsgRotateConsPubKey(operatorAddr, newPk))
+	require.True(t, got.IsOK(), "%v", got)
+	staking.EndBlocker(ctx, sk)
+
+	// the signing info and missed blocks moved to the new consensus address
+	oldConsAddr, newConsAddr := sdk.ConsAddress(oldPk.Address()), sdk.ConsAddress(newPk.Address())
+	_, found := keeper.getValidatorSigningInfo(ctx, oldConsAddr)
+	require.False(t, found)
+	info, found := keeper.getValidatorSigningInfo(ctx, newConsAddr)
+	require.True(t, found)
+	require.Equal(t, newConsAddr, info.Address)
+	require.Equal(t, int64(1), info.MissedBlocksCounter)
+	require.True(t, keeper.getValidatorMissedBlockBitArray(ctx, newConsAddr, 0))
+	_, err := keeper.getPubkey(ctx, newPk.Address())
+	require.NoError(t, err)
+
+	// the old pubkey still signs until the validator update takes effect
+	ctx = ctx.WithBlockHeight(1)
+	keeper.HandleValidatorSignature(ctx, oldPk.Address(), power, true)
+	info, _ = keeper.getValidatorSigningInfo(ctx, newConsAddr)
+	require.Equal(t, int64(2), info.IndexOffset)
+
+	oldTokens := sk.Validator(ctx, operatorAddr).GetTokens()
+
+	// double sign with the old pubkey
+	keeper.HandleDoubleSign(ctx, oldPk.Address(), 0, time.Unix(0, 0), power)
+
+	// should be jailed, slashed and tombstoned
+	require.True(t, sk.Validator(ctx, operatorAddr).IsJailed())
+	require.True(t, sk.Validator(ctx, operatorAddr).GetTokens().LT(oldTokens))
+	info, _ = keeper.getValidatorSigningInfo(ctx, newConsAddr)
+	require.True(t, info.Tombstoned)
+}
+
+// ______________________________________________________________
+
 // Test that a validator is slashed correctly
 // when we discover evidence of infraction
 func TestPastMaxEvidenceAge(t *testing.T) {
@@ -31,6 +31,8 @@ const (
 	DefaultUnbondingTime               = types.DefaultUnbondingTime
 	DefaultMaxValidators               = types.DefaultMaxValidators
 	DefaultMaxEntries                  = types.DefaultMaxEntries
+	DefaultMaxConsPubKeyRotations      = types.DefaultMaxConsPubKeyRotations
+	DefaultHistoricalEntries           = types.DefaultHistoricalEntries
 	NotBondedPoolName                  = types.NotBondedPoolName
 	BondedPoolName                     = types.BondedPoolName
 	QueryValidators                    = types.QueryValidators
@@ -47,6 +49,8 @@ const (
 	QueryDelegatorValidator            = types.QueryDelegatorValidator
 	QueryPool                          = types.QueryPool
 	QueryParameters                    = types.QueryParameters
+	QueryValidatorConsPubKeyRotations  = types.QueryValidatorConsPubKeyRotations
+	QueryHistoricalInfo                = types.QueryHistoricalInfo
 	MaxMonikerLength                   = types.MaxMonikerLength
 	MaxIdentityLength                  = types.MaxIdentityLength
 	MaxWebsiteLength                   = types.MaxWebsiteLength
@@ -93,6 +97,9 @@ var (
 	ErrValidatorPubKeyExists           = types.ErrValidatorPubKeyExists
 	ErrValidatorPubKeyTypeNotSupported = types.ErrValidatorPubKeyTypeNotSupported
 	ErrValidatorJailed                 = types.ErrValidatorJailed
+	ErrConsPubKeyRotationDisabled      = types.ErrConsPubKeyRotationDisabled
+	ErrMaxConsPubKeyRotations          = types.ErrMaxConsPubKeyRotations
+	ErrConsPubKeyAlreadyRotated        = types.ErrConsPubKeyAlreadyRotated
 	ErrBadRemoveValidator              = types.ErrBadRemoveValidator
 	ErrDescriptionLength               = types.ErrDescriptionLength
 	ErrCommissionNegative              = types.ErrCommissionNegative
@@ -126,11 +133,15 @@ var (
 	ErrVerySmallRedelegation           = types.ErrVerySmallRedelegation
 	ErrBadRedelegationDst              = types.ErrBadRedelegationDst
 	ErrTransitiveRedelegation          = types.ErrTransitiveRedelegation
+	ErrSelfDelegationTransfer          = types.ErrSelfDelegationTransfer
+	ErrTransferDelegationRedelegating  = types.ErrTransferDelegationRedelegating
+	ErrTransferDelegationVesting       = types.ErrTransferDelegationVesting
 	ErrMaxRedelegationEntries          = types.ErrMaxRedelegationEntries
 	ErrDelegatorShareExRateInvalid     = types.ErrDelegatorShareExRateInvalid
 	ErrBothShareMsgsGiven              = types.ErrBothShareMsgsGiven
 	ErrNeitherShareMsgsGiven           = types.ErrNeitherShareMsgsGiven
 	ErrMissingSignature                = types.ErrMissingSignature
+	ErrNoHistoricalInfo                = types.ErrNoHistoricalInfo
 	NewGenesisState                    = types.NewGenesisState
 	DefaultGenesisState                = types.DefaultGenesisState
 	NewMultiStakingHooks               = types.NewMultiStakingHooks
@@ -141,6 +152,11 @@ var (
 	GetLastValidatorPowerKey           = types.GetLastValidatorPowerKey
 	ParseValidatorPowerRankKey         = types.ParseValidatorPowerRankKey
 	GetValidatorQueueTimeKey           = types.GetValidatorQueueTimeKey
+	GetConsPubKeyRotationKey           = types.GetConsPubKeyRotationKey
+	GetConsPubKeyRotationsKey          = types.GetConsPubKeyRotationsKey
+	GetConsPubKeyRotationQueueTimeKey  = types.GetConsPubKeyRotationQueueTimeKey
+	GetPendingConsPubKeyRotationKey    = types.GetPendingConsPubKeyRotationKey
+	GetHistoricalInfoKey               = types.GetHistoricalInfoKey
 	GetDelegationKey                   = types.GetDelegationKey
 	GetDelegationsKey                  = types.GetDelegationsKey
 	GetUBDKey                          = types.GetUBDKey
@@ -164,6 +180,8 @@ var (
 	NewMsgDelegate                     = types.NewMsgDelegate
 	NewMsgBeginRedelegate              = types.NewMsgBeginRedelegate
 	NewMsgUndelegate                   = types.NewMsgUndelegate
+	NewMsgRotateConsPubKey             = types.NewMsgRotateConsPubKey
+	NewMsgTransferDelegation           = types.NewMsgTransferDelegation
 	NewParams                          = types.NewParams
 	DefaultParams                      = types.DefaultParams
 	MustUnmarshalParams                = types.MustUnmarshalParams
@@ -174,6 +192,15 @@ var (
 	NewQueryBondsParams                = types.NewQueryBondsParams
 	NewQueryRedelegationParams         = types.NewQueryRedelegationParams
 	NewQueryValidatorsParams           = types.NewQueryValidatorsParams
+	NewConsPubKeyRotation              = types.NewConsPubKeyRotation
+	MustMarshalConsPubKeyRotation      = types.MustMarshalConsPubKeyRotation
+	MustUnmarshalConsPubKeyRotation    = types.MustUnmarshalConsPubKeyRotation
+	UnmarshalConsPubKeyRotation        = types.UnmarshalConsPubKeyRotation
+	NewHistoricalInfo                  = types.NewHistoricalInfo
+	MustMarshalHistoricalInfo          = types.MustMarshalHistoricalInfo
+	MustUnmarshalHistoricalInfo        = types.MustUnmarshalHistoricalInfo
+	UnmarshalHistoricalInfo            = types.UnmarshalHistoricalInfo
+	NewQueryHistoricalInfoParams       = types.NewQueryHistoricalInfoParams
 	NewValidator                       = types.NewValidator
 	MustMarshalValidator               = types.MustMarshalValidator
 	MustUnmarshalValidator             = types.MustUnmarshalValidator
@@ -187,6 +214,7 @@ var (
 	ValidatorsKey                    = types.ValidatorsKey
 	ValidatorsByConsAddrKey          = types.ValidatorsByConsAddrKey
 	ValidatorsByPowerIndexKey        = types.ValidatorsByPowerIndexKey
+	ConsPubKeyRotationKey            = types.ConsPubKeyRotationKey
 	DelegationKey                    = types.DelegationKey
 	UnbondingDelegationKey           = types.UnbondingDelegationKey
 	UnbondingDelegationByValIndexKey = types.UnbondingDelegationByValIndexKey
@@ -196,10 +224,17 @@ var (
 	UnbondingQueueKey                = types.UnbondingQueueKey
 	RedelegationQueueKey             = types.RedelegationQueueKey
 	ValidatorQueueKey                = types.ValidatorQueueKey
+	ConsPubKeyRotationQueueKey       = types.ConsPubKeyRotationQueueKey
+	PendingConsPubKeyRotationKey     = types.PendingConsPubKeyRotationKey
+	HistoricalInfoKey                = types.HistoricalInfoKey
+	DefaultConsPubKeyRotationFee     = types.DefaultConsPubKeyRotationFee
 	KeyUnbondingTime                 = types.KeyUnbondingTime
 	KeyMaxValidators                 = types.KeyMaxValidators
 	KeyMaxEntries                    = types.KeyMaxEntries
 	KeyBondDenom                     = types.KeyBondDenom
+	KeyMaxConsPubKeyRotations        = types.KeyMaxConsPubKeyRotations
+	KeyConsPubKeyRotationFee         = types.KeyConsPubKeyRotationFee
+	KeyHistoricalEntries             = types.KeyHistoricalEntries
 )
 
 type (
@@ -230,6 +265,8 @@ type (
 	MsgDelegate               = types.MsgDelegate
 	MsgBeginRedelegate        = types.MsgBeginRedelegate
 	MsgUndelegate             = types.MsgUndelegate
+	MsgRotateConsPubKey       = types.MsgRotateConsPubKey
+	MsgTransferDelegation     = types.MsgTransferDelegation
 	Params                    = types.Params
 	Pool                      = types.Pool
 	QueryDelegatorParams      = types.QueryDelegatorParams
@@ -239,6 +276,10 @@ type (
 	QueryValidatorsParams     = types.QueryValidatorsParams
 	Validator                 = types.Validator
 	Validators                = types.Validators
+	ConsPubKeyRotation        = types.ConsPubKeyRotation
+	ConsPubKeyRotations       = types.ConsPubKeyRotations
+	HistoricalInfo            = types.HistoricalInfo
+	QueryHistoricalInfoParams = types.QueryHistoricalInfoParams
 	Description               = types.Description
 	DelegationI               = exported.DelegationI
 	ValidatorI                = exported.ValidatorI
@@ -38,6 +38,8 @@ func GetTxCmd(storeKey string, cdc *codec.Codec) *cobra.Command {
 		GetCmdDelegate(cdc),
 		GetCmdRedelegate(storeKey, cdc),
 		GetCmdUnbond(storeKey, cdc),
+		GetCmdRotateConsPubKey(cdc),
+		GetCmdTransferDelegation(cdc),
 	)...)
 
 	return stakingTxCmd
@@ -245,6 +247,83 @@ $ %s tx staking unbond cosmosvaloper1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj 100s
 	}
 }
 
+// GetCmdRotateConsPubKey implements the rotate consensus pubkey command.
+func GetCmdRotateConsPubKey(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "rotate-cons-pubkey [new-pubkey]",
+		Short: "Replace the consensus pubkey of your validator",
+		Args:  cobra.ExactArgs(1),
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Replace the consensus pubkey of the validator operated by the sending account.
+The rotation fee is burned from the operator account. The old pubkey stays
+reserved for the validator, and can still be slashed, until the unbonding
+time has passed.
+
+Example:
+$ %s tx staking rotate-cons-pubkey cosmosvalconspub1zcjduepq0vu2zgkgk49efa0nqwzndanq5m4c7pa3u4apz4g2r9gspqg6g9cs3k9cuf --from mykey
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(auth.DefaultTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			newPubKey, err := sdk.GetConsPubKeyBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			valAddr := cliCtx.GetFromAddress()
+			msg := types.NewMsgRotateConsPubKey(sdk.ValAddress(valAddr), newPubKey)
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+// GetCmdTransferDelegation implements the transfer delegation command.
+func GetCmdTransferDelegation(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "transfer-delegation [validator-addr] [recipient-addr] [amount]",
+		Short: "Transfer delegated shares of a validator to another account",
+		Args:  cobra.ExactArgs(3),
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Transfer an amount of bonded shares of a validator to another account
+without unbonding them. Pending rewards of both accounts are withdrawn first.
+Accounts with vesting coins can neither send nor receive delegations.
+
+Example:
+$ %s tx staking transfer-delegation cosmosvaloper1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj cosmos1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p 100stake --from mykey
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(auth.DefaultTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			delAddr := cliCtx.GetFromAddress()
+			valAddr, err := sdk.ValAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			recipientAddr, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+
+			amount, err := sdk.ParseCoin(args[2])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgTransferDelegation(delAddr, valAddr, recipientAddr, amount)
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
 //__________________________________________________________
 
 var (
@@ -87,6 +87,18 @@ func InitGenesis(ctx sdk.Context, keeper Keeper, accountKeeper types.AccountKeep
 		}
 	}
 
+	for _, rotation := range data.ConsPubKeyRotations {
+		keeper.SetConsPubKeyRotation(ctx, rotation)
+		keeper.InsertConsPubKeyRotationQueue(ctx, rotation)
+
+		// keep the old consensus pubkey reserved for the validator
+		validator, found := keeper.GetValidator(ctx, rotation.OperatorAddress)
+		if found {
+			validator.ConsPubKey = rotation.OldConsPubKey
+			keeper.SetValidatorByConsAddr(ctx, validator)
+		}
+	}
+
 	bondedCoins := sdk.NewCoins(sdk.NewCoin(data.Params.BondDenom, bondedTokens))
 	notBondedCoins := sdk.NewCoins(sdk.NewCoin(data.Params.BondDenom, notBondedTokens))
 
@@ -154,6 +166,11 @@ func ExportGenesis(ctx sdk.Context, keeper Keeper) types.GenesisState {
 		redelegations = append(redelegations, red)
 		return false
 	})
+	var rotations types.ConsPubKeyRotations
+	keeper.IterateConsPubKeyRotations(ctx, func(_ int64, rotation types.ConsPubKeyRotation) (stop bool) {
+		rotations = append(rotations, rotation)
+		return false
+	})
 	var lastValidatorPowers []types.LastValidatorPower
 	keeper.IterateLastValidatorPowers(ctx, func(addr sdk.ValAddress, power int64) (stop bool) {
 		lastValidatorPowers = append(lastValidatorPowers, types.LastValidatorPower{addr, power})
@@ -169,6 +186,7 @@ func ExportGenesis(ctx sdk.Context, keeper Keeper) types.GenesisState {
 		UnbondingDelegations: unbondingDelegations,
 		Redelegations:        redelegations,
 		Exported:             true,
+		ConsPubKeyRotations:  rotations,
 	}
 }
 
@@ -198,6 +216,10 @@ func ValidateGenesis(data types.GenesisState) error {
 	if err != nil {
 		return err
 	}
+	err = validateGenesisStateConsPubKeyRotations(data.ConsPubKeyRotations)
+	if err != nil {
+		return err
+	}
 
 	return nil
 }
@@ -220,3 +242,16 @@ func validateGenesisStateValidators(validators []types.Validator) (err error) {
 	}
 	return
 }
+
+func validateGenesisStateConsPubKeyRotations(rotations types.ConsPubKeyRotations) error {
+	for _, rotation := range rotations {
+		if rotation.OperatorAddress.Empty() {
+			return fmt.Errorf("consensus pubkey rotation at height %d has no operator address", rotation.Height)
+		}
+		if rotation.OldConsPubKey == nil || rotation.NewConsPubKey == nil {
+			return fmt.Errorf("consensus pubkey rotation of validator %s at height %d is missing a pubkey",
+				rotation.OperatorAddress, rotation.Height)
+		}
+	}
+	return nil
+}
@@ -13,6 +13,7 @@ import (
 	tmtypes "github.com/tendermint/tendermint/types"
 
 	sdk "github.com/shinecloudfoundation/shinecloudnet/types"
+	"github.com/shinecloudfoundation/shinecloudnet/x/auth"
 	keep "github.com/shinecloudfoundation/shinecloudnet/x/staking/keeper"
 	"github.com/shinecloudfoundation/shinecloudnet/x/staking/types"
 )
@@ -1324,3 +1325,182 @@ func TestInvalidCoinDenom(t *testing.T) {
 	got = handleMsgBeginRedelegate(ctx, msgRedelegate, keeper)
 	require.True(t, got.IsOK())
 }
+
+func TestRotateConsPubKey(t *testing.T) {
+	initPower := int64(1000)
+	ctx, accKeeper, keeper, supplyKeeper := keep.CreateTestInput(t, false, initPower)
+	params := keeper.GetParams(ctx)
+	params.UnbondingTime = time.Hour
+	keeper.SetParams(ctx, params)
+
+	validatorAddr := sdk.ValAddress(keep.Addrs[0])
+	oldPubKey, newPubKey := keep.PKs[0], keep.PKs[1]
+	bondAmt := sdk.TokensFromConsensusPower(10)
+
+	msgCreateValidator := NewTestMsgCreateValidator(validatorAddr, oldPubKey, bondAmt)
+	got := handleMsgCreateValidator(ctx, msgCreateValidator, keeper)
+	require.True(t, got.IsOK(), "expected create-validator to be ok, got %v", got)
+	EndBlocker(ctx, keeper)
+
+	// a key in use by a validator cannot be rotated to
+	got = handleMsgRotateConsPubKey(ctx, NewMsgRotateConsPubKey(validatorAddr, oldPubKey), keeper)
+	require.False(t, got.IsOK())
+
+	balance := accKeeper.GetAccount(ctx, keep.Addrs[0]).GetCoins()
+	supplyBefore := supplyKeeper.GetSupply(ctx).GetTotal()
+
+	got = handleMsgRotateConsPubKey(ctx, NewMsgRotateConsPubKey(validatorAddr, newPubKey), keeper)
+	require.True(t, got.IsOK(), "expected rotate-cons-pubkey to be ok, got %v", got)
+
+	// the rotation fee is burned from the operator
+	fee := keeper.ConsPubKeyRotationFee(ctx)
+	require.Equal(t, balance.Sub(fee), accKeeper.GetAccount(ctx, keep.Addrs[0]).GetCoins())
+	require.Equal(t, supplyBefore.Sub(fee), supplyKeeper.GetSupply(ctx).GetTotal())
+
+	validator, found := keeper.GetValidator(ctx, validatorAddr)
+	require.True(t, found)
+	require.True(t, newPubKey.Equals(validator.ConsPubKey))
+
+	// Tendermint removes the old pubkey and adds the new one
+	updates := EndBlocker(ctx, keeper)
+	require.Equal(t, []abci.ValidatorUpdate{
+		{PubKey: tmtypes.TM2PB.PubKey(oldPubKey), Power: 0},
+		validator.ABCIValidatorUpdate(),
+	}, updates)
+	require.Empty(t, EndBlocker(ctx, keeper))
+
+	// both consensus addresses map to the validator within the unbonding time
+	_, found = keeper.GetValidatorByConsAddr(ctx, sdk.ConsAddress(oldPubKey.Address()))
+	require.True(t, found)
+	_, found = keeper.GetValidatorByConsAddr(ctx, sdk.ConsAddress(newPubKey.Address()))
+	require.True(t, found)
+
+	// the rotation limit is reached
+	got = handleMsgRotateConsPubKey(ctx, NewMsgRotateConsPubKey(validatorAddr, keep.PKs[2]), keeper)
+	require.False(t, got.IsOK())
+	require.Len(t, keeper.GetValidatorConsPubKeyRotations(ctx, validatorAddr), 1)
+
+	// the old pubkey is released once the rotation matures
+	ctx = ctx.WithBlockTime(ctx.BlockHeader().Time.Add(params.UnbondingTime))
+	EndBlocker(ctx, keeper)
+	_, found = keeper.GetValidatorByConsAddr(ctx, sdk.ConsAddress(oldPubKey.Address()))
+	require.False(t, found)
+	require.Empty(t, keeper.GetValidatorConsPubKeyRotations(ctx, validatorAddr))
+
+	got = handleMsgRotateConsPubKey(ctx, NewMsgRotateConsPubKey(validatorAddr, keep.PKs[2]), keeper)
+	require.True(t, got.IsOK(), "expected rotate-cons-pubkey to be ok, got %v", got)
+}
+
+func TestRotateConsPubKeyUnbondingValidator(t *testing.T) {
+	ctx, _, keeper, _ := keep.CreateTestInput(t, false, 1000)
+	params := keeper.GetParams(ctx)
+	params.MaxValidators = 1
+	keeper.SetParams(ctx, params)
+
+	validatorAddr, validatorAddr2 := sdk.ValAddress(keep.Addrs[0]), sdk.ValAddress(keep.Addrs[1])
+	oldPubKey, newPubKey := keep.PKs[0], keep.PKs[2]
+
+	got := handleMsgCreateValidator(ctx, NewTestMsgCreateValidator(validatorAddr, oldPubKey, sdk.TokensFromConsensusPower(10)), keeper)
+	require.True(t, got.IsOK(), "expected create-validator to be ok, got %v", got)
+	EndBlocker(ctx, keeper)
+
+	// a stronger validator kicks the rotated validator out of the set in the
+	// same block, Tendermint must be told to remove the old pubkey
+	got = handleMsgCreateValidator(ctx, NewTestMsgCreateValidator(validatorAddr2, keep.PKs[1], sdk.TokensFromConsensusPower(20)), keeper)
+	require.True(t, got.IsOK(), "expected create-validator to be ok, got %v", got)
+	got = handleMsgRotateConsPubKey(ctx, NewMsgRotateConsPubKey(validatorAddr, newPubKey), keeper)
+	require.True(t, got.IsOK(), "expected rotate-cons-pubkey to be ok, got %v", got)
+
+	updates := EndBlocker(ctx, keeper)
+	require.Len(t, updates, 2)
+	require.Contains(t, updates, abci.ValidatorUpdate{PubKey: tmtypes.TM2PB.PubKey(oldPubKey), Power: 0})
+	require.NotContains(t, updates, abci.ValidatorUpdate{PubKey: tmtypes.TM2PB.PubKey(newPubKey), Power: 0})
+}
+
+func TestTransferDelegation(t *testing.T) {
+	initPower := int64(1000)
+	ctx, _, keeper, _ := keep.CreateTestInput(t, false, initPower)
+
+	validatorAddr := sdk.ValAddress(keep.Addrs[0])
+	delegatorAddr, recipientAddr := keep.Addrs[1], keep.Addrs[2]
+	bondAmt := sdk.TokensFromConsensusPower(10)
+
+	msgCreateValidator := NewTestMsgCreateValidator(validatorAddr, keep.PKs[0], bondAmt)
+	got := handleMsgCreateValidator(ctx, msgCreateValidator, keeper)
+	require.True(t, got.IsOK(), "expected create-validator to be ok, got %v", got)
+
+	msgDelegate := NewTestMsgDelegate(delegatorAddr, validatorAddr, bondAmt)
+	got = handleMsgDelegate(ctx, msgDelegate, keeper)
+	require.True(t, got.IsOK(), "expected delegation to be ok, got %v", got)
+	EndBlocker(ctx, keeper)
+
+	validatorBefore, found := keeper.GetValidator(ctx, validatorAddr)
+	require.True(t, found)
+
+	// a delegator cannot transfer more than it has delegated
+	tooMuch := sdk.NewCoin(sdk.DefaultBondDenom, bondAmt.AddRaw(1))
+	got = handleMsgTransferDelegation(ctx, NewMsgTransferDelegation(delegatorAddr, validatorAddr, recipientAddr, tooMuch), keeper)
+	require.False(t, got.IsOK())
+
+	// transfer half of the delegation
+	half := sdk.NewCoin(sdk.DefaultBondDenom, bondAmt.QuoRaw(2))
+	got = handleMsgTransferDelegation(ctx, NewMsgTransferDelegation(delegatorAddr, validatorAddr, recipientAddr, half), keeper)
+	require.True(t, got.IsOK(), "expected transfer-delegation to be ok, got %v", got)
+
+	delegation, found := keeper.GetDelegation(ctx, delegatorAddr, validatorAddr)
+	require.True(t, found)
+	require.Equal(t, half.Amount.ToDec(), delegation.Shares)
+	recipientDelegation, found := keeper.GetDelegation(ctx, recipientAddr, validatorAddr)
+	require.True(t, found)
+	require.Equal(t, half.Amount.ToDec(), recipientDelegation.Shares)
+
+	// the validator is not affected
+	validator, found := keeper.GetValidator(ctx, validatorAddr)
+	require.True(t, found)
+	require.Equal(t, validatorBefore.Tokens, validator.Tokens)
+	require.Equal(t, validatorBefore.DelegatorShares, validator.DelegatorShares)
+	require.Empty(t, EndBlocker(ctx, keeper))
+
+	// transferring the rest removes the delegation of the sender
+	got = handleMsgTransferDelegation(ctx, NewMsgTransferDelegation(delegatorAddr, validatorAddr, recipientAddr, half), keeper)
+	require.True(t, got.IsOK(), "expected transfer-delegation to be ok, got %v", got)
+	_, found = keeper.GetDelegation(ctx, delegatorAddr, validatorAddr)
+	require.False(t, found)
+	recipientDelegation, found = keeper.GetDelegation(ctx, recipientAddr, validatorAddr)
+	require.True(t, found)
+	require.Equal(t, bondAmt.ToDec(), recipientDelegation.Shares)
+}
+
+func TestTransferDelegationVestingAccount(t *testing.T) {
+	initPower := int64(1000)
+	ctx, accKeeper, keeper, _ := keep.CreateTestInput(t, false, initPower)
+
+	validatorAddr := sdk.ValAddress(keep.Addrs[0])
+	delegatorAddr, vestingAddr := keep.Addrs[1], keep.Addrs[2]
+	bondAmt := sdk.TokensFromConsensusPower(10)
+
+	msgCreateValidator := NewTestMsgCreateValidator(validatorAddr, keep.PKs[0], bondAmt)
+	got := handleMsgCreateValidator(ctx, msgCreateValidator, keeper)
+	require.True(t, got.IsOK(), "expected create-validator to be ok, got %v", got)
+
+	endTime := ctx.BlockHeader().Time.Add(time.Hour)
+	baseAcc := accKeeper.GetAccount(ctx, vestingAddr).(*auth.BaseAccount)
+	accKeeper.SetAccount(ctx, auth.NewDelayedVestingAccount(baseAcc, endTime.Unix()))
+
+	for _, delAddr := range []sdk.AccAddress{delegatorAddr, vestingAddr} {
+		got = handleMsgDelegate(ctx, NewTestMsgDelegate(delAddr, validatorAddr, bondAmt), keeper)
+		require.True(t, got.IsOK(), "expected delegation to be ok, got %v", got)
+	}
+
+	// delegations can neither be sent from nor to an account with vesting coins
+	amount := sdk.NewCoin(sdk.DefaultBondDenom, bondAmt)
+	got = handleMsgTransferDelegation(ctx, NewMsgTransferDelegation(vestingAddr, validatorAddr, delegatorAddr, amount), keeper)
+	require.False(t, got.IsOK())
+	got = handleMsgTransferDelegation(ctx, NewMsgTransferDelegation(delegatorAddr, validatorAddr, vestingAddr, amount), keeper)
+	require.False(t, got.IsOK())
+
+	// once all coins have vested the delegation can be transferred
+	ctx = ctx.WithBlockTime(endTime)
+	got = handleMsgTransferDelegation(ctx, NewMsgTransferDelegation(vestingAddr, validatorAddr, delegatorAddr, amount), keeper)
+	require.True(t, got.IsOK(), "expected transfer-delegation to be ok, got %v", got)
+}
@@ -6,6 +6,7 @@ import (
 	"time"
 
 	sdk "github.com/shinecloudfoundation/shinecloudnet/types"
+	authexported "github.com/shinecloudfoundation/shinecloudnet/x/auth/exported"
 	"github.com/shinecloudfoundation/shinecloudnet/x/staking/types"
 )
 
@@ -782,6 +783,89 @@ func (k Keeper) CompleteRedelegation(ctx sdk.Context, delAddr sdk.AccAddress,
 	return nil
 }
 
+// TransferDelegation moves delegation shares of a validator from one delegator
+// to another without unbonding them. The validator's tokens and shares are
+// left untouched, so neither its power nor the staking pools change.
+func (k Keeper) TransferDelegation(ctx sdk.Context, delAddr, recipientAddr sdk.AccAddress,
+	valAddr sdk.ValAddress, shares sdk.Dec) sdk.Error {
+
+	if delAddr.Equals(recipientAddr) {
+		return types.ErrSelfDelegationTransfer(k.Codespace())
+	}
+
+	validator, found := k.GetValidator(ctx, valAddr)
+	if !found {
+		return types.ErrNoValidatorFound(k.Codespace())
+	}
+
+	delegation, found := k.GetDelegation(ctx, delAddr, valAddr)
+	if !found {
+		return types.ErrNoDelegation(k.Codespace())
+	}
+	if delegation.Shares.LT(shares) {
+		return types.ErrNotEnoughDelegationShares(k.Codespace(), delegation.Shares.String())
+	}
+
+	// shares received through a redelegation may still be slashed for
+	// infractions of the source validator, so they must stay with the delegator
+	if k.HasReceivingRedelegation(ctx, delAddr, valAddr) {
+		return types.ErrTransferDelegationRedelegating(k.Codespace())
+	}
+
+	// vesting accounts track their delegated coins, which can't be
+	// reconciled with a delegation that changes hands
+	if k.hasVestingCoins(ctx, delAddr) || k.hasVestingCoins(ctx, recipientAddr) {
+		return types.ErrTransferDelegationVesting(k.Codespace())
+	}
+
+	// withdraw the rewards of the sender before its shares change
+	k.BeforeDelegationSharesModified(ctx, delAddr, valAddr)
+	delegation.Shares = delegation.Shares.Sub(shares)
+
+	// if the delegation is the operator of the validator and the transfer
+	// decreases the validator's self delegation below their minimum, jail the
+	// validator
+	isValidatorOperator := delegation.DelegatorAddress.Equals(validator.OperatorAddress)
+	if isValidatorOperator && !validator.Jailed &&
+		validator.TokensFromShares(delegation.Shares).TruncateInt().LT(validator.MinSelfDelegation) {
+
+		k.jailValidator(ctx, validator)
+	}
+
+	if delegation.Shares.IsZero() {
+		k.RemoveDelegation(ctx, delegation)
+	} else {
+		k.SetDelegation(ctx, delegation)
+		k.AfterDelegationModified(ctx, delegation.DelegatorAddress, delegation.ValidatorAddress)
+	}
+
+	// get or create the delegation of the recipient
+	recipientDelegation, found := k.GetDelegation(ctx, recipientAddr, valAddr)
+	if found {
+		k.BeforeDelegationSharesModified(ctx, recipientAddr, valAddr)
+	} else {
+		recipientDelegation = types.NewDelegation(recipientAddr, valAddr, sdk.ZeroDec())
+		k.BeforeDelegationCreated(ctx, recipientAddr, valAddr)
+	}
+
+	recipientDelegation.Shares = recipientDelegation.Shares.Add(shares)
+	k.SetDelegation(ctx, recipientDelegation)
+	k.AfterDelegationModified(ctx, recipientDelegation.DelegatorAddress, recipientDelegation.ValidatorAddress)
+
+	return nil
+}
+
+// hasVestingCoins returns true if the account at the given address is a
+// vesting account which has not fully vested yet
+func (k Keeper) hasVestingCoins(ctx sdk.Context, addr sdk.AccAddress) bool {
+	acc := k.accountKeeper.GetAccount(ctx, addr)
+	vacc, ok := acc.(authexported.VestingAccount)
+	if !ok {
+		return false
+	}
+	return !vacc.GetVestingCoins(ctx.BlockHeader().Time).IsZero()
+}
+
 // ValidateUnbondAmount validates that a given unbond or redelegation amount is
 // valied based on upon the converted shares. If the amount is valid, the total
 // amount of respective shares is returned, otherwise an error is returned.
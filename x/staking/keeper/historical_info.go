@@ -0,0 +1,62 @@
+package keeper
+
+import (
+	sdk "github.com/shinecloudfoundation/shinecloudnet/types"
+	"github.com/shinecloudfoundation/shinecloudnet/x/staking/types"
+)
+
+// return the historical info of a given height
+func (k Keeper) GetHistoricalInfo(ctx sdk.Context, height int64) (hi types.HistoricalInfo, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	value := store.Get(types.GetHistoricalInfoKey(height))
+	if value == nil {
+		return hi, false
+	}
+
+	hi = types.MustUnmarshalHistoricalInfo(k.cdc, value)
+	return hi, true
+}
+
+// set the historical info of a given height
+func (k Keeper) SetHistoricalInfo(ctx sdk.Context, height int64, hi types.HistoricalInfo) {
+	store := ctx.KVStore(k.storeKey)
+	bz := types.MustMarshalHistoricalInfo(k.cdc, hi)
+	store.Set(types.GetHistoricalInfoKey(height), bz)
+}
+
+// delete the historical info of a given height
+func (k Keeper) DeleteHistoricalInfo(ctx sdk.Context, height int64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetHistoricalInfoKey(height))
+}
+
+// TrackHistoricalInfo saves the header and the bonded validator set of the
+// current height, and prunes the entries which are older than the
+// HistoricalEntries parameter allows to keep.
+func (k Keeper) TrackHistoricalInfo(ctx sdk.Context) {
+	entryNum := k.HistoricalEntries(ctx)
+
+	// Prune the entries that fall out of the window. The window may have
+	// shrunk since the last block through a parameter change, so keep deleting
+	// until an empty height is reached. The current height is not stored yet,
+	// so pruning starts below it at the latest.
+	pruneHeight := ctx.BlockHeight() - int64(entryNum)
+	if entryNum == 0 {
+		pruneHeight--
+	}
+	for i := pruneHeight; i >= 0; i-- {
+		if _, found := k.GetHistoricalInfo(ctx, i); !found {
+			break
+		}
+		k.DeleteHistoricalInfo(ctx, i)
+	}
+
+	// if there is no need to persist historical info, return
+	if entryNum == 0 {
+		return
+	}
+
+	lastVals := k.GetLastValidators(ctx)
+	historicalEntry := types.NewHistoricalInfo(ctx.BlockHeader(), lastVals)
+	k.SetHistoricalInfo(ctx, ctx.BlockHeight(), historicalEntry)
+}
@@ -0,0 +1,104 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	sdk "github.com/shinecloudfoundation/shinecloudnet/types"
+	"github.com/shinecloudfoundation/shinecloudnet/x/staking/types"
+)
+
+func TestHistoricalInfo(t *testing.T) {
+	ctx, _, keeper, _ := CreateTestInput(t, false, 10)
+	validators := make([]types.Validator, len(addrVals))
+
+	for i, valAddr := range addrVals {
+		validators[i] = types.NewValidator(valAddr, PKs[i], types.Description{})
+	}
+
+	hi := types.NewHistoricalInfo(ctx.BlockHeader(), validators)
+	keeper.SetHistoricalInfo(ctx, 2, hi)
+
+	recv, found := keeper.GetHistoricalInfo(ctx, 2)
+	require.True(t, found, "HistoricalInfo not found after set")
+	require.Equal(t, hi, recv, "HistoricalInfo not equal")
+	require.NoError(t, recv.ValidateBasic(), "ValidateBasic on HistoricalInfo failed")
+
+	keeper.DeleteHistoricalInfo(ctx, 2)
+
+	recv, found = keeper.GetHistoricalInfo(ctx, 2)
+	require.False(t, found, "HistoricalInfo found after delete")
+	require.Equal(t, types.HistoricalInfo{}, recv, "HistoricalInfo is not empty")
+}
+
+func TestTrackHistoricalInfo(t *testing.T) {
+	ctx, _, keeper, _ := CreateTestInput(t, false, 10)
+
+	// set historical entries in params to 5
+	params := types.DefaultParams()
+	params.HistoricalEntries = 5
+	keeper.SetParams(ctx, params)
+
+	// set historical info at 5, 4 which should be pruned
+	// and check that it has been stored
+	h4 := abci.Header{ChainID: "HelloChain", Height: 4}
+	h5 := abci.Header{ChainID: "HelloChain", Height: 5}
+	valSet := []types.Validator{
+		types.NewValidator(sdk.ValAddress(Addrs[0]), PKs[0], types.Description{}),
+		types.NewValidator(sdk.ValAddress(Addrs[1]), PKs[1], types.Description{}),
+	}
+	hi4 := types.NewHistoricalInfo(h4, valSet)
+	hi5 := types.NewHistoricalInfo(h5, valSet)
+	keeper.SetHistoricalInfo(ctx, 4, hi4)
+	keeper.SetHistoricalInfo(ctx, 5, hi5)
+	recv, found := keeper.GetHistoricalInfo(ctx, 4)
+	require.True(t, found)
+	require.Equal(t, hi4, recv)
+	recv, found = keeper.GetHistoricalInfo(ctx, 5)
+	require.True(t, found)
+	require.Equal(t, hi5, recv)
+
+	// set the bonded validators with different powers
+	val1 := types.NewValidator(sdk.ValAddress(Addrs[2]), PKs[2], types.Description{})
+	val1, _ = val1.AddTokensFromDel(sdk.TokensFromConsensusPower(10))
+	val1 = TestingUpdateValidator(keeper, ctx, val1, true)
+	val2 := types.NewValidator(sdk.ValAddress(Addrs[3]), PKs[3], types.Description{})
+	val2, _ = val2.AddTokensFromDel(sdk.TokensFromConsensusPower(20))
+	val2 = TestingUpdateValidator(keeper, ctx, val2, true)
+
+	// set the header and track the historical info at height 10
+	header := abci.Header{ChainID: "HelloChain", Height: 10}
+	ctx = ctx.WithBlockHeader(header)
+	keeper.TrackHistoricalInfo(ctx)
+
+	// the validators are sorted by descending power
+	recv, found = keeper.GetHistoricalInfo(ctx, 10)
+	require.True(t, found, "GetHistoricalInfo failed after BeginBlock")
+	require.Equal(t, header, recv.Header, "header not set correctly")
+	require.Len(t, recv.ValSet, 2)
+	require.True(ValEq(t, val2, recv.ValSet[0]))
+	require.True(ValEq(t, val1, recv.ValSet[1]))
+	require.NoError(t, recv.ValidateBasic())
+
+	// the entries out of the window are pruned
+	recv, found = keeper.GetHistoricalInfo(ctx, 4)
+	require.False(t, found, "GetHistoricalInfo did not prune earlier height")
+	require.Equal(t, types.HistoricalInfo{}, recv, "GetHistoricalInfo at height 4 is not empty after prune")
+	recv, found = keeper.GetHistoricalInfo(ctx, 5)
+	require.False(t, found, "GetHistoricalInfo did not prune first prune height")
+	require.Equal(t, types.HistoricalInfo{}, recv, "GetHistoricalInfo at height 5 is not empty after prune")
+
+	// nothing is stored once historical entries are disabled, and the
+	// remaining entries are pruned
+	params.HistoricalEntries = 0
+	keeper.SetParams(ctx, params)
+	ctx = ctx.WithBlockHeader(abci.Header{ChainID: "HelloChain", Height: 11})
+	keeper.TrackHistoricalInfo(ctx)
+	_, found = keeper.GetHistoricalInfo(ctx, 11)
+	require.False(t, found)
+	_, found = keeper.GetHistoricalInfo(ctx, 10)
+	require.False(t, found)
+}
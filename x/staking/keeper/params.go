@@ -43,6 +43,28 @@ func (k Keeper) BondDenom(ctx sdk.Context) (res string) {
 	return
 }
 
+// MaxConsPubKeyRotations - Maximum number of consensus pubkey rotations per
+// validator within the unbonding time
+func (k Keeper) MaxConsPubKeyRotations(ctx sdk.Context) (res uint16) {
+	res = types.DefaultMaxConsPubKeyRotations
+	k.paramstore.GetIfExists(ctx, types.KeyMaxConsPubKeyRotations, &res)
+	return
+}
+
+// ConsPubKeyRotationFee - Fee burned on every consensus pubkey rotation
+func (k Keeper) ConsPubKeyRotationFee(ctx sdk.Context) (res sdk.Coins) {
+	res = sdk.NewCoins(sdk.NewCoin(k.BondDenom(ctx), types.DefaultConsPubKeyRotationFee.AmountOf(sdk.DefaultBondDenom)))
+	k.paramstore.GetIfExists(ctx, types.KeyConsPubKeyRotationFee, &res)
+	return
+}
+
+// HistoricalEntries - Number of recent blocks for which historical info is kept
+func (k Keeper) HistoricalEntries(ctx sdk.Context) (res uint16) {
+	res = types.DefaultHistoricalEntries
+	k.paramstore.GetIfExists(ctx, types.KeyHistoricalEntries, &res)
+	return
+}
+
 // Get all parameteras as types.Params
 func (k Keeper) GetParams(ctx sdk.Context) types.Params {
 	return types.NewParams(
@@ -50,6 +72,9 @@ func (k Keeper) GetParams(ctx sdk.Context) types.Params {
 		k.MaxValidators(ctx),
 		k.MaxEntries(ctx),
 		k.BondDenom(ctx),
+		k.MaxConsPubKeyRotations(ctx),
+		k.ConsPubKeyRotationFee(ctx),
+		k.HistoricalEntries(ctx),
 	)
 }
 
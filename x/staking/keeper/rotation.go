@@ -0,0 +1,225 @@
+package keeper
+
+import (
+	"time"
+
+	"github.com/tendermint/tendermint/crypto"
+
+	sdk "github.com/shinecloudfoundation/shinecloudnet/types"
+	"github.com/shinecloudfoundation/shinecloudnet/x/staking/types"
+)
+
+// return a given consensus pubkey rotation
+func (k Keeper) GetConsPubKeyRotation(ctx sdk.Context,
+	valAddr sdk.ValAddress, height int64) (rotation types.ConsPubKeyRotation, found bool) {
+
+	store := ctx.KVStore(k.storeKey)
+	value := store.Get(types.GetConsPubKeyRotationKey(valAddr, height))
+	if value == nil {
+		return rotation, false
+	}
+
+	rotation = types.MustUnmarshalConsPubKeyRotation(k.cdc, value)
+	return rotation, true
+}
+
+// set a consensus pubkey rotation
+func (k Keeper) SetConsPubKeyRotation(ctx sdk.Context, rotation types.ConsPubKeyRotation) {
+	store := ctx.KVStore(k.storeKey)
+	bz := types.MustMarshalConsPubKeyRotation(k.cdc, rotation)
+	store.Set(types.GetConsPubKeyRotationKey(rotation.OperatorAddress, rotation.Height), bz)
+}
+
+// remove a consensus pubkey rotation
+func (k Keeper) RemoveConsPubKeyRotation(ctx sdk.Context, rotation types.ConsPubKeyRotation) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetConsPubKeyRotationKey(rotation.OperatorAddress, rotation.Height))
+}
+
+// return all consensus pubkey rotations of a validator which have not matured yet
+func (k Keeper) GetValidatorConsPubKeyRotations(ctx sdk.Context,
+	valAddr sdk.ValAddress) (rotations types.ConsPubKeyRotations) {
+
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.GetConsPubKeyRotationsKey(valAddr))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		rotation := types.MustUnmarshalConsPubKeyRotation(k.cdc, iterator.Value())
+		rotations = append(rotations, rotation)
+	}
+	return rotations
+}
+
+// iterate through all consensus pubkey rotations
+func (k Keeper) IterateConsPubKeyRotations(ctx sdk.Context,
+	fn func(index int64, rotation types.ConsPubKeyRotation) (stop bool)) {
+
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.ConsPubKeyRotationKey)
+	defer iterator.Close()
+
+	for i := int64(0); iterator.Valid(); iterator.Next() {
+		rotation := types.MustUnmarshalConsPubKeyRotation(k.cdc, iterator.Value())
+		if stop := fn(i, rotation); stop {
+			break
+		}
+		i++
+	}
+}
+
+//_______________________________________________________________________
+// Consensus PubKey Rotation Queue
+
+// gets a specific consensus pubkey rotation queue timeslice. A timeslice is a
+// slice of ValAddresses of validators whose rotations mature at a certain time.
+func (k Keeper) GetConsPubKeyRotationQueueTimeSlice(ctx sdk.Context, timestamp time.Time) (valAddrs []sdk.ValAddress) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetConsPubKeyRotationQueueTimeKey(timestamp))
+	if bz == nil {
+		return []sdk.ValAddress{}
+	}
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &valAddrs)
+	return valAddrs
+}
+
+// Sets a specific consensus pubkey rotation queue timeslice.
+func (k Keeper) SetConsPubKeyRotationQueueTimeSlice(ctx sdk.Context, timestamp time.Time, keys []sdk.ValAddress) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshalBinaryLengthPrefixed(keys)
+	store.Set(types.GetConsPubKeyRotationQueueTimeKey(timestamp), bz)
+}
+
+// Insert a rotation to the appropriate timeslice in the consensus pubkey rotation queue
+func (k Keeper) InsertConsPubKeyRotationQueue(ctx sdk.Context, rotation types.ConsPubKeyRotation) {
+	timeSlice := k.GetConsPubKeyRotationQueueTimeSlice(ctx, rotation.CompletionTime)
+	keys := append(timeSlice, rotation.OperatorAddress)
+	k.SetConsPubKeyRotationQueueTimeSlice(ctx, rotation.CompletionTime, keys)
+}
+
+// Returns all the consensus pubkey rotation queue timeslices from time 0 until endTime
+func (k Keeper) ConsPubKeyRotationQueueIterator(ctx sdk.Context, endTime time.Time) sdk.Iterator {
+	store := ctx.KVStore(k.storeKey)
+	return store.Iterator(types.ConsPubKeyRotationQueueKey,
+		sdk.InclusiveEndBytes(types.GetConsPubKeyRotationQueueTimeKey(endTime)))
+}
+
+// Completes all the consensus pubkey rotations that have finished their
+// unbonding period, releasing the old consensus pubkeys
+func (k Keeper) CompleteAllMatureConsPubKeyRotations(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	currTime := ctx.BlockHeader().Time
+	rotationTimesliceIterator := k.ConsPubKeyRotationQueueIterator(ctx, currTime)
+	defer rotationTimesliceIterator.Close()
+
+	for ; rotationTimesliceIterator.Valid(); rotationTimesliceIterator.Next() {
+		timeslice := []sdk.ValAddress{}
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(rotationTimesliceIterator.Value(), &timeslice)
+
+		for _, valAddr := range timeslice {
+			for _, rotation := range k.GetValidatorConsPubKeyRotations(ctx, valAddr) {
+				if !rotation.IsMature(currTime) {
+					continue
+				}
+
+				// the old consensus address can no longer be slashed, release it
+				store.Delete(types.GetValidatorByConsAddrKey(sdk.ConsAddress(rotation.OldConsPubKey.Address())))
+				k.RemoveConsPubKeyRotation(ctx, rotation)
+			}
+		}
+
+		store.Delete(rotationTimesliceIterator.Key())
+	}
+}
+
+//_______________________________________________________________________
+// Pending Consensus PubKey Rotations
+
+// get the consensus pubkey a validator rotated away from in the current block
+func (k Keeper) getPendingConsPubKeyRotation(ctx sdk.Context, valAddr sdk.ValAddress) (pubKey crypto.PubKey, found bool) {
+	store := ctx.TransientStore(k.storeTKey)
+	bz := store.Get(types.GetPendingConsPubKeyRotationKey(valAddr))
+	if bz == nil {
+		return nil, false
+	}
+	k.cdc.MustUnmarshalBinaryBare(bz, &pubKey)
+	return pubKey, true
+}
+
+// set the consensus pubkey a validator rotated away from in the current block
+func (k Keeper) setPendingConsPubKeyRotation(ctx sdk.Context, valAddr sdk.ValAddress, pubKey crypto.PubKey) {
+	store := ctx.TransientStore(k.storeTKey)
+	store.Set(types.GetPendingConsPubKeyRotationKey(valAddr), k.cdc.MustMarshalBinaryBare(pubKey))
+}
+
+// delete all the consensus pubkeys validators rotated away from in the current block
+func (k Keeper) clearPendingConsPubKeyRotations(ctx sdk.Context) {
+	store := ctx.TransientStore(k.storeTKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.PendingConsPubKeyRotationKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		store.Delete(iterator.Key())
+	}
+}
+
+//_______________________________________________________________________
+
+// RotateConsPubKey replaces the consensus pubkey of a validator. The old
+// pubkey keeps mapping to the validator until the unbonding time has passed,
+// so that evidence of infractions committed with it can still be slashed.
+// The validator set updates for Tendermint are emitted at the end of the
+// block by ApplyAndReturnValidatorSetUpdates.
+func (k Keeper) RotateConsPubKey(ctx sdk.Context, valAddr sdk.ValAddress, newPubKey crypto.PubKey) sdk.Error {
+	validator, found := k.GetValidator(ctx, valAddr)
+	if !found {
+		return types.ErrNoValidatorFound(k.Codespace())
+	}
+
+	// the new pubkey must not be in use, nor be reserved by a pending rotation
+	newConsAddr := sdk.ConsAddress(newPubKey.Address())
+	if _, found := k.GetValidatorByConsAddr(ctx, newConsAddr); found {
+		return types.ErrValidatorPubKeyExists(k.Codespace())
+	}
+
+	maxRotations := k.MaxConsPubKeyRotations(ctx)
+	if maxRotations == 0 {
+		return types.ErrConsPubKeyRotationDisabled(k.Codespace())
+	}
+	if _, found := k.getPendingConsPubKeyRotation(ctx, valAddr); found {
+		return types.ErrConsPubKeyAlreadyRotated(k.Codespace())
+	}
+	if len(k.GetValidatorConsPubKeyRotations(ctx, valAddr)) >= int(maxRotations) {
+		return types.ErrMaxConsPubKeyRotations(k.Codespace(), maxRotations)
+	}
+
+	// burn the rotation fee
+	fee := k.ConsPubKeyRotationFee(ctx)
+	if !fee.IsZero() {
+		err := k.supplyKeeper.SendCoinsFromAccountToModule(ctx, sdk.AccAddress(valAddr), types.NotBondedPoolName, fee)
+		if err != nil {
+			return err
+		}
+		if err := k.supplyKeeper.BurnCoins(ctx, types.NotBondedPoolName, fee); err != nil {
+			panic(err)
+		}
+	}
+
+	oldPubKey := validator.ConsPubKey
+	oldConsAddr := validator.ConsAddress()
+
+	rotation := types.NewConsPubKeyRotation(valAddr, oldPubKey, newPubKey,
+		ctx.BlockHeight(), ctx.BlockHeader().Time.Add(k.UnbondingTime(ctx)))
+	k.SetConsPubKeyRotation(ctx, rotation)
+	k.InsertConsPubKeyRotationQueue(ctx, rotation)
+	k.setPendingConsPubKeyRotation(ctx, valAddr, oldPubKey)
+
+	// the power index is keyed by operator address and does not change
+	validator.ConsPubKey = newPubKey
+	k.SetValidator(ctx, validator)
+	k.SetValidatorByConsAddr(ctx, validator)
+
+	k.AfterConsPubKeyRotated(ctx, oldConsAddr, newConsAddr, valAddr)
+
+	return nil
+}
@@ -6,6 +6,7 @@ import (
 	"sort"
 
 	abci "github.com/tendermint/tendermint/abci/types"
+	tmtypes "github.com/tendermint/tendermint/types"
 
 	sdk "github.com/shinecloudfoundation/shinecloudnet/types"
 	"github.com/shinecloudfoundation/shinecloudnet/x/staking/types"
@@ -80,8 +81,18 @@ func (k Keeper) ApplyAndReturnValidatorSetUpdates(ctx sdk.Context) (updates []ab
 		newPower := validator.ConsensusPower()
 		newPowerBytes := k.cdc.MustMarshalBinaryLengthPrefixed(newPower)
 
-		// update the validator set if power has changed
-		if !found || !bytes.Equal(oldPowerBytes, newPowerBytes) {
+		// a validator whose consensus pubkey was rotated in this block must
+		// be removed from the Tendermint set under its old pubkey
+		oldPubKey, rotated := k.getPendingConsPubKeyRotation(ctx, valAddr)
+		if rotated && found {
+			updates = append(updates, abci.ValidatorUpdate{
+				PubKey: tmtypes.TM2PB.PubKey(oldPubKey),
+				Power:  0,
+			})
+		}
+
+		// update the validator set if power or consensus pubkey has changed
+		if !found || !bytes.Equal(oldPowerBytes, newPowerBytes) || rotated {
 			updates = append(updates, validator.ABCIValidatorUpdate())
 
 			// set validator power on lookup index
@@ -112,10 +123,21 @@ func (k Keeper) ApplyAndReturnValidatorSetUpdates(ctx sdk.Context) (updates []ab
 		// delete from the bonded validator index
 		k.DeleteLastValidatorPower(ctx, validator.GetOperator())
 
-		// update the validator set
-		updates = append(updates, validator.ABCIValidatorUpdateZero())
+		// update the validator set, Tendermint only knows the old pubkey of a
+		// validator whose consensus pubkey was rotated in this block
+		if oldPubKey, rotated := k.getPendingConsPubKeyRotation(ctx, validator.GetOperator()); rotated {
+			updates = append(updates, abci.ValidatorUpdate{
+				PubKey: tmtypes.TM2PB.PubKey(oldPubKey),
+				Power:  0,
+			})
+		} else {
+			updates = append(updates, validator.ABCIValidatorUpdateZero())
+		}
 	}
 
+	// all consensus pubkey rotations of this block have been applied
+	k.clearPendingConsPubKeyRotations(ctx)
+
 	// Update the pools based on the recent updates in the validator set:
 	// - The tokens from the non-bonded candidates that enter the new validator set need to be transferred
 	// to the Bonded pool.
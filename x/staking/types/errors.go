@@ -54,6 +54,19 @@ func ErrValidatorJailed(codespace sdk.CodespaceType) sdk.Error {
 	return sdk.NewError(codespace, CodeInvalidValidator, "validator for this address is currently jailed")
 }
 
+func ErrConsPubKeyRotationDisabled(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidValidator, "consensus pubkey rotation is disabled")
+}
+
+func ErrMaxConsPubKeyRotations(codespace sdk.CodespaceType, max uint16) sdk.Error {
+	msg := fmt.Sprintf("validator cannot rotate its consensus pubkey more than %d times within the unbonding time, please wait for some rotations to mature", max)
+	return sdk.NewError(codespace, CodeInvalidValidator, msg)
+}
+
+func ErrConsPubKeyAlreadyRotated(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidValidator, "validator consensus pubkey was already rotated in this block")
+}
+
 func ErrBadRemoveValidator(codespace sdk.CodespaceType) sdk.Error {
 	return sdk.NewError(codespace, CodeInvalidValidator, "error removing validator")
 }
@@ -196,6 +209,19 @@ func ErrMaxRedelegationEntries(codespace sdk.CodespaceType) sdk.Error {
 		"too many redelegation entries in this delegator/src-validator/dst-validator trio, please wait for some entries to mature")
 }
 
+func ErrSelfDelegationTransfer(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidDelegation, "cannot transfer a delegation to the same delegator")
+}
+
+func ErrTransferDelegationRedelegating(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidDelegation,
+		"redelegation to this validator in progress, redelegations to this validator must complete before transferring the delegation")
+}
+
+func ErrTransferDelegationVesting(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidDelegation, "cannot transfer delegations from or to an account with vesting coins")
+}
+
 func ErrDelegatorShareExRateInvalid(codespace sdk.CodespaceType) sdk.Error {
 	return sdk.NewError(codespace, CodeInvalidDelegation,
 		"cannot delegate to validators with invalid (zero) ex-rate")
@@ -212,3 +238,7 @@ func ErrNeitherShareMsgsGiven(codespace sdk.CodespaceType) sdk.Error {
 func ErrMissingSignature(codespace sdk.CodespaceType) sdk.Error {
 	return sdk.NewError(codespace, CodeInvalidValidator, "missing signature")
 }
+
+func ErrNoHistoricalInfo(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidInput, "no historical info found")
+}
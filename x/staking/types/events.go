@@ -9,6 +9,8 @@ var (
 	EventTypeDelegate             = "delegate"
 	EventTypeUnbond               = "unbond"
 	EventTypeRedelegate           = "redelegate"
+	EventTypeRotateConsPubKey     = "rotate_cons_pubkey"
+	EventTypeTransferDelegation   = "transfer_delegation"
 
 	AttributeKeyValidator         = "validator"
 	AttributeKeyCommissionRate    = "commission_rate"
@@ -16,6 +18,9 @@ var (
 	AttributeKeySrcValidator      = "source_validator"
 	AttributeKeyDstValidator      = "destination_validator"
 	AttributeKeyDelegator         = "delegator"
+	AttributeKeyRecipient         = "recipient"
 	AttributeKeyCompletionTime    = "completion_time"
+	AttributeKeyOldConsPubKey     = "old_cons_pubkey"
+	AttributeKeyNewConsPubKey     = "new_cons_pubkey"
 	AttributeValueCategory        = ModuleName
 )
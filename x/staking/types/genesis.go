@@ -14,6 +14,7 @@ type GenesisState struct {
 	UnbondingDelegations []UnbondingDelegation `json:"unbonding_delegations" yaml:"unbonding_delegations"`
 	Redelegations        []Redelegation        `json:"redelegations" yaml:"redelegations"`
 	Exported             bool                  `json:"exported" yaml:"exported"`
+	ConsPubKeyRotations  ConsPubKeyRotations   `json:"cons_pubkey_rotations" yaml:"cons_pubkey_rotations"`
 }
 
 // Last validator power, needed for validator set update logic
@@ -0,0 +1,87 @@
+package types
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/shinecloudfoundation/shinecloudnet/codec"
+)
+
+// HistoricalInfo contains the header and the bonded validator set of a
+// recent height. It is stored by the staking module at the beginning of every
+// block, so that the application can serve the validator set of past heights
+// without relying on the Tendermint RPC.
+type HistoricalInfo struct {
+	Header abci.Header `json:"header" yaml:"header"`
+	ValSet Validators  `json:"valset" yaml:"valset"`
+}
+
+// NewHistoricalInfo creates a new HistoricalInfo instance. The validators are
+// sorted the way Tendermint orders a validator set, by descending voting power
+// and then by ascending consensus address.
+func NewHistoricalInfo(header abci.Header, valSet Validators) HistoricalInfo {
+	sorted := make(Validators, len(valSet))
+	copy(sorted, valSet)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		powerI, powerJ := sorted[i].ConsensusPower(), sorted[j].ConsensusPower()
+		if powerI != powerJ {
+			return powerI > powerJ
+		}
+		return bytes.Compare(sorted[i].ConsAddress(), sorted[j].ConsAddress()) < 0
+	})
+
+	return HistoricalInfo{
+		Header: header,
+		ValSet: sorted,
+	}
+}
+
+// String returns a human readable string representation of the historical info.
+func (hi HistoricalInfo) String() string {
+	return fmt.Sprintf(`Historical Info:
+  Height:           %d
+  Time:             %v
+  Last Block Hash:  %X
+  App Hash:         %X
+  Validators:
+%s`, hi.Header.Height, hi.Header.Time, hi.Header.LastBlockId.Hash, hi.Header.AppHash, hi.ValSet)
+}
+
+// ValidateBasic ensures the validator set is not empty and sorted
+func (hi HistoricalInfo) ValidateBasic() error {
+	if len(hi.ValSet) == 0 {
+		return fmt.Errorf("validator set is empty")
+	}
+	for i := 1; i < len(hi.ValSet); i++ {
+		prev, curr := hi.ValSet[i-1], hi.ValSet[i]
+		if prev.ConsensusPower() < curr.ConsensusPower() ||
+			(prev.ConsensusPower() == curr.ConsensusPower() &&
+				bytes.Compare(prev.ConsAddress(), curr.ConsAddress()) >= 0) {
+			return fmt.Errorf("validator set is not sorted by voting power and address")
+		}
+	}
+	return nil
+}
+
+// return the historical info
+func MustMarshalHistoricalInfo(cdc *codec.Codec, hi HistoricalInfo) []byte {
+	return cdc.MustMarshalBinaryLengthPrefixed(hi)
+}
+
+// unmarshal a historical info from a store value
+func MustUnmarshalHistoricalInfo(cdc *codec.Codec, value []byte) HistoricalInfo {
+	hi, err := UnmarshalHistoricalInfo(cdc, value)
+	if err != nil {
+		panic(err)
+	}
+	return hi
+}
+
+// unmarshal a historical info from a store value
+func UnmarshalHistoricalInfo(cdc *codec.Codec, value []byte) (hi HistoricalInfo, err error) {
+	err = cdc.UnmarshalBinaryLengthPrefixed(value, &hi)
+	return hi, err
+}
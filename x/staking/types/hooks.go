@@ -62,3 +62,8 @@ func (h MultiStakingHooks) BeforeValidatorSlashed(ctx sdk.Context, valAddr sdk.V
 		h[i].BeforeValidatorSlashed(ctx, valAddr, fraction)
 	}
 }
+func (h MultiStakingHooks) AfterConsPubKeyRotated(ctx sdk.Context, oldConsAddr, newConsAddr sdk.ConsAddress, valAddr sdk.ValAddress) {
+	for i := range h {
+		h[i].AfterConsPubKeyRotated(ctx, oldConsAddr, newConsAddr, valAddr)
+	}
+}
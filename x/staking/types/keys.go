@@ -34,6 +34,7 @@ var (
 	ValidatorsKey             = []byte{0x21} // prefix for each key to a validator
 	ValidatorsByConsAddrKey   = []byte{0x22} // prefix for each key to a validator index, by pubkey
 	ValidatorsByPowerIndexKey = []byte{0x23} // prefix for each key to a validator index, sorted by power
+	ConsPubKeyRotationKey     = []byte{0x24} // prefix for each key to a consensus pubkey rotation, by validator operator
 
 	DelegationKey                    = []byte{0x31} // key for a delegation
 	UnbondingDelegationKey           = []byte{0x32} // key for an unbonding-delegation
@@ -45,6 +46,13 @@ var (
 	UnbondingQueueKey    = []byte{0x41} // prefix for the timestamps in unbonding queue
 	RedelegationQueueKey = []byte{0x42} // prefix for the timestamps in redelegations queue
 	ValidatorQueueKey    = []byte{0x43} // prefix for the timestamps in validator queue
+
+	ConsPubKeyRotationQueueKey = []byte{0x44} // prefix for the timestamps in consensus pubkey rotation queue
+
+	HistoricalInfoKey = []byte{0x60} // prefix for the historical info of recent heights
+
+	// Keys for transient store prefixes
+	PendingConsPubKeyRotationKey = []byte{0x51} // prefix for the consensus pubkeys rotated away from in the current block
 )
 
 // gets the key for the validator with address
@@ -122,6 +130,40 @@ func GetValidatorQueueTimeKey(timestamp time.Time) []byte {
 	return append(ValidatorQueueKey, bz...)
 }
 
+// gets the key for a consensus pubkey rotation of a validator at a height
+// VALUE: staking/ConsPubKeyRotation
+func GetConsPubKeyRotationKey(operatorAddr sdk.ValAddress, height int64) []byte {
+	heightBytes := make([]byte, 8)
+	binary.BigEndian.PutUint64(heightBytes, uint64(height))
+	return append(GetConsPubKeyRotationsKey(operatorAddr), heightBytes...)
+}
+
+// gets the prefix for all consensus pubkey rotations of a validator
+func GetConsPubKeyRotationsKey(operatorAddr sdk.ValAddress) []byte {
+	return append(ConsPubKeyRotationKey, operatorAddr.Bytes()...)
+}
+
+// gets the key for the consensus pubkey rotations maturing at a timestamp
+func GetConsPubKeyRotationQueueTimeKey(timestamp time.Time) []byte {
+	bz := sdk.FormatTimeBytes(timestamp)
+	return append(ConsPubKeyRotationQueueKey, bz...)
+}
+
+// gets the transient key for the consensus pubkey a validator rotated away
+// from in the current block
+// VALUE: crypto.PubKey
+func GetPendingConsPubKeyRotationKey(operatorAddr sdk.ValAddress) []byte {
+	return append(PendingConsPubKeyRotationKey, operatorAddr.Bytes()...)
+}
+
+// gets the key for the historical info at a height
+// VALUE: staking/HistoricalInfo
+func GetHistoricalInfoKey(height int64) []byte {
+	heightBytes := make([]byte, 8)
+	binary.BigEndian.PutUint64(heightBytes, uint64(height))
+	return append(HistoricalInfoKey, heightBytes...)
+}
+
 //______________________________________________________________________________
 
 // gets the key for delegator bond with validator
@@ -16,6 +16,8 @@ var (
 	_ sdk.Msg = &MsgDelegate{}
 	_ sdk.Msg = &MsgUndelegate{}
 	_ sdk.Msg = &MsgBeginRedelegate{}
+	_ sdk.Msg = &MsgRotateConsPubKey{}
+	_ sdk.Msg = &MsgTransferDelegation{}
 )
 
 //______________________________________________________________________
@@ -343,3 +345,123 @@ func (msg MsgUndelegate) ValidateBasic() sdk.Error {
 	}
 	return nil
 }
+
+//______________________________________________________________________
+
+// MsgRotateConsPubKey - struct for replacing the consensus pubkey of a validator
+type MsgRotateConsPubKey struct {
+	ValidatorAddress sdk.ValAddress `json:"validator_address" yaml:"validator_address"`
+	NewPubKey        crypto.PubKey  `json:"new_pubkey" yaml:"new_pubkey"`
+}
+
+type msgRotateConsPubKeyJSON struct {
+	ValidatorAddress sdk.ValAddress `json:"validator_address" yaml:"validator_address"`
+	NewPubKey        string         `json:"new_pubkey" yaml:"new_pubkey"`
+}
+
+func NewMsgRotateConsPubKey(valAddr sdk.ValAddress, newPubKey crypto.PubKey) MsgRotateConsPubKey {
+	return MsgRotateConsPubKey{
+		ValidatorAddress: valAddr,
+		NewPubKey:        newPubKey,
+	}
+}
+
+//nolint
+func (msg MsgRotateConsPubKey) Route() string { return RouterKey }
+func (msg MsgRotateConsPubKey) Type() string  { return "rotate_cons_pubkey" }
+func (msg MsgRotateConsPubKey) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.AccAddress(msg.ValidatorAddress)}
+}
+
+// MarshalJSON implements the json.Marshaler interface to provide custom JSON
+// serialization of the MsgRotateConsPubKey type.
+func (msg MsgRotateConsPubKey) MarshalJSON() ([]byte, error) {
+	return json.Marshal(msgRotateConsPubKeyJSON{
+		ValidatorAddress: msg.ValidatorAddress,
+		NewPubKey:        sdk.MustBech32ifyConsPub(msg.NewPubKey),
+	})
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface to provide custom
+// JSON deserialization of the MsgRotateConsPubKey type.
+func (msg *MsgRotateConsPubKey) UnmarshalJSON(bz []byte) error {
+	var msgRotateJSON msgRotateConsPubKeyJSON
+	if err := json.Unmarshal(bz, &msgRotateJSON); err != nil {
+		return err
+	}
+
+	msg.ValidatorAddress = msgRotateJSON.ValidatorAddress
+	var err error
+	msg.NewPubKey, err = sdk.GetConsPubKeyBech32(msgRotateJSON.NewPubKey)
+	return err
+}
+
+// get the bytes for the message signer to sign on
+func (msg MsgRotateConsPubKey) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// quick validity check
+func (msg MsgRotateConsPubKey) ValidateBasic() sdk.Error {
+	if msg.ValidatorAddress.Empty() {
+		return ErrNilValidatorAddr(DefaultCodespace)
+	}
+	if msg.NewPubKey == nil {
+		return sdk.NewError(DefaultCodespace, CodeInvalidInput, "new consensus pubkey is nil")
+	}
+	return nil
+}
+
+//______________________________________________________________________
+
+// MsgTransferDelegation - struct for moving delegation shares to another
+// delegator without unbonding them
+type MsgTransferDelegation struct {
+	DelegatorAddress sdk.AccAddress `json:"delegator_address" yaml:"delegator_address"`
+	ValidatorAddress sdk.ValAddress `json:"validator_address" yaml:"validator_address"`
+	RecipientAddress sdk.AccAddress `json:"recipient_address" yaml:"recipient_address"`
+	Amount           sdk.Coin       `json:"amount" yaml:"amount"`
+}
+
+func NewMsgTransferDelegation(delAddr sdk.AccAddress, valAddr sdk.ValAddress,
+	recipientAddr sdk.AccAddress, amount sdk.Coin) MsgTransferDelegation {
+
+	return MsgTransferDelegation{
+		DelegatorAddress: delAddr,
+		ValidatorAddress: valAddr,
+		RecipientAddress: recipientAddr,
+		Amount:           amount,
+	}
+}
+
+//nolint
+func (msg MsgTransferDelegation) Route() string                { return RouterKey }
+func (msg MsgTransferDelegation) Type() string                 { return "transfer_delegation" }
+func (msg MsgTransferDelegation) GetSigners() []sdk.AccAddress { return []sdk.AccAddress{msg.DelegatorAddress} }
+
+// get the bytes for the message signer to sign on
+func (msg MsgTransferDelegation) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// quick validity check
+func (msg MsgTransferDelegation) ValidateBasic() sdk.Error {
+	if msg.DelegatorAddress.Empty() {
+		return ErrNilDelegatorAddr(DefaultCodespace)
+	}
+	if msg.ValidatorAddress.Empty() {
+		return ErrNilValidatorAddr(DefaultCodespace)
+	}
+	if msg.RecipientAddress.Empty() {
+		return ErrNilDelegatorAddr(DefaultCodespace)
+	}
+	if msg.DelegatorAddress.Equals(msg.RecipientAddress) {
+		return ErrSelfDelegationTransfer(DefaultCodespace)
+	}
+	if msg.Amount.Amount.LTE(sdk.ZeroInt()) {
+		return ErrBadSharesAmount(DefaultCodespace)
+	}
+	return nil
+}
@@ -154,3 +154,60 @@ func TestMsgUndelegate(t *testing.T) {
 		}
 	}
 }
+
+// test ValidateBasic and JSON round trip for MsgRotateConsPubKey
+func TestMsgRotateConsPubKey(t *testing.T) {
+	tests := []struct {
+		name          string
+		validatorAddr sdk.ValAddress
+		newPubKey     crypto.PubKey
+		expectPass    bool
+	}{
+		{"regular", valAddr1, pk2, true},
+		{"empty validator", emptyAddr, pk2, false},
+		{"empty pubkey", valAddr1, nil, false},
+	}
+
+	for _, tc := range tests {
+		msg := NewMsgRotateConsPubKey(tc.validatorAddr, tc.newPubKey)
+		if tc.expectPass {
+			require.Nil(t, msg.ValidateBasic(), "test: %v", tc.name)
+		} else {
+			require.NotNil(t, msg.ValidateBasic(), "test: %v", tc.name)
+		}
+	}
+
+	msg := NewMsgRotateConsPubKey(valAddr1, pk2)
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	var decoded MsgRotateConsPubKey
+	require.NoError(t, ModuleCdc.UnmarshalJSON(bz, &decoded))
+	require.Equal(t, msg, decoded)
+}
+
+// test ValidateBasic for MsgTransferDelegation
+func TestMsgTransferDelegation(t *testing.T) {
+	tests := []struct {
+		name          string
+		delegatorAddr sdk.AccAddress
+		validatorAddr sdk.ValAddress
+		recipientAddr sdk.AccAddress
+		amount        sdk.Coin
+		expectPass    bool
+	}{
+		{"regular", sdk.AccAddress(valAddr1), valAddr2, sdk.AccAddress(valAddr3), sdk.NewInt64Coin(sdk.DefaultBondDenom, 1), true},
+		{"zero amount", sdk.AccAddress(valAddr1), valAddr2, sdk.AccAddress(valAddr3), sdk.NewInt64Coin(sdk.DefaultBondDenom, 0), false},
+		{"empty delegator", sdk.AccAddress(emptyAddr), valAddr2, sdk.AccAddress(valAddr3), sdk.NewInt64Coin(sdk.DefaultBondDenom, 1), false},
+		{"empty validator", sdk.AccAddress(valAddr1), emptyAddr, sdk.AccAddress(valAddr3), sdk.NewInt64Coin(sdk.DefaultBondDenom, 1), false},
+		{"empty recipient", sdk.AccAddress(valAddr1), valAddr2, sdk.AccAddress(emptyAddr), sdk.NewInt64Coin(sdk.DefaultBondDenom, 1), false},
+		{"self transfer", sdk.AccAddress(valAddr1), valAddr2, sdk.AccAddress(valAddr1), sdk.NewInt64Coin(sdk.DefaultBondDenom, 1), false},
+	}
+
+	for _, tc := range tests {
+		msg := NewMsgTransferDelegation(tc.delegatorAddr, tc.validatorAddr, tc.recipientAddr, tc.amount)
+		if tc.expectPass {
+			require.Nil(t, msg.ValidateBasic(), "test: %v", tc.name)
+		} else {
+			require.NotNil(t, msg.ValidateBasic(), "test: %v", tc.name)
+		}
+	}
+}
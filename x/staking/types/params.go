@@ -22,14 +22,29 @@ const (
 
 	// Default maximum entries in a UBD/RED pair
 	DefaultMaxEntries uint16 = 7
+
+	// Default maximum number of consensus pubkey rotations per validator
+	// within an unbonding period
+	DefaultMaxConsPubKeyRotations uint16 = 1
+
+	// Default number of recent blocks for which historical info is kept
+	DefaultHistoricalEntries uint16 = 100
 )
 
+// DefaultConsPubKeyRotationFee is the default fee burned from the operator
+// account on every consensus pubkey rotation
+var DefaultConsPubKeyRotationFee = sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.TokensFromConsensusPower(1)))
+
 // nolint - Keys for parameter access
 var (
 	KeyUnbondingTime = []byte("UnbondingTime")
 	KeyMaxValidators = []byte("MaxValidators")
 	KeyMaxEntries    = []byte("KeyMaxEntries")
 	KeyBondDenom     = []byte("BondDenom")
+
+	KeyMaxConsPubKeyRotations = []byte("MaxConsPubKeyRotations")
+	KeyConsPubKeyRotationFee  = []byte("ConsPubKeyRotationFee")
+	KeyHistoricalEntries      = []byte("HistoricalEntries")
 )
 
 var _ params.ParamSet = (*Params)(nil)
@@ -41,17 +56,25 @@ type Params struct {
 	MaxEntries    uint16        `json:"max_entries" yaml:"max_entries"`       // max entries for either unbonding delegation or redelegation (per pair/trio)
 	// note: we need to be a bit careful about potential overflow here, since this is user-determined
 	BondDenom string `json:"bond_denom" yaml:"bond_denom"` // bondable coin denomination
+
+	MaxConsPubKeyRotations uint16    `json:"max_cons_pubkey_rotations" yaml:"max_cons_pubkey_rotations"` // max consensus pubkey rotations per validator within the unbonding time, 0 disables rotations
+	ConsPubKeyRotationFee  sdk.Coins `json:"cons_pubkey_rotation_fee" yaml:"cons_pubkey_rotation_fee"`   // fee burned on every consensus pubkey rotation
+	HistoricalEntries      uint16    `json:"historical_entries" yaml:"historical_entries"`               // number of recent blocks for which historical info is kept, 0 disables it
 }
 
 // NewParams creates a new Params instance
 func NewParams(unbondingTime time.Duration, maxValidators, maxEntries uint16,
-	bondDenom string) Params {
+	bondDenom string, maxConsPubKeyRotations uint16, consPubKeyRotationFee sdk.Coins,
+	historicalEntries uint16) Params {
 
 	return Params{
-		UnbondingTime: unbondingTime,
-		MaxValidators: maxValidators,
-		MaxEntries:    maxEntries,
-		BondDenom:     bondDenom,
+		UnbondingTime:          unbondingTime,
+		MaxValidators:          maxValidators,
+		MaxEntries:             maxEntries,
+		BondDenom:              bondDenom,
+		MaxConsPubKeyRotations: maxConsPubKeyRotations,
+		ConsPubKeyRotationFee:  consPubKeyRotationFee,
+		HistoricalEntries:      historicalEntries,
 	}
 }
 
@@ -62,6 +85,9 @@ func (p *Params) ParamSetPairs() params.ParamSetPairs {
 		{KeyMaxValidators, &p.MaxValidators},
 		{KeyMaxEntries, &p.MaxEntries},
 		{KeyBondDenom, &p.BondDenom},
+		{KeyMaxConsPubKeyRotations, &p.MaxConsPubKeyRotations},
+		{KeyConsPubKeyRotationFee, &p.ConsPubKeyRotationFee},
+		{KeyHistoricalEntries, &p.HistoricalEntries},
 	}
 }
 
@@ -75,17 +101,22 @@ func (p Params) Equal(p2 Params) bool {
 
 // DefaultParams returns a default set of parameters.
 func DefaultParams() Params {
-	return NewParams(DefaultUnbondingTime, DefaultMaxValidators, DefaultMaxEntries, sdk.DefaultBondDenom)
+	return NewParams(DefaultUnbondingTime, DefaultMaxValidators, DefaultMaxEntries, sdk.DefaultBondDenom,
+		DefaultMaxConsPubKeyRotations, DefaultConsPubKeyRotationFee, DefaultHistoricalEntries)
 }
 
 // String returns a human readable string representation of the parameters.
 func (p Params) String() string {
 	return fmt.Sprintf(`Params:
-  Unbonding Time:    %s
-  Max Validators:    %d
-  Max Entries:       %d
-  Bonded Coin Denom: %s`, p.UnbondingTime,
-		p.MaxValidators, p.MaxEntries, p.BondDenom)
+  Unbonding Time:            %s
+  Max Validators:            %d
+  Max Entries:               %d
+  Bonded Coin Denom:         %s
+  Max ConsPubKey Rotations:  %d
+  ConsPubKey Rotation Fee:   %s
+  Historical Entries:        %d`, p.UnbondingTime,
+		p.MaxValidators, p.MaxEntries, p.BondDenom,
+		p.MaxConsPubKeyRotations, p.ConsPubKeyRotationFee, p.HistoricalEntries)
 }
 
 // unmarshal the current staking params value from store key or panic
@@ -114,5 +145,8 @@ func (p Params) Validate() error {
 	if p.MaxValidators == 0 {
 		return fmt.Errorf("staking parameter MaxValidators must be a positive integer")
 	}
+	if !p.ConsPubKeyRotationFee.IsValid() {
+		return fmt.Errorf("staking parameter ConsPubKeyRotationFee is invalid: %s", p.ConsPubKeyRotationFee)
+	}
 	return nil
 }
@@ -20,6 +20,8 @@ const (
 	QueryDelegatorValidator            = "delegatorValidator"
 	QueryPool                          = "pool"
 	QueryParameters                    = "parameters"
+	QueryValidatorConsPubKeyRotations  = "validatorConsPubKeyRotations"
+	QueryHistoricalInfo                = "historicalInfo"
 )
 
 // defines the params for the following queries:
@@ -42,6 +44,7 @@ func NewQueryDelegatorParams(delegatorAddr sdk.AccAddress) QueryDelegatorParams
 // - 'custom/staking/validatorDelegations'
 // - 'custom/staking/validatorUnbondingDelegations'
 // - 'custom/staking/validatorRedelegations'
+// - 'custom/staking/validatorConsPubKeyRotations'
 type QueryValidatorParams struct {
 	ValidatorAddr sdk.ValAddress
 }
@@ -96,3 +99,13 @@ type QueryValidatorsParams struct {
 func NewQueryValidatorsParams(page, limit int, status string) QueryValidatorsParams {
 	return QueryValidatorsParams{page, limit, status}
 }
+
+// QueryHistoricalInfoParams defines the params for the following queries:
+// - 'custom/staking/historicalInfo'
+type QueryHistoricalInfoParams struct {
+	Height int64
+}
+
+func NewQueryHistoricalInfoParams(height int64) QueryHistoricalInfoParams {
+	return QueryHistoricalInfoParams{height}
+}
@@ -0,0 +1,137 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/tendermint/tendermint/crypto"
+
+	"github.com/shinecloudfoundation/shinecloudnet/codec"
+	sdk "github.com/shinecloudfoundation/shinecloudnet/types"
+)
+
+// ConsPubKeyRotation records the replacement of a validator's consensus
+// pubkey. Until CompletionTime the old pubkey stays reserved for the
+// validator, so that evidence against it can still be slashed, and the
+// rotation counts against the validator's rotation limit.
+type ConsPubKeyRotation struct {
+	OperatorAddress sdk.ValAddress `json:"operator_address" yaml:"operator_address"`         // address of the validator's operator
+	OldConsPubKey   crypto.PubKey  `json:"old_consensus_pubkey" yaml:"old_consensus_pubkey"` // the consensus pubkey rotated away from
+	NewConsPubKey   crypto.PubKey  `json:"new_consensus_pubkey" yaml:"new_consensus_pubkey"` // the consensus pubkey rotated to
+	Height          int64          `json:"height" yaml:"height"`                             // height at which the rotation took place
+	CompletionTime  time.Time      `json:"completion_time" yaml:"completion_time"`           // time at which the old pubkey is released
+}
+
+// NewConsPubKeyRotation creates a new ConsPubKeyRotation instance
+func NewConsPubKeyRotation(operator sdk.ValAddress, oldPubKey, newPubKey crypto.PubKey,
+	height int64, completionTime time.Time) ConsPubKeyRotation {
+
+	return ConsPubKeyRotation{
+		OperatorAddress: operator,
+		OldConsPubKey:   oldPubKey,
+		NewConsPubKey:   newPubKey,
+		Height:          height,
+		CompletionTime:  completionTime,
+	}
+}
+
+// IsMature - is the rotation complete based on current time
+func (r ConsPubKeyRotation) IsMature(currentTime time.Time) bool {
+	return !r.CompletionTime.After(currentTime)
+}
+
+// String returns a human readable string representation of a rotation.
+func (r ConsPubKeyRotation) String() string {
+	return fmt.Sprintf(`Consensus PubKey Rotation:
+  Operator Address:        %s
+  Old Consensus Pubkey:    %s
+  New Consensus Pubkey:    %s
+  Height:                  %d
+  Completion Time:         %v`, r.OperatorAddress,
+		sdk.MustBech32ifyConsPub(r.OldConsPubKey), sdk.MustBech32ifyConsPub(r.NewConsPubKey),
+		r.Height, r.CompletionTime)
+}
+
+// this is a helper struct used for JSON de- and encoding only
+type bechConsPubKeyRotation struct {
+	OperatorAddress sdk.ValAddress `json:"operator_address" yaml:"operator_address"`
+	OldConsPubKey   string         `json:"old_consensus_pubkey" yaml:"old_consensus_pubkey"`
+	NewConsPubKey   string         `json:"new_consensus_pubkey" yaml:"new_consensus_pubkey"`
+	Height          int64          `json:"height" yaml:"height"`
+	CompletionTime  time.Time      `json:"completion_time" yaml:"completion_time"`
+}
+
+// MarshalJSON marshals the rotation to JSON using Bech32
+func (r ConsPubKeyRotation) MarshalJSON() ([]byte, error) {
+	bechOldPubKey, err := sdk.Bech32ifyConsPub(r.OldConsPubKey)
+	if err != nil {
+		return nil, err
+	}
+	bechNewPubKey, err := sdk.Bech32ifyConsPub(r.NewConsPubKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return codec.Cdc.MarshalJSON(bechConsPubKeyRotation{
+		OperatorAddress: r.OperatorAddress,
+		OldConsPubKey:   bechOldPubKey,
+		NewConsPubKey:   bechNewPubKey,
+		Height:          r.Height,
+		CompletionTime:  r.CompletionTime,
+	})
+}
+
+// UnmarshalJSON unmarshals the rotation from JSON using Bech32
+func (r *ConsPubKeyRotation) UnmarshalJSON(data []byte) error {
+	br := &bechConsPubKeyRotation{}
+	if err := codec.Cdc.UnmarshalJSON(data, br); err != nil {
+		return err
+	}
+	oldPubKey, err := sdk.GetConsPubKeyBech32(br.OldConsPubKey)
+	if err != nil {
+		return err
+	}
+	newPubKey, err := sdk.GetConsPubKeyBech32(br.NewConsPubKey)
+	if err != nil {
+		return err
+	}
+	*r = ConsPubKeyRotation{
+		OperatorAddress: br.OperatorAddress,
+		OldConsPubKey:   oldPubKey,
+		NewConsPubKey:   newPubKey,
+		Height:          br.Height,
+		CompletionTime:  br.CompletionTime,
+	}
+	return nil
+}
+
+// ConsPubKeyRotations is a collection of ConsPubKeyRotation
+type ConsPubKeyRotations []ConsPubKeyRotation
+
+func (r ConsPubKeyRotations) String() (out string) {
+	for _, rot := range r {
+		out += rot.String() + "\n"
+	}
+	return strings.TrimSpace(out)
+}
+
+// return the rotation
+func MustMarshalConsPubKeyRotation(cdc *codec.Codec, rotation ConsPubKeyRotation) []byte {
+	return cdc.MustMarshalBinaryLengthPrefixed(rotation)
+}
+
+// unmarshal a rotation from a store value
+func MustUnmarshalConsPubKeyRotation(cdc *codec.Codec, value []byte) ConsPubKeyRotation {
+	rotation, err := UnmarshalConsPubKeyRotation(cdc, value)
+	if err != nil {
+		panic(err)
+	}
+	return rotation
+}
+
+// unmarshal a rotation from a store value
+func UnmarshalConsPubKeyRotation(cdc *codec.Codec, value []byte) (rotation ConsPubKeyRotation, err error) {
+	err = cdc.UnmarshalBinaryLengthPrefixed(value, &rotation)
+	return rotation, err
+}
@@ -0,0 +1,41 @@
+// nolint
+// autogenerated code using github.com/rigelrozanski/multitool
+// aliases generated for the following subdirectories:
+// ALIASGEN: github.com/shinecloudfoundation/shinecloudnet/x/vesting/internal/keeper
+// ALIASGEN: github.com/shinecloudfoundation/shinecloudnet/x/vesting/internal/types
+package vesting
+
+import (
+	"github.com/shinecloudfoundation/shinecloudnet/x/vesting/internal/keeper"
+	"github.com/shinecloudfoundation/shinecloudnet/x/vesting/internal/types"
+)
+
+const (
+	DefaultCodespace              = types.DefaultCodespace
+	CodeAccountExists             = types.CodeAccountExists
+	CodeInvalidSchedule           = types.CodeInvalidSchedule
+	EventTypeCreateVestingAccount = types.EventTypeCreateVestingAccount
+	AttributeKeyRecipient         = types.AttributeKeyRecipient
+	AttributeValueCategory        = types.AttributeValueCategory
+	ModuleName                    = types.ModuleName
+	RouterKey                     = types.RouterKey
+	TypeMsgCreateVestingAccount   = types.TypeMsgCreateVestingAccount
+)
+
+var (
+	// functions aliases
+	NewKeeper                          = keeper.NewKeeper
+	RegisterCodec                      = types.RegisterCodec
+	ErrAccountExists                   = types.ErrAccountExists
+	ErrInvalidSchedule                 = types.ErrInvalidSchedule
+	NewMsgCreateVestingAccount         = types.NewMsgCreateVestingAccount
+	NewMsgCreatePeriodicVestingAccount = types.NewMsgCreatePeriodicVestingAccount
+
+	// variable aliases
+	ModuleCdc = types.ModuleCdc
+)
+
+type (
+	Keeper                  = keeper.Keeper
+	MsgCreateVestingAccount = types.MsgCreateVestingAccount
+)
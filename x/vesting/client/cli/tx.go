@@ -0,0 +1,184 @@
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/shinecloudfoundation/shinecloudnet/client"
+	"github.com/shinecloudfoundation/shinecloudnet/client/context"
+	"github.com/shinecloudfoundation/shinecloudnet/codec"
+	sdk "github.com/shinecloudfoundation/shinecloudnet/types"
+	"github.com/shinecloudfoundation/shinecloudnet/version"
+	"github.com/shinecloudfoundation/shinecloudnet/x/auth"
+	"github.com/shinecloudfoundation/shinecloudnet/x/auth/client/utils"
+	"github.com/shinecloudfoundation/shinecloudnet/x/vesting/internal/types"
+)
+
+const (
+	flagStartTime = "start-time"
+	flagDelayed   = "delayed"
+)
+
+// GetTxCmd returns the transaction commands for this module
+func GetTxCmd(cdc *codec.Codec) *cobra.Command {
+	vestingTxCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Vesting transaction subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	vestingTxCmd.AddCommand(client.PostCommands(
+		GetCmdCreateVestingAccount(cdc),
+		GetCmdCreatePeriodicVestingAccount(cdc),
+	)...)
+
+	return vestingTxCmd
+}
+
+// GetCmdCreateVestingAccount implements the command to create a continuous or
+// delayed vesting account
+func GetCmdCreateVestingAccount(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-vesting-account [to_address] [amount] [end_time]",
+		Short: "Create a new vesting account funded with an allocation of tokens",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Create a new vesting account at an address that doesn't exist yet, funded
+with tokens from the sender's account. The tokens vest linearly from the start
+time, which defaults to the block time, until the end time (unix epoch). With
+--delayed all of them vest at the end time instead.
+
+Example:
+$ %s tx %s create-vesting-account scloud1skjw... 100000uscds 1640995200 --from=mykey
+$ %s tx %s create-vesting-account scloud1skjw... 100000uscds 1640995200 --delayed --from=mykey
+`,
+				version.ClientName, types.ModuleName, version.ClientName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			to, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			amount, err := sdk.ParseCoins(args[1])
+			if err != nil {
+				return err
+			}
+
+			endTime, err := strconv.ParseInt(args[2], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCreateVestingAccount(
+				cliCtx.GetFromAddress(), to, amount,
+				viper.GetInt64(flagStartTime), endTime, viper.GetBool(flagDelayed),
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.Flags().Int64(flagStartTime, 0, "Start time (unix epoch) of a continuous vesting schedule, the block time if zero")
+	cmd.Flags().Bool(flagDelayed, false, "Vest all tokens at the end time")
+	return cmd
+}
+
+// GetCmdCreatePeriodicVestingAccount implements the command to create a
+// periodic vesting account
+func GetCmdCreatePeriodicVestingAccount(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "create-periodic-vesting-account [to_address] [schedule_file]",
+		Short: "Create a new periodic vesting account funded with an allocation of tokens",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Create a new vesting account at an address that doesn't exist yet, funded
+with tokens from the sender's account. The tokens vest in tranches given by a
+JSON schedule file, in which the lengths are in seconds and a zero start time
+(unix epoch) starts the schedule at the block time:
+
+{
+  "start_time": 1577836800,
+  "periods": [
+    {"length": 2592000, "amount": "1000uscds"},
+    {"length": 2592000, "amount": "1000uscds"}
+  ]
+}
+
+Example:
+$ %s tx %s create-periodic-vesting-account scloud1skjw... schedule.json --from=mykey
+`,
+				version.ClientName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			to, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			startTime, periods, err := readVestingSchedule(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCreatePeriodicVestingAccount(cliCtx.GetFromAddress(), to, startTime, periods)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+// vestingSchedule is the JSON file format of a periodic vesting schedule
+type vestingSchedule struct {
+	StartTime int64 `json:"start_time"`
+	Periods   []struct {
+		Length int64  `json:"length"`
+		Amount string `json:"amount"`
+	} `json:"periods"`
+}
+
+// readVestingSchedule parses the periodic vesting schedule stored in file
+func readVestingSchedule(file string) (int64, auth.Periods, error) {
+	bz, err := ioutil.ReadFile(file)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	var sched vestingSchedule
+	if err := json.Unmarshal(bz, &sched); err != nil {
+		return 0, nil, fmt.Errorf("failed to parse vesting schedule %s: %v", file, err)
+	}
+
+	periods := make(auth.Periods, len(sched.Periods))
+	for i, p := range sched.Periods {
+		amount, err := sdk.ParseCoins(p.Amount)
+		if err != nil {
+			return 0, nil, err
+		}
+		periods[i] = auth.NewPeriod(p.Length, amount)
+	}
+
+	return sched.StartTime, periods, nil
+}
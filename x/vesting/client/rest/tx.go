@@ -0,0 +1,68 @@
+package rest
+
+import (
+	"net/http"
+
+	"github.com/shinecloudfoundation/shinecloudnet/client/context"
+	sdk "github.com/shinecloudfoundation/shinecloudnet/types"
+	"github.com/shinecloudfoundation/shinecloudnet/types/rest"
+	"github.com/shinecloudfoundation/shinecloudnet/x/auth"
+	"github.com/shinecloudfoundation/shinecloudnet/x/auth/client/utils"
+	"github.com/shinecloudfoundation/shinecloudnet/x/vesting/internal/types"
+)
+
+// CreateVestingAccountReq defines the properties of a create vesting account
+// request's body. With vesting periods a periodic vesting account vesting
+// their total amount is created and Amount, EndTime and Delayed are ignored.
+type CreateVestingAccountReq struct {
+	BaseReq        rest.BaseReq   `json:"base_req" yaml:"base_req"`
+	ToAddress      sdk.AccAddress `json:"to_address" yaml:"to_address"`
+	Amount         sdk.Coins      `json:"amount" yaml:"amount"`
+	StartTime      int64          `json:"start_time" yaml:"start_time"`
+	EndTime        int64          `json:"end_time" yaml:"end_time"`
+	Delayed        bool           `json:"delayed" yaml:"delayed"`
+	VestingPeriods auth.Periods   `json:"vesting_periods" yaml:"vesting_periods"`
+}
+
+// createVestingAccountHandlerFn - http request handler to create a vesting account
+func createVestingAccountHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req CreateVestingAccountReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		// derive the from account address and name from the Keybase
+		var fromAddress sdk.AccAddress
+		var fromName string
+		var err error
+		if req.BaseReq.GenerateOnly {
+			fromAddress, err = sdk.AccAddressFromBech32(req.BaseReq.From)
+		} else {
+			fromAddress, fromName, err = context.GetFromFieldsFromAddr(req.BaseReq.From)
+		}
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		cliCtx = cliCtx.WithFromName(fromName).WithFromAddress(fromAddress).WithBroadcastMode(req.BaseReq.BroadcastMode)
+
+		msg := types.NewMsgCreateVestingAccount(
+			fromAddress, req.ToAddress, req.Amount, req.StartTime, req.EndTime, req.Delayed,
+		)
+		if len(req.VestingPeriods) > 0 {
+			msg = types.NewMsgCreatePeriodicVestingAccount(fromAddress, req.ToAddress, req.StartTime, req.VestingPeriods)
+		}
+		if err := msg.ValidateBasic(); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		utils.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
@@ -0,0 +1,44 @@
+package vesting
+
+import (
+	"fmt"
+
+	sdk "github.com/shinecloudfoundation/shinecloudnet/types"
+)
+
+// NewHandler returns a handler for "vesting" type messages.
+func NewHandler(k Keeper) sdk.Handler {
+	return func(ctx sdk.Context, msg sdk.Msg) sdk.Result {
+		ctx = ctx.WithEventManager(sdk.NewEventManager())
+
+		switch msg := msg.(type) {
+		case MsgCreateVestingAccount:
+			return handleMsgCreateVestingAccount(ctx, k, msg)
+
+		default:
+			errMsg := fmt.Sprintf("unrecognized vesting message type: %T", msg)
+			return sdk.ErrUnknownRequest(errMsg).Result()
+		}
+	}
+}
+
+func handleMsgCreateVestingAccount(ctx sdk.Context, k Keeper, msg MsgCreateVestingAccount) sdk.Result {
+	if err := k.CreateVestingAccount(ctx, msg); err != nil {
+		return err.Result()
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			EventTypeCreateVestingAccount,
+			sdk.NewAttribute(AttributeKeyRecipient, msg.ToAddress.String()),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, msg.Amount.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.FromAddress.String()),
+		),
+	})
+
+	return sdk.Result{Events: ctx.EventManager().Events()}
+}
@@ -0,0 +1,80 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/tendermint/tendermint/libs/log"
+
+	sdk "github.com/shinecloudfoundation/shinecloudnet/types"
+	"github.com/shinecloudfoundation/shinecloudnet/x/auth"
+	authexported "github.com/shinecloudfoundation/shinecloudnet/x/auth/exported"
+	"github.com/shinecloudfoundation/shinecloudnet/x/vesting/internal/types"
+)
+
+// Keeper creates vesting accounts funded from existing accounts
+type Keeper struct {
+	ak        types.AccountKeeper
+	bk        types.BankKeeper
+	codespace sdk.CodespaceType
+}
+
+// NewKeeper creates a new vesting Keeper instance
+func NewKeeper(ak types.AccountKeeper, bk types.BankKeeper, codespace sdk.CodespaceType) Keeper {
+	return Keeper{
+		ak:        ak,
+		bk:        bk,
+		codespace: codespace,
+	}
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
+}
+
+// Codespace returns the keeper's codespace.
+func (k Keeper) Codespace() sdk.CodespaceType {
+	return k.codespace
+}
+
+// CreateVestingAccount moves the vesting amount of msg out of the sender's
+// account into a new vesting account. It fails if the recipient account
+// already exists.
+func (k Keeper) CreateVestingAccount(ctx sdk.Context, msg types.MsgCreateVestingAccount) sdk.Error {
+	if err := k.bk.IsSendEnabledCoins(ctx, msg.Amount...); err != nil {
+		return err
+	}
+	if k.bk.BlockedAddr(ctx, msg.ToAddress) {
+		return sdk.ErrUnauthorized(fmt.Sprintf("%s is not allowed to receive transactions", msg.ToAddress))
+	}
+	if k.ak.GetAccount(ctx, msg.ToAddress) != nil {
+		return types.ErrAccountExists(k.codespace, msg.ToAddress)
+	}
+
+	startTime := msg.StartTime
+	if startTime == 0 {
+		startTime = ctx.BlockHeader().Time.Unix()
+	}
+	if len(msg.VestingPeriods) == 0 && !msg.Delayed && startTime >= msg.EndTime {
+		return types.ErrInvalidSchedule(k.codespace, "vesting end time must be after the start time")
+	}
+
+	if _, err := k.bk.SubtractCoins(ctx, msg.FromAddress, msg.Amount); err != nil {
+		return err
+	}
+
+	bacc := auth.NewBaseAccount(msg.ToAddress, msg.Amount, nil, 0, 0)
+
+	var vacc authexported.Account
+	switch {
+	case len(msg.VestingPeriods) > 0:
+		vacc = auth.NewPeriodicVestingAccount(bacc, startTime, msg.VestingPeriods)
+	case msg.Delayed:
+		vacc = auth.NewDelayedVestingAccount(bacc, msg.EndTime)
+	default:
+		vacc = auth.NewContinuousVestingAccount(bacc, startTime, msg.EndTime)
+	}
+
+	k.ak.SetAccount(ctx, k.ak.NewAccount(ctx, vacc))
+	return nil
+}
@@ -0,0 +1,144 @@
+package keeper
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	sdk "github.com/shinecloudfoundation/shinecloudnet/types"
+	"github.com/shinecloudfoundation/shinecloudnet/x/auth"
+	"github.com/shinecloudfoundation/shinecloudnet/x/vesting/internal/types"
+)
+
+func TestCreateVestingAccount(t *testing.T) {
+	ctx, ak, bk, keeper := SetupTestInput()
+	now := time.Unix(1577836800, 0)
+	ctx = ctx.WithBlockHeader(abci.Header{Time: now})
+
+	from := sdk.AccAddress([]byte("from"))
+	origCoins := sdk.NewCoins(sdk.NewInt64Coin("stake", 1000))
+	ak.SetAccount(ctx, ak.NewAccountWithAddress(ctx, from))
+	require.NoError(t, bk.SetCoins(ctx, from, origCoins))
+
+	amount := sdk.NewCoins(sdk.NewInt64Coin("stake", 100))
+	endTime := now.Add(24 * time.Hour).Unix()
+
+	// continuous vesting accounts start at the block time by default
+	to1 := sdk.AccAddress([]byte("to1"))
+	err := keeper.CreateVestingAccount(ctx, types.NewMsgCreateVestingAccount(from, to1, amount, 0, endTime, false))
+	require.NoError(t, err)
+	cva, ok := ak.GetAccount(ctx, to1).(*auth.ContinuousVestingAccount)
+	require.True(t, ok)
+	require.Equal(t, amount, cva.GetCoins())
+	require.Equal(t, amount, cva.GetOriginalVesting())
+	require.Equal(t, now.Unix(), cva.GetStartTime())
+	require.Equal(t, endTime, cva.GetEndTime())
+	require.Equal(t, origCoins.Sub(amount), bk.GetCoins(ctx, from))
+
+	// delayed vesting account
+	to2 := sdk.AccAddress([]byte("to2"))
+	err = keeper.CreateVestingAccount(ctx, types.NewMsgCreateVestingAccount(from, to2, amount, 0, endTime, true))
+	require.NoError(t, err)
+	dva, ok := ak.GetAccount(ctx, to2).(*auth.DelayedVestingAccount)
+	require.True(t, ok)
+	require.Equal(t, endTime, dva.GetEndTime())
+	require.Nil(t, dva.SpendableCoins(now))
+
+	// periodic vesting account
+	to3 := sdk.AccAddress([]byte("to3"))
+	periods := auth.Periods{
+		auth.NewPeriod(3600, sdk.NewCoins(sdk.NewInt64Coin("stake", 40))),
+		auth.NewPeriod(3600, sdk.NewCoins(sdk.NewInt64Coin("stake", 60))),
+	}
+	err = keeper.CreateVestingAccount(ctx, types.NewMsgCreatePeriodicVestingAccount(from, to3, now.Unix()+60, periods))
+	require.NoError(t, err)
+	pva, ok := ak.GetAccount(ctx, to3).(*auth.PeriodicVestingAccount)
+	require.True(t, ok)
+	require.Equal(t, amount, pva.GetOriginalVesting())
+	require.Equal(t, now.Unix()+60+7200, pva.GetEndTime())
+	require.Equal(t, periods, pva.GetVestingPeriods())
+	require.Equal(t, origCoins.Sub(amount).Sub(amount).Sub(amount), bk.GetCoins(ctx, from))
+
+	// the new accounts get their own account numbers
+	require.NotEqual(t, cva.GetAccountNumber(), dva.GetAccountNumber())
+	require.NotEqual(t, dva.GetAccountNumber(), pva.GetAccountNumber())
+}
+
+func TestCreateVestingAccountErrors(t *testing.T) {
+	ctx, ak, bk, keeper := SetupTestInput()
+	now := time.Unix(1577836800, 0)
+	ctx = ctx.WithBlockHeader(abci.Header{Time: now})
+
+	from := sdk.AccAddress([]byte("from"))
+	origCoins := sdk.NewCoins(sdk.NewInt64Coin("stake", 100))
+	ak.SetAccount(ctx, ak.NewAccountWithAddress(ctx, from))
+	require.NoError(t, bk.SetCoins(ctx, from, origCoins))
+
+	to := sdk.AccAddress([]byte("to"))
+	endTime := now.Add(24 * time.Hour).Unix()
+
+	// the sender can't afford the vesting amount
+	msg := types.NewMsgCreateVestingAccount(from, to, sdk.NewCoins(sdk.NewInt64Coin("stake", 101)), 0, endTime, false)
+	err := keeper.CreateVestingAccount(ctx, msg)
+	require.Error(t, err)
+	require.Equal(t, sdk.CodeInsufficientCoins, err.Code())
+	require.Nil(t, ak.GetAccount(ctx, to))
+
+	// the schedule ended before the block time
+	msg = types.NewMsgCreateVestingAccount(from, to, origCoins, 0, now.Unix()-1, false)
+	err = keeper.CreateVestingAccount(ctx, msg)
+	require.Error(t, err)
+	require.Equal(t, types.CodeInvalidSchedule, err.Code())
+
+	// the recipient is blacklisted
+	msg = types.NewMsgCreateVestingAccount(from, BlacklistedAddr, origCoins, 0, endTime, false)
+	err = keeper.CreateVestingAccount(ctx, msg)
+	require.Error(t, err)
+	require.Equal(t, sdk.CodeUnauthorized, err.Code())
+
+	// the recipient account already exists
+	err = keeper.CreateVestingAccount(ctx, types.NewMsgCreateVestingAccount(from, from, origCoins, 0, endTime, false))
+	require.Error(t, err)
+	require.Equal(t, types.CodeAccountExists, err.Code())
+	require.Equal(t, origCoins, bk.GetCoins(ctx, from))
+}
+
+func TestVestingAccountDelegation(t *testing.T) {
+	ctx, ak, bk, keeper := SetupTestInput()
+	now := time.Unix(1577836800, 0)
+	ctx = ctx.WithBlockHeader(abci.Header{Time: now})
+
+	from := sdk.AccAddress([]byte("from"))
+	module := sdk.AccAddress([]byte("bondedPool"))
+	ak.SetAccount(ctx, ak.NewAccountWithAddress(ctx, from))
+	ak.SetAccount(ctx, ak.NewAccountWithAddress(ctx, module))
+	require.NoError(t, bk.SetCoins(ctx, from, sdk.NewCoins(sdk.NewInt64Coin("stake", 100))))
+
+	to := sdk.AccAddress([]byte("to"))
+	periods := auth.Periods{
+		auth.NewPeriod(3600, sdk.NewCoins(sdk.NewInt64Coin("stake", 50))),
+		auth.NewPeriod(3600, sdk.NewCoins(sdk.NewInt64Coin("stake", 50))),
+	}
+	require.NoError(t, keeper.CreateVestingAccount(ctx, types.NewMsgCreatePeriodicVestingAccount(from, to, 0, periods)))
+
+	// delegate vested and vesting coins after the first period
+	ctx = ctx.WithBlockHeader(abci.Header{Time: now.Add(time.Hour)})
+	delCoins := sdk.NewCoins(sdk.NewInt64Coin("stake", 75))
+	require.NoError(t, bk.DelegateCoins(ctx, to, module, delCoins))
+
+	pva := ak.GetAccount(ctx, to).(*auth.PeriodicVestingAccount)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 25)), pva.GetCoins())
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 50)), pva.GetDelegatedVesting())
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 25)), pva.GetDelegatedFree())
+
+	// undelegate all of them
+	require.NoError(t, bk.UndelegateCoins(ctx, module, to, delCoins))
+
+	pva = ak.GetAccount(ctx, to).(*auth.PeriodicVestingAccount)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 100)), pva.GetCoins())
+	require.True(t, pva.GetDelegatedVesting().Empty())
+	require.True(t, pva.GetDelegatedFree().Empty())
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 50)), pva.SpendableCoins(now.Add(time.Hour)))
+}
@@ -0,0 +1,53 @@
+package keeper
+
+// DONTCOVER
+
+import (
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/shinecloudfoundation/shinecloudnet/codec"
+	"github.com/shinecloudfoundation/shinecloudnet/store"
+	sdk "github.com/shinecloudfoundation/shinecloudnet/types"
+	"github.com/shinecloudfoundation/shinecloudnet/x/auth"
+	"github.com/shinecloudfoundation/shinecloudnet/x/bank"
+	"github.com/shinecloudfoundation/shinecloudnet/x/params"
+	"github.com/shinecloudfoundation/shinecloudnet/x/vesting/internal/types"
+)
+
+// BlacklistedAddr is an address the test bank keeper refuses to send to
+var BlacklistedAddr = sdk.AccAddress([]byte("moduleAcc"))
+
+// SetupTestInput returns a context, an account keeper, a bank keeper and a
+// vesting keeper on a fresh store
+func SetupTestInput() (sdk.Context, auth.AccountKeeper, bank.Keeper, Keeper) {
+	db := dbm.NewMemDB()
+
+	cdc := codec.New()
+	auth.RegisterCodec(cdc)
+	codec.RegisterCrypto(cdc)
+
+	authCapKey := sdk.NewKVStoreKey(auth.StoreKey)
+	keyParams := sdk.NewKVStoreKey(params.StoreKey)
+	tkeyParams := sdk.NewTransientStoreKey(params.TStoreKey)
+
+	ms := store.NewCommitMultiStore(db)
+	ms.MountStoreWithDB(authCapKey, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(keyParams, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(tkeyParams, sdk.StoreTypeTransient, db)
+	_ = ms.LoadLatestVersion()
+
+	blacklistedAddrs := map[string]bool{BlacklistedAddr.String(): true}
+
+	pk := params.NewKeeper(cdc, keyParams, tkeyParams, params.DefaultCodespace)
+	ak := auth.NewAccountKeeper(cdc, authCapKey, pk.Subspace(auth.DefaultParamspace), auth.ProtoBaseAccount)
+	ctx := sdk.NewContext(ms, abci.Header{ChainID: "test-chain-id"}, false, log.NewNopLogger())
+	ak.SetParams(ctx, auth.DefaultParams())
+
+	bk := bank.NewBaseKeeper(ak, pk.Subspace(bank.DefaultParamspace), bank.DefaultCodespace, blacklistedAddrs)
+	bk.SetSendEnabled(ctx, true)
+
+	keeper := NewKeeper(ak, bk, types.DefaultCodespace)
+	return ctx, ak, bk, keeper
+}
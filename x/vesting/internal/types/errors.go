@@ -0,0 +1,27 @@
+//nolint
+package types
+
+import (
+	sdk "github.com/shinecloudfoundation/shinecloudnet/types"
+)
+
+// Local code type
+type CodeType = sdk.CodeType
+
+const (
+	// Default vesting codespace
+	DefaultCodespace sdk.CodespaceType = ModuleName
+
+	CodeAccountExists   CodeType = 101
+	CodeInvalidSchedule CodeType = 102
+)
+
+// ErrAccountExists is returned if the vesting account to create already exists
+func ErrAccountExists(codespace sdk.CodespaceType, addr sdk.AccAddress) sdk.Error {
+	return sdk.NewError(codespace, CodeAccountExists, "account %s already exists", addr)
+}
+
+// ErrInvalidSchedule is returned if the vesting schedule is malformed
+func ErrInvalidSchedule(codespace sdk.CodespaceType, msg string) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidSchedule, msg)
+}
@@ -0,0 +1,10 @@
+package types
+
+// vesting module event types
+const (
+	EventTypeCreateVestingAccount = "create_vesting_account"
+
+	AttributeKeyRecipient = "recipient"
+
+	AttributeValueCategory = ModuleName
+)
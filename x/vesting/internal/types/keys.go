@@ -0,0 +1,9 @@
+package types
+
+const (
+	// ModuleName is the module name constant used in many places
+	ModuleName = "vesting"
+
+	// RouterKey is the message route for the vesting module
+	RouterKey = ModuleName
+)
@@ -0,0 +1,116 @@
+package types
+
+import (
+	sdk "github.com/shinecloudfoundation/shinecloudnet/types"
+	"github.com/shinecloudfoundation/shinecloudnet/x/auth"
+)
+
+// vesting message types
+const (
+	TypeMsgCreateVestingAccount = "create_vesting_account"
+)
+
+var _ sdk.Msg = MsgCreateVestingAccount{}
+
+// MsgCreateVestingAccount creates a new vesting account at ToAddress funded
+// with Amount from the account of FromAddress. With vesting periods the
+// account is a periodic vesting account whose schedule ends after the summed
+// period lengths, otherwise it is a delayed or continuous vesting account
+// ending at EndTime. A zero StartTime starts the schedule at the block time.
+type MsgCreateVestingAccount struct {
+	FromAddress    sdk.AccAddress `json:"from_address" yaml:"from_address"`
+	ToAddress      sdk.AccAddress `json:"to_address" yaml:"to_address"`
+	Amount         sdk.Coins      `json:"amount" yaml:"amount"`
+	StartTime      int64          `json:"start_time" yaml:"start_time"`
+	EndTime        int64          `json:"end_time" yaml:"end_time"`
+	Delayed        bool           `json:"delayed" yaml:"delayed"`
+	VestingPeriods auth.Periods   `json:"vesting_periods,omitempty" yaml:"vesting_periods,omitempty"`
+}
+
+// NewMsgCreateVestingAccount creates a msg for a continuous or delayed
+// vesting account
+func NewMsgCreateVestingAccount(fromAddr, toAddr sdk.AccAddress, amount sdk.Coins,
+	startTime, endTime int64, delayed bool) MsgCreateVestingAccount {
+
+	return MsgCreateVestingAccount{
+		FromAddress: fromAddr,
+		ToAddress:   toAddr,
+		Amount:      amount,
+		StartTime:   startTime,
+		EndTime:     endTime,
+		Delayed:     delayed,
+	}
+}
+
+// NewMsgCreatePeriodicVestingAccount creates a msg for a periodic vesting
+// account vesting the total amount of the periods
+func NewMsgCreatePeriodicVestingAccount(fromAddr, toAddr sdk.AccAddress, startTime int64,
+	periods auth.Periods) MsgCreateVestingAccount {
+
+	return MsgCreateVestingAccount{
+		FromAddress:    fromAddr,
+		ToAddress:      toAddr,
+		Amount:         periods.TotalAmount(),
+		StartTime:      startTime,
+		VestingPeriods: periods,
+	}
+}
+
+// Route implements sdk.Msg
+func (msg MsgCreateVestingAccount) Route() string { return RouterKey }
+
+// Type implements sdk.Msg
+func (msg MsgCreateVestingAccount) Type() string { return TypeMsgCreateVestingAccount }
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgCreateVestingAccount) ValidateBasic() sdk.Error {
+	if msg.FromAddress.Empty() {
+		return sdk.ErrInvalidAddress("missing sender address")
+	}
+	if msg.ToAddress.Empty() {
+		return sdk.ErrInvalidAddress("missing recipient address")
+	}
+	if !msg.Amount.IsValid() {
+		return sdk.ErrInvalidCoins("vesting amount is invalid: " + msg.Amount.String())
+	}
+	if !msg.Amount.IsAllPositive() {
+		return sdk.ErrInsufficientCoins("vesting amount must be positive")
+	}
+	if msg.StartTime < 0 {
+		return ErrInvalidSchedule(DefaultCodespace, "vesting start time can't be negative")
+	}
+
+	if len(msg.VestingPeriods) > 0 {
+		if msg.Delayed || msg.EndTime != 0 {
+			return ErrInvalidSchedule(DefaultCodespace, "the end time of a periodic vesting account is given by its periods")
+		}
+		if err := msg.VestingPeriods.Validate(); err != nil {
+			return ErrInvalidSchedule(DefaultCodespace, err.Error())
+		}
+		if !msg.VestingPeriods.TotalAmount().IsEqual(msg.Amount) {
+			return ErrInvalidSchedule(DefaultCodespace, "vesting periods total amount must equal the vesting amount")
+		}
+		return nil
+	}
+
+	if msg.EndTime <= 0 {
+		return ErrInvalidSchedule(DefaultCodespace, "vesting end time must be positive")
+	}
+	if msg.Delayed && msg.StartTime != 0 {
+		return ErrInvalidSchedule(DefaultCodespace, "delayed vesting accounts have no start time")
+	}
+	if msg.StartTime != 0 && msg.StartTime >= msg.EndTime {
+		return ErrInvalidSchedule(DefaultCodespace, "vesting end time must be after the start time")
+	}
+	return nil
+}
+
+// GetSignBytes implements sdk.Msg
+func (msg MsgCreateVestingAccount) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners implements sdk.Msg
+func (msg MsgCreateVestingAccount) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.FromAddress}
+}
@@ -0,0 +1,54 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/shinecloudfoundation/shinecloudnet/types"
+	"github.com/shinecloudfoundation/shinecloudnet/x/auth"
+)
+
+func TestMsgCreateVestingAccountValidateBasic(t *testing.T) {
+	from := sdk.AccAddress([]byte("from"))
+	to := sdk.AccAddress([]byte("to"))
+	amount := sdk.NewCoins(sdk.NewInt64Coin("stake", 100))
+	periods := auth.Periods{
+		auth.NewPeriod(3600, sdk.NewCoins(sdk.NewInt64Coin("stake", 40))),
+		auth.NewPeriod(3600, sdk.NewCoins(sdk.NewInt64Coin("stake", 60))),
+	}
+
+	badPeriods := NewMsgCreatePeriodicVestingAccount(from, to, 0, periods)
+	badPeriods.Amount = sdk.NewCoins(sdk.NewInt64Coin("stake", 50))
+	periodsWithEnd := NewMsgCreatePeriodicVestingAccount(from, to, 0, periods)
+	periodsWithEnd.EndTime = 1000
+
+	tests := []struct {
+		name  string
+		msg   MsgCreateVestingAccount
+		valid bool
+	}{
+		{"continuous", NewMsgCreateVestingAccount(from, to, amount, 0, 1000, false), true},
+		{"continuous with start", NewMsgCreateVestingAccount(from, to, amount, 500, 1000, false), true},
+		{"delayed", NewMsgCreateVestingAccount(from, to, amount, 0, 1000, true), true},
+		{"periodic", NewMsgCreatePeriodicVestingAccount(from, to, 500, periods), true},
+		{"no sender", NewMsgCreateVestingAccount(nil, to, amount, 0, 1000, false), false},
+		{"no recipient", NewMsgCreateVestingAccount(from, nil, amount, 0, 1000, false), false},
+		{"no amount", NewMsgCreateVestingAccount(from, to, sdk.Coins{}, 0, 1000, false), false},
+		{"no end time", NewMsgCreateVestingAccount(from, to, amount, 0, 0, false), false},
+		{"end before start", NewMsgCreateVestingAccount(from, to, amount, 1000, 500, false), false},
+		{"delayed with start", NewMsgCreateVestingAccount(from, to, amount, 500, 1000, true), false},
+		{"periods amount mismatch", badPeriods, false},
+		{"periods with end time", periodsWithEnd, false},
+		{"invalid period", NewMsgCreatePeriodicVestingAccount(from, to, 0, auth.Periods{auth.NewPeriod(0, amount)}), false},
+	}
+
+	for _, tc := range tests {
+		err := tc.msg.ValidateBasic()
+		if tc.valid {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}